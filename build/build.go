@@ -0,0 +1,159 @@
+package build
+
+import (
+	"context"
+	"fmt"
+	"gs/config"
+	"os"
+	"os/exec"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/sirupsen/logrus"
+)
+
+var log = logrus.WithFields(logrus.Fields{
+	"package": "build",
+})
+
+// Options are the options used to build the service binaries.
+type Options struct {
+	// the folder the binaries are written to
+	Out string
+	// the target operating systems and architectures
+	OS   []string
+	Arch []string
+	// the version information injected in the gen/version package
+	Version   string
+	Commit    string
+	BuildTime string
+}
+
+// Artifact is a built service binary.
+type Artifact struct {
+	Service string
+	OS      string
+	Arch    string
+	Path    string
+	Size    int64
+}
+
+type target struct {
+	service string
+	// pkg is the import path of the service package
+	pkg  string
+	os   string
+	arch string
+}
+
+// Build builds the binaries of the services for all the target platforms in parallel,
+// if one of the builds fails all the other builds are canceled.
+func Build(cfg *config.GSConfig, services []string, options Options) ([]Artifact, error) {
+	if err := os.MkdirAll(options.Out, 0755); err != nil {
+		return nil, err
+	}
+	var targets []target
+	for _, svc := range services {
+		_, pkg, err := cfg.Services[svc].ResolveModule(svc, cfg.Module)
+		if err != nil {
+			return nil, err
+		}
+		for _, goos := range options.OS {
+			for _, arch := range options.Arch {
+				targets = append(targets, target{service: svc, pkg: pkg, os: goos, arch: arch})
+			}
+		}
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	artifacts := make([]Artifact, len(targets))
+	errs := make(chan error, len(targets))
+	var wg sync.WaitGroup
+	for inx, t := range targets {
+		wg.Add(1)
+		go func(inx int, t target) {
+			defer wg.Done()
+			artifact, err := buildTarget(ctx, t, options)
+			if err != nil {
+				errs <- err
+				cancel()
+				return
+			}
+			artifacts[inx] = *artifact
+		}(inx, t)
+	}
+	wg.Wait()
+	close(errs)
+	// the first error is the one that canceled the rest of the builds
+	if err := <-errs; err != nil {
+		return nil, err
+	}
+	return artifacts, nil
+}
+
+func buildTarget(ctx context.Context, t target, options Options) (*Artifact, error) {
+	name := fmt.Sprintf("%s_%s_%s", t.service, t.os, t.arch)
+	if t.os == "windows" {
+		name += ".exe"
+	}
+	output, err := filepath.Abs(filepath.Join(options.Out, name))
+	if err != nil {
+		return nil, err
+	}
+	versionPkg := path.Join(t.pkg, "gen", "version")
+	ldflags := strings.Join([]string{
+		fmt.Sprintf("-X '%s.Version=%s'", versionPkg, options.Version),
+		fmt.Sprintf("-X '%s.Commit=%s'", versionPkg, options.Commit),
+		fmt.Sprintf("-X '%s.BuildTime=%s'", versionPkg, options.BuildTime),
+	}, " ")
+
+	log.WithField("service", t.service).Infof("Building for %s/%s", t.os, t.arch)
+	cmd := exec.CommandContext(
+		ctx,
+		"go", "build",
+		"-ldflags", ldflags,
+		"-o", output,
+		path.Join(t.pkg, "cmd"),
+	)
+	cmd.Env = append(os.Environ(), "GOOS="+t.os, "GOARCH="+t.arch)
+	if out, err := cmd.CombinedOutput(); err != nil {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		return nil, fmt.Errorf("building `%s` for %s/%s failed: %s\n%s", t.service, t.os, t.arch, err, out)
+	}
+	info, err := os.Stat(output)
+	if err != nil {
+		return nil, err
+	}
+	return &Artifact{
+		Service: t.service,
+		OS:      t.os,
+		Arch:    t.arch,
+		Path:    output,
+		Size:    info.Size(),
+	}, nil
+}
+
+// GitVersion returns the version and the commit of the current git repository,
+// if the project is not a git repository the version is `dev`.
+func GitVersion() (version string, commit string) {
+	version = "dev"
+	if out, err := exec.Command("git", "describe", "--tags", "--always", "--dirty").Output(); err == nil {
+		version = strings.TrimSpace(string(out))
+	}
+	if out, err := exec.Command("git", "rev-parse", "--short", "HEAD").Output(); err == nil {
+		commit = strings.TrimSpace(string(out))
+	}
+	return version, commit
+}
+
+// BuildTime returns the current time in the format used for the build time.
+func BuildTime() string {
+	return time.Now().UTC().Format(time.RFC3339)
+}
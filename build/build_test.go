@@ -0,0 +1,100 @@
+package build
+
+import (
+	"gs/config"
+	"gs/service"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// exampleProject copies the string service example to a temporary folder and generates it
+// with the http transport only, so the test does not need protoc.
+func exampleProject(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "gs-build")
+	if err != nil {
+		t.Fatal(err)
+	}
+	example := filepath.Join("..", "example", "stringsvc")
+	err = filepath.Walk(example, func(pth string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(example, pth)
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return os.MkdirAll(filepath.Join(dir, rel), 0755)
+		}
+		data, err := ioutil.ReadFile(pth)
+		if err != nil {
+			return err
+		}
+		return ioutil.WriteFile(filepath.Join(dir, rel), data, 0644)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg := "version = 1\n\n[services]\n\n  [services.strings]\n    transports = [\"http\"]\n"
+	if err := ioutil.WriteFile(filepath.Join(dir, "gs.toml"), []byte(cfg), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return dir
+}
+
+func TestBuildVersion(t *testing.T) {
+	if testing.Short() {
+		t.Skip("building the example is slow")
+	}
+	dir := exampleProject(t)
+	defer os.RemoveAll(dir)
+
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(dir); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Chdir(wd)
+
+	cfg, err := config.Read()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := service.GenerateServices(cfg, []string{"strings"}); err != nil {
+		t.Fatal(err)
+	}
+	artifacts, err := Build(cfg, []string{"strings"}, Options{
+		Out:       "dist",
+		OS:        []string{runtime.GOOS},
+		Arch:      []string{runtime.GOARCH},
+		Version:   "v9.8.7-gs-test",
+		Commit:    "c0ffee",
+		BuildTime: "2020-01-02T03:04:05Z",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	out, err := exec.Command("go", "tool", "nm", artifacts[0].Path).Output()
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.True(t, strings.Contains(string(out), "stringsvc/strings/gen/version.Version"), "the version package should be linked")
+
+	binary, err := ioutil.ReadFile(artifacts[0].Path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, value := range []string{"v9.8.7-gs-test", "c0ffee", "2020-01-02T03:04:05Z"} {
+		assert.True(t, strings.Contains(string(binary), value), "the binary should contain `%s`", value)
+	}
+}
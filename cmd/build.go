@@ -0,0 +1,84 @@
+package cmd
+
+import (
+	"fmt"
+	"gs/build"
+	"gs/config"
+	"os"
+	"runtime"
+	"text/tabwriter"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/spf13/cobra"
+)
+
+var buildCmd = &cobra.Command{
+	Use:   "build",
+	Short: "Build release binaries of the services",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if b, _ := cmd.Flags().GetBool("debug"); b {
+			logrus.SetLevel(logrus.DebugLevel)
+		}
+		version, commit := build.GitVersion()
+		if v, _ := cmd.Flags().GetString("version"); v != "" {
+			version = v
+		}
+		options := build.Options{
+			Version:   version,
+			Commit:    commit,
+			BuildTime: build.BuildTime(),
+		}
+		options.Out, _ = cmd.Flags().GetString("out")
+		options.OS, _ = cmd.Flags().GetStringSlice("os")
+		options.Arch, _ = cmd.Flags().GetStringSlice("arch")
+		return buildServices(options, args...)
+	},
+}
+
+func init() {
+	buildCmd.Flags().StringP("out", "o", "dist", "the folder the binaries are written to")
+	buildCmd.Flags().StringSlice("os", []string{runtime.GOOS}, "the target operating systems")
+	buildCmd.Flags().StringSlice("arch", []string{runtime.GOARCH}, "the target architectures")
+	buildCmd.Flags().String("version", "", "the version of the binaries, defaults to `git describe`")
+	rootCmd.AddCommand(buildCmd)
+}
+
+func buildServices(options build.Options, services ...string) error {
+	if err := generateServices("", services...); err != nil {
+		return err
+	}
+	cfg, err := config.Read()
+	if err != nil {
+		return err
+	}
+	var names []string
+	for _, name := range config.ServiceNames(selectServices(cfg, services)) {
+		if cfg.Services[name].IsEnabled() {
+			names = append(names, name)
+		}
+	}
+	artifacts, err := build.Build(cfg, names, options)
+	if err != nil {
+		return err
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "SERVICE\tOS\tARCH\tSIZE\tPATH")
+	for _, a := range artifacts {
+		_, _ = fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", a.Service, a.OS, a.Arch, humanSize(a.Size), a.Path)
+	}
+	return w.Flush()
+}
+
+func humanSize(size int64) string {
+	const unit = 1024
+	if size < unit {
+		return fmt.Sprintf("%d B", size)
+	}
+	div, exp := int64(unit), 0
+	for n := size / unit; n >= unit; n /= unit {
+		div *= unit
+		exp++
+	}
+	return fmt.Sprintf("%.1f %ciB", float64(size)/float64(div), "KMGTPE"[exp])
+}
@@ -0,0 +1,79 @@
+package cmd
+
+import (
+	"fmt"
+	"gs/config"
+
+	"github.com/pelletier/go-toml"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/spf13/cobra"
+)
+
+var configCmd = &cobra.Command{
+	Use:   "config",
+	Short: "Read, change and migrate gs.toml",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return cmd.Help()
+	},
+}
+
+var configMigrateCmd = &cobra.Command{
+	Use:   "migrate",
+	Short: "Upgrade gs.toml to the current layout",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if b, _ := cmd.Flags().GetBool("debug"); b {
+			logrus.SetLevel(logrus.DebugLevel)
+		}
+		changes, err := config.Migrate()
+		if err != nil {
+			return err
+		}
+		if len(changes) == 0 {
+			logrus.Info("gs.toml is up to date")
+		}
+		for _, change := range changes {
+			logrus.Info(change)
+		}
+		return nil
+	},
+}
+
+var configGetCmd = &cobra.Command{
+	Use:   "get",
+	Short: "Print a value of gs.toml (e.x gs config get services.add.http.port)",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		value, err := config.Get(args[0])
+		if err != nil {
+			return err
+		}
+		if tree, ok := value.(*toml.Tree); ok {
+			fmt.Print(tree.String())
+			return nil
+		}
+		fmt.Println(value)
+		return nil
+	},
+}
+
+var configSetCmd = &cobra.Command{
+	Use:   "set",
+	Short: "Change a value of gs.toml keeping the comments (e.x gs config set services.add.http.port 8080)",
+	Args:  cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if b, _ := cmd.Flags().GetBool("debug"); b {
+			logrus.SetLevel(logrus.DebugLevel)
+		}
+		return config.Set(args[0], args[1])
+	},
+}
+
+func init() {
+	configCmd.AddCommand(configMigrateCmd)
+	configCmd.AddCommand(configGetCmd)
+	configCmd.AddCommand(configSetCmd)
+	rootCmd.AddCommand(configCmd)
+}
@@ -0,0 +1,49 @@
+package cmd
+
+import (
+	"fmt"
+	"gs/doctor"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/spf13/cobra"
+)
+
+var doctorCmd = &cobra.Command{
+	Use:   "doctor",
+	Short: "Check the toolchain and the health of the project",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if b, _ := cmd.Flags().GetBool("debug"); b {
+			logrus.SetLevel(logrus.DebugLevel)
+		} else {
+			logrus.SetLevel(logrus.ErrorLevel)
+		}
+		checks := doctor.Run()
+
+		failed := 0
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		for _, c := range checks {
+			_, _ = fmt.Fprintf(w, "[%s]\t%s\t%s\n", strings.ToUpper(string(c.Status)), c.Name, c.Message)
+			if c.Status != doctor.PASS && c.Fix != "" {
+				_, _ = fmt.Fprintf(w, "\t\tfix: %s\n", c.Fix)
+			}
+			if c.Status == doctor.FAIL {
+				failed++
+			}
+		}
+		if err := w.Flush(); err != nil {
+			return err
+		}
+		if failed > 0 {
+			return fmt.Errorf("%d check(s) failed", failed)
+		}
+		return nil
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(doctorCmd)
+}
@@ -0,0 +1,33 @@
+package cmd
+
+import (
+	"gs/service"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/spf13/cobra"
+)
+
+var endpointCmd = &cobra.Command{
+	Use: "endpoint",
+	Aliases: []string{
+		"ep",
+		"e",
+	},
+	Short: "Add a new endpoint to an existing service",
+	Args:  cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if b, _ := cmd.Flags().GetBool("debug"); b {
+			logrus.SetLevel(logrus.DebugLevel)
+		}
+		http, _ := cmd.Flags().GetString("http")
+		grpc, _ := cmd.Flags().GetBool("grpc")
+		return service.GenerateNewEndpoint(args[0], args[1], http, grpc)
+	},
+}
+
+func init() {
+	endpointCmd.Flags().String("http", "", "add an http transport to the endpoint in the format `method:/route`")
+	endpointCmd.Flags().Bool("grpc", false, "add a grpc transport to the endpoint")
+	newCmd.AddCommand(endpointCmd)
+}
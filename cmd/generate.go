@@ -1,8 +1,10 @@
 package cmd
 
 import (
+	"fmt"
 	"gs/config"
 	"gs/service"
+	"strings"
 
 	"github.com/sirupsen/logrus"
 
@@ -20,36 +22,72 @@ var generateCmd = &cobra.Command{
 		if b, _ := cmd.Flags().GetBool("debug"); b {
 			logrus.SetLevel(logrus.DebugLevel)
 		}
-		return generateServices(args...)
+		dryRun, _ := cmd.Flags().GetBool("dry-run")
+		check, _ := cmd.Flags().GetBool("check")
+		profile, _ := cmd.Flags().GetString("profile")
+		if dryRun || check {
+			return diffServices(check, profile, args...)
+		}
+		return generateServices(profile, args...)
 	},
 }
 
 func init() {
+	generateCmd.Flags().Bool("dry-run", false, "print a diff of the changes without writing any files")
+	generateCmd.Flags().Bool("check", false, "exit with an error if the generated code is out of date")
+	generateCmd.Flags().String("profile", "", "the profile in gs.toml used to override the service addresses")
 	rootCmd.AddCommand(generateCmd)
 }
 
-func generateServices(services ...string) error {
-	cfg, err := config.Read()
-	if err != nil {
-		return err
+// selectServices returns the configuration of the given services,
+// if no service is given all the services are returned.
+func selectServices(cfg *config.GSConfig, services []string) map[string]config.ServiceConfig {
+	if len(services) == 0 {
+		return cfg.Services
 	}
+	selected := map[string]config.ServiceConfig{}
 	for _, svc := range services {
 		if svcCfg, ok := cfg.Services[svc]; ok {
-			err := service.Generate(svc, svcCfg, cfg.Module)
-			if err != nil {
-				return err
-			}
+			selected[svc] = svcCfg
 		} else {
 			logrus.Warnf("service `%s` does not exits in the configuration file", svc)
 		}
 	}
-	if len(services) == 0 {
-		for name, svcCfg := range cfg.Services {
-			err := service.Generate(name, svcCfg, cfg.Module)
-			if err != nil {
-				return err
-			}
+	return selected
+}
+
+func generateServices(profile string, services ...string) error {
+	cfg, err := config.ReadProfile(profile)
+	if err != nil {
+		return err
+	}
+	return service.GenerateServices(cfg, config.ServiceNames(selectServices(cfg, services)))
+}
+
+func diffServices(check bool, profile string, services ...string) error {
+	cfg, err := config.ReadProfile(profile)
+	if err != nil {
+		return err
+	}
+	selected := selectServices(cfg, services)
+	names := config.ServiceNames(selected)
+
+	var stale []string
+	for _, name := range names {
+		diff, err := service.Diff(name, selected[name], cfg.Module, cfg.Plugins)
+		if err != nil {
+			return err
+		}
+		if diff == "" {
+			continue
 		}
+		stale = append(stale, name)
+		if !check {
+			fmt.Print(diff)
+		}
+	}
+	if check && len(stale) > 0 {
+		return fmt.Errorf("generated code is out of date for: %s, run `gs generate`", strings.Join(stale, ", "))
 	}
 	return nil
 }
@@ -0,0 +1,129 @@
+package cmd
+
+import (
+	"errors"
+	"gs/config"
+	"gs/fs"
+	"gs/service"
+	"gs/template"
+	"sort"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/spf13/cobra"
+)
+
+var initCmd = &cobra.Command{
+	Use:   "init",
+	Short: "Initialize gs in an existing go module",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if b, _ := cmd.Flags().GetBool("debug"); b {
+			logrus.SetLevel(logrus.DebugLevel)
+		}
+		return initProject()
+	},
+}
+
+func init() {
+	rootCmd.AddCommand(initCmd)
+}
+
+func initProject() error {
+	if b, _ := fs.Exists("gs.toml"); b {
+		return errors.New("gs.toml already exists, the project is already initialized")
+	}
+	module, err := config.ReadModule()
+	if err != nil {
+		// the root of a go.work workspace does not need to be a module
+		if b, _ := fs.Exists("go.work"); !b {
+			return err
+		}
+	}
+	services, err := service.Discover()
+	if err != nil {
+		return err
+	}
+
+	cfg := &config.GSConfig{
+		Module:          module,
+		WatchExtensions: []string{},
+		Services:        map[string]config.ServiceConfig{},
+	}
+	var names []string
+	for name := range services {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		httpPort := cfg.FreePort(8000)
+		grpcPort := cfg.FreePort(2000, httpPort)
+		debugPort := cfg.FreePort(3000, httpPort, grpcPort)
+		svcCfg := config.ServiceConfig{
+			Http: config.AddressConfig{
+				Port: httpPort,
+			},
+			Grpc: config.AddressConfig{
+				Port: grpcPort,
+			},
+			Debug: config.AddressConfig{
+				Port: debugPort,
+			},
+		}
+		if services[name] != name {
+			svcCfg.Path = services[name]
+		}
+		cfg.Services[name] = svcCfg
+		logrus.Infof("Found service `%s` in `%s`", name, services[name])
+	}
+	if err := config.Write(*cfg); err != nil {
+		return err
+	}
+	if err := mergeGitignore(); err != nil {
+		return err
+	}
+	if len(services) > 0 {
+		logrus.Info("Run `gs generate` to generate the services")
+	}
+	return nil
+}
+
+// gitignoreRules are the rules a project needs, the generated code and the binaries of `gs build`.
+var gitignoreRules = []string{"gen/", "dist/"}
+
+// mergeGitignore appends the gs rules that are missing from the existing .gitignore
+// without touching the existing rules, if there is no .gitignore the project gitignore is created.
+func mergeGitignore() error {
+	if b, _ := fs.Exists(".gitignore"); !b {
+		gitignore, err := template.CompileFromPath("project/gitignore", nil)
+		if err != nil {
+			return err
+		}
+		return fs.WriteFile(".gitignore", gitignore)
+	}
+	existing, err := fs.ReadFile(".gitignore")
+	if err != nil {
+		return err
+	}
+	rules := map[string]bool{}
+	for _, line := range strings.Split(existing, "\n") {
+		rules[strings.TrimSpace(line)] = true
+	}
+	var missing []string
+	for _, rule := range gitignoreRules {
+		// `gen` without the slash also ignores the folders
+		if rules[rule] || rules[strings.TrimSuffix(rule, "/")] {
+			continue
+		}
+		missing = append(missing, rule)
+	}
+	if len(missing) == 0 {
+		return nil
+	}
+	if existing != "" && !strings.HasSuffix(existing, "\n") {
+		existing += "\n"
+	}
+	existing += "\n# Added by `gs init`\n" + strings.Join(missing, "\n") + "\n"
+	return fs.WriteFile(".gitignore", existing)
+}
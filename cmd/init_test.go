@@ -0,0 +1,74 @@
+package cmd
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// inTempFolder runs fn in a new temporary folder.
+func inTempFolder(t *testing.T, fn func()) {
+	dir, err := ioutil.TempDir("", "gs-cmd")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(dir); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Chdir(wd)
+	fn()
+}
+
+func TestMergeGitignore(t *testing.T) {
+	tests := []struct {
+		name     string
+		existing string
+		merged   string
+	}{
+		{
+			name:     "missing rules",
+			existing: "*.so\nbin/",
+			merged:   "*.so\nbin/\n\n# Added by `gs init`\ngen/\ndist/\n",
+		},
+		{
+			name:     "some rules",
+			existing: "# build\ndist/\n",
+			merged:   "# build\ndist/\n\n# Added by `gs init`\ngen/\n",
+		},
+		{
+			name:     "all rules",
+			existing: "gen\ndist/\n",
+			merged:   "gen\ndist/\n",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			inTempFolder(t, func() {
+				if err := ioutil.WriteFile(".gitignore", []byte(test.existing), 0644); err != nil {
+					t.Fatal(err)
+				}
+				assert.NoError(t, mergeGitignore())
+				data, err := ioutil.ReadFile(".gitignore")
+				assert.NoError(t, err)
+				assert.Equal(t, test.merged, string(data))
+			})
+		})
+	}
+}
+
+func TestMergeGitignoreCreatesTheFile(t *testing.T) {
+	inTempFolder(t, func() {
+		assert.NoError(t, mergeGitignore())
+		data, err := ioutil.ReadFile(".gitignore")
+		assert.NoError(t, err)
+		assert.Contains(t, string(data), "*.exe\n")
+		assert.Contains(t, string(data), "gen/\n")
+	})
+}
@@ -0,0 +1,83 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"gs/config"
+	"gs/service"
+	"os"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/spf13/cobra"
+)
+
+var inspectCmd = &cobra.Command{
+	Use:   "inspect",
+	Short: "Print the parsed model of the services",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if b, _ := cmd.Flags().GetBool("debug"); b {
+			logrus.SetLevel(logrus.DebugLevel)
+		} else {
+			// the warnings are written to stderr so they do not mix with the output
+			logrus.SetLevel(logrus.WarnLevel)
+		}
+		asJson, _ := cmd.Flags().GetBool("json")
+		return inspectServices(asJson, args...)
+	},
+}
+
+func init() {
+	inspectCmd.Flags().Bool("json", false, "print the model as versioned json")
+	rootCmd.AddCommand(inspectCmd)
+}
+
+func inspectServices(asJson bool, services ...string) error {
+	cfg, err := config.Read()
+	if err != nil {
+		return err
+	}
+	selected := selectServices(cfg, services)
+
+	model := service.Model{
+		Version:  service.InspectVersion,
+		Module:   cfg.Module,
+		Services: []service.ServiceModel{},
+	}
+	for _, name := range config.ServiceNames(selected) {
+		svc, err := service.Parse(name, selected[name], cfg.Module)
+		if err != nil {
+			return err
+		}
+		// the rules are only needed to generate, `gs lint` shows where they are
+		if err := svc.CheckValidations(); err != nil {
+			logrus.Warn(err)
+		}
+		model.Services = append(model.Services, svc.Inspect())
+	}
+
+	if asJson {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(model)
+	}
+	for _, svc := range model.Services {
+		fmt.Printf("%s (%s.%s)\n", svc.Name, svc.Import, svc.Interface)
+		for _, ep := range svc.Endpoints {
+			fmt.Printf("  %s\n", ep.Name)
+			if ep.Http != nil {
+				for _, r := range ep.Http.Routes {
+					fmt.Printf("    http %s %s\n", strings.Join(r.Methods, ","), r.Route)
+				}
+				for _, p := range ep.Http.Params {
+					fmt.Printf("      %s %s -> %s\n", p.Kind, p.Name, p.Field)
+				}
+			}
+			if ep.GRPC != nil {
+				fmt.Printf("    grpc %s\n", ep.GRPC.Method)
+			}
+		}
+	}
+	return nil
+}
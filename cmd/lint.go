@@ -0,0 +1,76 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"gs/config"
+	"gs/service"
+	"os"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/spf13/cobra"
+)
+
+var lintCmd = &cobra.Command{
+	Use:   "lint",
+	Short: "Validate the service annotations and contracts without generating code",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if b, _ := cmd.Flags().GetBool("debug"); b {
+			logrus.SetLevel(logrus.DebugLevel)
+		} else {
+			// all the problems are reported as diagnostics
+			logrus.SetLevel(logrus.ErrorLevel)
+		}
+		format, _ := cmd.Flags().GetString("format")
+		return lintServices(format, args...)
+	},
+}
+
+func init() {
+	lintCmd.Flags().StringP("format", "f", "text", "the output format `text` or `json`")
+	rootCmd.AddCommand(lintCmd)
+}
+
+func lintServices(format string, services ...string) error {
+	if format != "text" && format != "json" {
+		return fmt.Errorf("format `%s` is not supported, use `text` or `json`", format)
+	}
+	cfg, err := config.Read()
+	if err != nil {
+		return err
+	}
+	selected := selectServices(cfg, services)
+	names := config.ServiceNames(selected)
+
+	diagnostics := []service.Diagnostic{}
+	for _, name := range names {
+		d, err := service.Lint(name, selected[name], cfg.Module)
+		if err != nil {
+			return err
+		}
+		diagnostics = append(diagnostics, d...)
+	}
+
+	errorCount := 0
+	for _, d := range diagnostics {
+		if d.Severity == service.ERROR {
+			errorCount++
+		}
+	}
+	if format == "json" {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(diagnostics); err != nil {
+			return err
+		}
+	} else {
+		for _, d := range diagnostics {
+			fmt.Println(d.String())
+		}
+	}
+	if errorCount > 0 {
+		return fmt.Errorf("found %d error(s)", errorCount)
+	}
+	return nil
+}
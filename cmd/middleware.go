@@ -0,0 +1,31 @@
+package cmd
+
+import (
+	"gs/service"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/spf13/cobra"
+)
+
+var middlewareCmd = &cobra.Command{
+	Use: "middleware",
+	Aliases: []string{
+		"mw",
+		"m",
+	},
+	Short: "Create a new service or endpoint middleware",
+	Args:  cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if b, _ := cmd.Flags().GetBool("debug"); b {
+			logrus.SetLevel(logrus.DebugLevel)
+		}
+		endpoint, _ := cmd.Flags().GetString("endpoint")
+		return service.GenerateNewMiddleware(args[0], args[1], endpoint)
+	},
+}
+
+func init() {
+	middlewareCmd.Flags().StringP("endpoint", "e", "", "create an endpoint middleware for the given endpoint")
+	newCmd.AddCommand(middlewareCmd)
+}
@@ -57,5 +57,9 @@ func newProject(name string) error {
 	if err := fs.WriteFile(path.Join(moduleName, "go.mod"), goMod); err != nil {
 		return err
 	}
-	return fs.WriteFile(path.Join(moduleName, "gs.toml"), "")
+	gsConfig, err := template.CompileFromPath("project/gs.jet", nil)
+	if err != nil {
+		return err
+	}
+	return fs.WriteFile(path.Join(moduleName, "gs.toml"), gsConfig)
 }
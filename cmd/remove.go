@@ -0,0 +1,62 @@
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"gs/service"
+	"os"
+	"strings"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/spf13/cobra"
+)
+
+var removeCmd = &cobra.Command{
+	Use: "remove",
+	Aliases: []string{
+		"rm",
+	},
+	Short: "Various helper commands to remove code",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return cmd.Help()
+	},
+}
+
+var removeServiceCmd = &cobra.Command{
+	Use: "service",
+	Aliases: []string{
+		"svc",
+		"s",
+	},
+	Short: "Remove a service from the project",
+	Args:  cobra.ExactArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if b, _ := cmd.Flags().GetBool("debug"); b {
+			logrus.SetLevel(logrus.DebugLevel)
+		}
+		if yes, _ := cmd.Flags().GetBool("yes"); !yes && !confirm(
+			fmt.Sprintf("This will delete the service `%s` and its folder, are you sure?", args[0]),
+		) {
+			return nil
+		}
+		return service.Remove(args[0])
+	},
+}
+
+func init() {
+	removeServiceCmd.Flags().BoolP("yes", "y", false, "do not ask for confirmation")
+	removeCmd.AddCommand(removeServiceCmd)
+	rootCmd.AddCommand(removeCmd)
+}
+
+// confirm asks the user a yes/no question, the default answer is no.
+func confirm(question string) bool {
+	fmt.Printf("%s [y/N]: ", question)
+	answer, err := bufio.NewReader(os.Stdin).ReadString('\n')
+	if err != nil {
+		return false
+	}
+	answer = strings.ToLower(strings.TrimSpace(answer))
+	return answer == "y" || answer == "yes"
+}
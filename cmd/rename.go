@@ -0,0 +1,41 @@
+package cmd
+
+import (
+	"gs/service"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/spf13/cobra"
+)
+
+var renameCmd = &cobra.Command{
+	Use: "rename",
+	Aliases: []string{
+		"mv",
+	},
+	Short: "Various helper commands to rename code",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return cmd.Help()
+	},
+}
+
+var renameServiceCmd = &cobra.Command{
+	Use: "service",
+	Aliases: []string{
+		"svc",
+		"s",
+	},
+	Short: "Rename a service and fix all of its imports",
+	Args:  cobra.ExactArgs(2),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if b, _ := cmd.Flags().GetBool("debug"); b {
+			logrus.SetLevel(logrus.DebugLevel)
+		}
+		return service.Rename(args[0], args[1])
+	},
+}
+
+func init() {
+	renameCmd.AddCommand(renameServiceCmd)
+	rootCmd.AddCommand(renameCmd)
+}
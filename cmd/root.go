@@ -1,6 +1,9 @@
 package cmd
 
 import (
+	"gs/config"
+	"gs/fs"
+	"gs/template"
 	"os"
 
 	"github.com/spf13/cobra"
@@ -10,11 +13,25 @@ var rootCmd = &cobra.Command{
 	Use:          "gs",
 	SilenceUsage: true,
 	Short:        "A tool to help you create microservices",
+	PersistentPreRun: func(cmd *cobra.Command, args []string) {
+		loadTemplateOverrides()
+	},
 	RunE: func(cmd *cobra.Command, args []string) error {
 		return cmd.Help()
 	},
 }
 
+// loadTemplateOverrides makes the templates use the project templates if the project configures them,
+// the configuration errors are ignored here because each command reports them when it reads the configuration.
+func loadTemplateOverrides() {
+	if b, _ := fs.Exists("gs.toml"); !b {
+		return
+	}
+	if cfg, err := config.Read(); err == nil {
+		template.SetOverrides(cfg.Templates)
+	}
+}
+
 // Execute adds all child commands to the root command and sets flags appropriately.
 // This is called by main.main(). It only needs to happen once to the rootCmd.
 func Execute() {
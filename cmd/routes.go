@@ -0,0 +1,88 @@
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"gs/config"
+	"gs/service"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/spf13/cobra"
+)
+
+var routesCmd = &cobra.Command{
+	Use:   "routes",
+	Short: "List the http routes and grpc methods of all the services",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if b, _ := cmd.Flags().GetBool("debug"); b {
+			logrus.SetLevel(logrus.DebugLevel)
+		} else {
+			// the warnings are written to stderr so they do not mix with the output
+			logrus.SetLevel(logrus.WarnLevel)
+		}
+		asJson, _ := cmd.Flags().GetBool("json")
+		return listRoutes(asJson, args...)
+	},
+}
+
+func init() {
+	routesCmd.Flags().Bool("json", false, "print the routes as json")
+	rootCmd.AddCommand(routesCmd)
+}
+
+func listRoutes(asJson bool, services ...string) error {
+	cfg, err := config.Read()
+	if err != nil {
+		return err
+	}
+	selected := selectServices(cfg, services)
+	names := config.ServiceNames(selected)
+
+	routes := []service.Route{}
+	for _, name := range names {
+		svc, err := service.Parse(name, selected[name], cfg.Module)
+		if err != nil {
+			return err
+		}
+		// the rules are only needed to generate, `gs lint` shows where they are
+		if err := svc.CheckValidations(); err != nil {
+			logrus.Warn(err)
+		}
+		routes = append(routes, svc.Routes()...)
+	}
+
+	if asJson {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(routes)
+	}
+	w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	_, _ = fmt.Fprintln(w, "SERVICE\tENDPOINT\tMETHODS\tROUTE\tREQUEST\tRESPONSE\tHTTP ADDRESS\tGRPC METHOD\tGRPC ADDRESS")
+	for _, r := range routes {
+		_, _ = fmt.Fprintf(
+			w,
+			"%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\t%s\n",
+			r.Service,
+			r.Endpoint,
+			orDash(strings.Join(r.Methods, ",")),
+			orDash(r.Route),
+			orDash(r.RequestFormat),
+			orDash(r.ResponseFormat),
+			orDash(r.HttpAddress),
+			orDash(r.GRPCMethod),
+			orDash(r.GRPCAddress),
+		)
+	}
+	return w.Flush()
+}
+
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
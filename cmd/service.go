@@ -20,10 +20,12 @@ var serviceCmd = &cobra.Command{
 		if b, _ := cmd.Flags().GetBool("debug"); b {
 			logrus.SetLevel(logrus.DebugLevel)
 		}
-		return service.GenerateNew(args[0])
+		folder, _ := cmd.Flags().GetString("path")
+		return service.GenerateNew(args[0], folder)
 	},
 }
 
 func init() {
+	serviceCmd.Flags().String("path", "", "the folder of the service, defaults to the service name")
 	newCmd.AddCommand(serviceCmd)
 }
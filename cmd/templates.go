@@ -0,0 +1,94 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"gs/config"
+	"gs/template"
+
+	"github.com/sirupsen/logrus"
+
+	"github.com/spf13/cobra"
+)
+
+// the folder the templates are ejected to if the project does not configure one
+const defaultTemplates = ".gs/templates"
+
+var templatesCmd = &cobra.Command{
+	Use: "templates",
+	Aliases: []string{
+		"tpl",
+	},
+	Short: "Manage the project templates that override the built-in templates",
+	RunE: func(cmd *cobra.Command, args []string) error {
+		return cmd.Help()
+	},
+}
+
+var templatesEjectCmd = &cobra.Command{
+	Use:   "eject",
+	Short: "Copy built-in templates to the project so they can be customized",
+	Long: "Copy built-in templates to the project so they can be customized, " +
+		"the path can be a template (e.g service/gen/gen.jet) or a folder of templates (e.g service/gen/transport/http).",
+	Args: cobra.MinimumNArgs(1),
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if b, _ := cmd.Flags().GetBool("debug"); b {
+			logrus.SetLevel(logrus.DebugLevel)
+		}
+		return ejectTemplates(args...)
+	},
+}
+
+var templatesDiffCmd = &cobra.Command{
+	Use:   "diff",
+	Short: "Show how the project templates differ from the built-in templates",
+	Args:  cobra.NoArgs,
+	RunE: func(cmd *cobra.Command, args []string) error {
+		if b, _ := cmd.Flags().GetBool("debug"); b {
+			logrus.SetLevel(logrus.DebugLevel)
+		}
+		cfg, err := config.Read()
+		if err != nil {
+			return err
+		}
+		if cfg.Templates == "" {
+			return errors.New("the project has no templates, run `gs templates eject` first")
+		}
+		diff, err := template.Diff(cfg.Templates)
+		if err != nil {
+			return err
+		}
+		fmt.Print(diff)
+		return nil
+	},
+}
+
+func init() {
+	templatesCmd.AddCommand(templatesEjectCmd)
+	templatesCmd.AddCommand(templatesDiffCmd)
+	rootCmd.AddCommand(templatesCmd)
+}
+
+func ejectTemplates(names ...string) error {
+	cfg, err := config.Read()
+	if err != nil {
+		return err
+	}
+	if cfg.Templates == "" {
+		cfg.Templates = defaultTemplates
+		if err := config.Write(*cfg); err != nil {
+			return err
+		}
+		logrus.Infof("Using `%s` for the project templates", cfg.Templates)
+	}
+	for _, name := range names {
+		ejected, err := template.Eject(cfg.Templates, name)
+		for _, pth := range ejected {
+			logrus.Infof("Ejected %s", pth)
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -16,15 +16,17 @@ var watchCmd = &cobra.Command{
 			logrus.SetLevel(logrus.DebugLevel)
 		}
 		p, _ := cmd.Flags().GetInt("port")
-		if err := generateServices(); err != nil {
+		profile, _ := cmd.Flags().GetString("profile")
+		if err := generateServices(profile); err != nil {
 			return err
 		}
-		watch.Run(p)
+		watch.Run(p, profile)
 		return nil
 	},
 }
 
 func init() {
 	watchCmd.Flags().IntP("port", "p", 8888, "the port to run the proxy")
+	watchCmd.Flags().String("profile", "", "the profile in gs.toml used to override the service addresses")
 	rootCmd.AddCommand(watchCmd)
 }
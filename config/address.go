@@ -0,0 +1,91 @@
+package config
+
+import (
+	"fmt"
+)
+
+func (a AddressConfig) String() string {
+	return fmt.Sprintf("%s:%d", a.Url, a.Port)
+}
+
+// conflicts checks if two addresses would listen on the same port,
+// an empty url listens on all the interfaces so it conflicts with any url.
+func (a AddressConfig) conflicts(other AddressConfig) bool {
+	if a.Port == 0 || a.Port != other.Port {
+		return false
+	}
+	return a.Url == other.Url || isWildcard(a.Url) || isWildcard(other.Url)
+}
+
+func isWildcard(url string) bool {
+	return url == "" || url == "0.0.0.0"
+}
+
+// addresses returns all the addresses of the service keyed by the address kind.
+func (s ServiceConfig) addresses() map[string]AddressConfig {
+	return map[string]AddressConfig{
+		HTTP:    s.Http,
+		GRPC:    s.Grpc,
+		"debug": s.Debug,
+	}
+}
+
+// CheckAddresses returns an error if two addresses in the configuration use the same port.
+func (c *GSConfig) CheckAddresses() error {
+	type namedAddress struct {
+		name    string
+		address AddressConfig
+	}
+	names := ServiceNames(c.Services)
+
+	var seen []namedAddress
+	for _, name := range names {
+		svc := c.Services[name]
+		if !svc.IsEnabled() {
+			continue
+		}
+		addresses := svc.addresses()
+		for _, kind := range []string{HTTP, GRPC, "debug"} {
+			// the address of a disabled transport is never used
+			if kind != "debug" && !svc.HasTransport(kind) {
+				continue
+			}
+			current := namedAddress{
+				name:    fmt.Sprintf("%s.%s", name, kind),
+				address: addresses[kind],
+			}
+			for _, other := range seen {
+				if current.address.conflicts(other.address) {
+					return fmt.Errorf(
+						"address `%s` of `%s` conflicts with address `%s` of `%s`",
+						current.address,
+						current.name,
+						other.address,
+						other.name,
+					)
+				}
+			}
+			seen = append(seen, current)
+		}
+	}
+	return nil
+}
+
+// FreePort returns the lowest port starting from start that is not used by any
+// address of any service and is not one of the reserved ports.
+func (c *GSConfig) FreePort(start int, reserved ...int) int {
+	used := map[int]bool{}
+	for _, port := range reserved {
+		used[port] = true
+	}
+	for _, svc := range c.Services {
+		for _, address := range svc.addresses() {
+			used[address.Port] = true
+		}
+	}
+	port := start
+	for used[port] {
+		port++
+	}
+	return port
+}
@@ -0,0 +1,50 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGSConfig_FreePort(t *testing.T) {
+	cfg := &GSConfig{
+		Services: map[string]ServiceConfig{
+			"a": {
+				Http:  AddressConfig{Port: 8000},
+				Grpc:  AddressConfig{Port: 8002},
+				Debug: AddressConfig{Port: 3000},
+			},
+			"b": {
+				Http: AddressConfig{Port: 8001},
+			},
+		},
+	}
+	assert.Equal(t, 8003, cfg.FreePort(8000), "should skip ports of all address kinds")
+	assert.Equal(t, 3001, cfg.FreePort(3000), "should skip used ports")
+	assert.Equal(t, 2001, cfg.FreePort(2000, 2000), "should skip reserved ports")
+}
+
+func TestGSConfig_CheckAddresses(t *testing.T) {
+	cfg := &GSConfig{
+		Services: map[string]ServiceConfig{
+			"a": {Http: AddressConfig{Port: 8000}},
+			"b": {Http: AddressConfig{Port: 8001}, Grpc: AddressConfig{Url: "localhost", Port: 2000}},
+		},
+	}
+	assert.Nil(t, cfg.CheckAddresses(), "should be nil")
+
+	cfg.Services["c"] = ServiceConfig{Debug: AddressConfig{Port: 2000}}
+	assert.NotNil(t, cfg.CheckAddresses(), "empty url should conflict with any url")
+}
+
+func TestGSConfig_CheckAddressesDisabled(t *testing.T) {
+	disabled := false
+	cfg := &GSConfig{
+		Services: map[string]ServiceConfig{
+			"a": {Http: AddressConfig{Port: 8000}, Grpc: AddressConfig{Port: 2000}, Transports: []string{"http"}},
+			"b": {Http: AddressConfig{Port: 8000}, Enabled: &disabled},
+			"c": {Grpc: AddressConfig{Port: 2000}, Debug: AddressConfig{Port: 3000}},
+		},
+	}
+	assert.Nil(t, cfg.CheckAddresses(), "disabled services and transports should be ignored")
+}
@@ -2,9 +2,12 @@ package config
 
 import (
 	"bytes"
-	"errors"
+	"fmt"
 	"gs/fs"
+	"path"
+	"sort"
 	"strconv"
+	"strings"
 
 	"github.com/pelletier/go-toml"
 
@@ -18,16 +21,96 @@ type AddressConfig struct {
 	Port int    `toml:"port"`
 }
 
+const (
+	HTTP = "http"
+	GRPC = "grpc"
+)
+
 type ServiceConfig struct {
 	Http  AddressConfig `toml:"http"`
 	Grpc  AddressConfig `toml:"grpc"`
 	Debug AddressConfig `toml:"debug"`
+
+	// Path is the folder of the service relative to the project root, defaults to the service name.
+	Path string `toml:"path,omitempty"`
+	// Interface is the name of the service interface, it only needs to be set
+	// if the service package has more than one @service() interface.
+	Interface string `toml:"interface,omitempty"`
+	// Enabled can be set to false to stop generating and building the service.
+	Enabled *bool `toml:"enabled,omitempty"`
+	// Transports are the transports that are generated, if it is empty all the transports are generated.
+	Transports []string `toml:"transports,omitempty"`
+
+	// the defaults for the endpoints that do not set them in the annotations
+	RequestFormat     string `toml:"request_format,omitempty"`
+	ResponseFormat    string `toml:"response_format,omitempty"`
+	KeepTrailingSlash bool   `toml:"keep_trailing_slash,omitempty"`
+	ProtoPackage      string `toml:"proto_package,omitempty"`
+}
+
+// PluginConfig is an external generator that receives the service model and returns the files to generate.
+type PluginConfig struct {
+	Cmd  string   `toml:"cmd"`
+	Args []string `toml:"args,omitempty"`
+	// Out is the folder the files are written to relative to the service folder, defaults to `gen`.
+	Out string `toml:"out,omitempty"`
+}
+
+// Folder returns the folder of the service relative to the project root.
+func (s ServiceConfig) Folder(name string) string {
+	if s.Path == "" {
+		return name
+	}
+	return path.Clean(s.Path)
+}
+
+// IsEnabled checks if the service should be generated and built.
+func (s ServiceConfig) IsEnabled() bool {
+	return s.Enabled == nil || *s.Enabled
+}
+
+// HasTransport checks if the transport is enabled for the service.
+func (s ServiceConfig) HasTransport(transport string) bool {
+	if len(s.Transports) == 0 {
+		return true
+	}
+	for _, t := range s.Transports {
+		if strings.ToLower(t) == transport {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckTransports checks that all the configured transports are known.
+func (s ServiceConfig) CheckTransports() error {
+	for _, t := range s.Transports {
+		if tp := strings.ToLower(t); tp != HTTP && tp != GRPC {
+			return fmt.Errorf("unknown transport `%s`, the supported transports are `%s` and `%s`", t, HTTP, GRPC)
+		}
+	}
+	return nil
+}
+
+// ServiceNames returns the sorted names of the services.
+func ServiceNames(services map[string]ServiceConfig) []string {
+	var names []string
+	for name := range services {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
 }
 
 type GSConfig struct {
-	Module          string                   `toml:"-"`
-	WatchExtensions []string                 `toml:"watch_extensions"`
-	Services        map[string]ServiceConfig `toml:"services"`
+	Version         int      `toml:"version"`
+	Module          string   `toml:"-"`
+	WatchExtensions []string `toml:"watch_extensions"`
+	// Templates is the project folder with the templates that override the embedded ones.
+	Templates string                   `toml:"templates,omitempty"`
+	Services  map[string]ServiceConfig `toml:"services"`
+	Plugins   map[string]PluginConfig  `toml:"plugins,omitempty"`
+	Profiles  map[string]ProfileConfig `toml:"profiles,omitempty"`
 }
 
 func Read() (*GSConfig, error) {
@@ -36,18 +119,27 @@ func Read() (*GSConfig, error) {
 	if err != nil {
 		return nil, err
 	}
-	cfg := &GSConfig{
-		Services: make(map[string]ServiceConfig),
-	}
-	err = toml.Unmarshal([]byte(gs), cfg)
+	cfg, err := parse(gs)
 	if err != nil {
 		return nil, err
 	}
+	if cfg.Version < CurrentVersion {
+		warnOnce("gs.toml uses an older layout, run `gs config migrate` to upgrade it")
+	}
+	cfg.warnMissingFolders()
 	cfg.Module, err = ReadModule()
+	if err != nil {
+		// the root of a go.work workspace does not need to be a module,
+		// the services read their module from their own go.mod
+		if b, _ := fs.Exists("go.work"); b {
+			return cfg, nil
+		}
+	}
 	return cfg, err
 }
 
 func Write(config GSConfig) error {
+	config.Version = CurrentVersion
 	encoded, err := encode(config)
 	if err != nil {
 		return err
@@ -62,13 +154,18 @@ func encode(config GSConfig) (string, error) {
 }
 
 func ReadModule() (string, error) {
-	mod, err := fs.ReadFile("go.mod")
+	return ReadModuleFile("go.mod")
+}
+
+// ReadModuleFile reads the module name from the go.mod file.
+func ReadModuleFile(file string) (string, error) {
+	mod, err := fs.ReadFile(file)
 	if err != nil {
 		return "", err
 	}
 	module := modulePath([]byte(mod))
 	if module == "" {
-		return "", errors.New("could not read the module name")
+		return "", fmt.Errorf("could not read the module name from `%s`", file)
 	}
 	return module, nil
 }
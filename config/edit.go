@@ -0,0 +1,299 @@
+package config
+
+import (
+	"fmt"
+	"gs/fs"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/pelletier/go-toml"
+)
+
+// The gs.toml source is edited line by line so the comments and the layout of the file are preserved,
+// only standard tables and `key = value` lines are supported which is what gs writes.
+var (
+	tableLineRegex    = regexp.MustCompile(`^\s*\[\s*([^\[\]]+?)\s*\]\s*(#.*)?$`)
+	keyValueLineRegex = regexp.MustCompile(`^(\s*)("[^"]*"|[A-Za-z0-9_-]+)\s*=\s*`)
+	bareKeyRegex      = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+)
+
+type sourceLine struct {
+	table []string
+	// key is empty if the line is not a key value line
+	key    string
+	indent string
+}
+
+func splitKey(key string) (parts []string) {
+	for _, part := range strings.Split(key, ".") {
+		parts = append(parts, strings.Trim(strings.TrimSpace(part), `"`))
+	}
+	return parts
+}
+
+func joinKey(parts []string) string {
+	var quoted []string
+	for _, part := range parts {
+		if !bareKeyRegex.MatchString(part) {
+			part = strconv.Quote(part)
+		}
+		quoted = append(quoted, part)
+	}
+	return strings.Join(quoted, ".")
+}
+
+func equalKeys(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func scanLines(lines []string) []sourceLine {
+	var table []string
+	scanned := make([]sourceLine, len(lines))
+	for i, line := range lines {
+		if match := tableLineRegex.FindStringSubmatch(line); match != nil {
+			table = splitKey(match[1])
+		} else if match := keyValueLineRegex.FindStringSubmatch(line); match != nil {
+			scanned[i].key = strings.Trim(match[2], `"`)
+			scanned[i].indent = match[1]
+		}
+		scanned[i].table = table
+	}
+	return scanned
+}
+
+// valueEnd returns the index where the value of the key value line ends.
+func valueEnd(value string) int {
+	switch {
+	case strings.HasPrefix(value, `"`):
+		for i := 1; i < len(value); i++ {
+			if value[i] == '\\' {
+				i++
+			} else if value[i] == '"' {
+				return i + 1
+			}
+		}
+	case strings.HasPrefix(value, "'"):
+		if i := strings.Index(value[1:], "'"); i >= 0 {
+			return i + 2
+		}
+	case strings.HasPrefix(value, "["):
+		if i := strings.LastIndex(value, "]"); i >= 0 {
+			return i + 1
+		}
+	default:
+		if i := strings.Index(value, "#"); i >= 0 {
+			return i
+		}
+	}
+	return len(value)
+}
+
+// tomlValue returns the value as it needs to be written in toml, values that
+// are not valid toml (e.x `localhost`) are written as strings.
+func tomlValue(value string) string {
+	if _, err := toml.Load("v = " + value); err == nil {
+		return value
+	}
+	return strconv.Quote(value)
+}
+
+// GetValue returns the value of the dotted key in the gs.toml source.
+func GetValue(data, key string) (interface{}, error) {
+	tree, err := toml.LoadBytes([]byte(data))
+	if err != nil {
+		return nil, fmt.Errorf("invalid gs.toml: %s", err)
+	}
+	value := tree.GetPath(splitKey(key))
+	if value == nil {
+		return nil, fmt.Errorf("key `%s` is not set", key)
+	}
+	return value, nil
+}
+
+// SetValue sets the dotted key in the gs.toml source and returns the new source,
+// the rest of the source including the comments is kept as it is.
+func SetValue(data, key, value string) (string, error) {
+	parts := splitKey(key)
+	table, name := parts[:len(parts)-1], parts[len(parts)-1]
+	value = tomlValue(value)
+
+	lines := strings.Split(data, "\n")
+	scanned := scanLines(lines)
+	tableEnd, indent := -1, ""
+	for i, line := range scanned {
+		if !equalKeys(line.table, table) {
+			continue
+		}
+		tableEnd = i
+		if line.key == "" {
+			continue
+		}
+		indent = line.indent
+		if line.key != name {
+			continue
+		}
+		prefix := keyValueLineRegex.FindString(lines[i])
+		rest := lines[i][len(prefix):]
+		comment := strings.TrimSpace(rest[valueEnd(rest):])
+		lines[i] = prefix + value
+		if comment != "" {
+			lines[i] += " " + comment
+		}
+		return strings.Join(lines, "\n"), nil
+	}
+
+	newLine := fmt.Sprintf("%s = %s", joinKey([]string{name}), value)
+	if tableEnd == -1 && len(table) > 0 {
+		data = strings.TrimRight(data, "\n")
+		return fmt.Sprintf("%s\n\n[%s]\n  %s\n", data, joinKey(table), newLine), nil
+	}
+	if len(table) == 0 {
+		// top level keys need to be before the first table, after the top level keys and comments
+		tableEnd = -1
+		for i, line := range scanned {
+			if len(line.table) > 0 {
+				break
+			}
+			if line.key != "" || strings.HasPrefix(strings.TrimSpace(lines[i]), "#") {
+				tableEnd = i
+			}
+		}
+	} else {
+		// do not add the key after the empty lines at the end of the table
+		for tableEnd > 0 && strings.TrimSpace(lines[tableEnd]) == "" {
+			tableEnd--
+		}
+		if indent == "" && strings.HasPrefix(lines[tableEnd], " ") {
+			indent = lines[tableEnd][:len(lines[tableEnd])-len(strings.TrimLeft(lines[tableEnd], " \t"))]
+			indent += "  "
+		}
+	}
+	lines = append(lines[:tableEnd+1], append([]string{indent + newLine}, lines[tableEnd+1:]...)...)
+	return strings.Join(lines, "\n"), nil
+}
+
+// deleteValue removes the dotted key from the gs.toml source.
+func deleteValue(data, key string) string {
+	parts := splitKey(key)
+	table, name := parts[:len(parts)-1], parts[len(parts)-1]
+	lines := strings.Split(data, "\n")
+	for i, line := range scanLines(lines) {
+		if line.key == name && equalKeys(line.table, table) {
+			return strings.Join(append(lines[:i], lines[i+1:]...), "\n")
+		}
+	}
+	return data
+}
+
+// Set sets the dotted key in gs.toml keeping the comments, the result needs to be a valid configuration.
+func Set(key, value string) error {
+	data, err := fs.ReadFile("gs.toml")
+	if err != nil {
+		return err
+	}
+	data, err = SetValue(data, key, value)
+	if err != nil {
+		return err
+	}
+	if _, err := parse(data); err != nil {
+		return err
+	}
+	return fs.WriteFile("gs.toml", data)
+}
+
+// Get returns the value of the dotted key in gs.toml.
+func Get(key string) (interface{}, error) {
+	data, err := fs.ReadFile("gs.toml")
+	if err != nil {
+		return nil, err
+	}
+	return GetValue(data, key)
+}
+
+// serviceTableIndex returns the index of the service name in the table key if the table belongs
+// to the service, this is the service table or the service table of a profile. It returns -1
+// if the table does not belong to the service.
+func serviceTableIndex(table []string, name string) int {
+	if len(table) >= 2 && table[0] == "services" && table[1] == name {
+		return 1
+	}
+	if len(table) >= 4 && table[0] == "profiles" && table[2] == "services" && table[3] == name {
+		return 3
+	}
+	return -1
+}
+
+// removeService removes the tables of the service from the gs.toml source,
+// the comments right before a table are removed with the table.
+func removeService(data, name string) string {
+	lines := strings.Split(data, "\n")
+	scanned := scanLines(lines)
+	// the comments right before a table belong to the table
+	for i := range scanned {
+		if !tableLineRegex.MatchString(lines[i]) {
+			continue
+		}
+		for j := i - 1; j >= 0 && strings.HasPrefix(strings.TrimSpace(lines[j]), "#"); j-- {
+			scanned[j].table = scanned[i].table
+		}
+	}
+	var kept []string
+	for i, line := range scanned {
+		if serviceTableIndex(line.table, name) == -1 {
+			kept = append(kept, lines[i])
+		}
+	}
+	return strings.TrimRight(strings.Join(kept, "\n"), "\n") + "\n"
+}
+
+// renameService renames the tables of the service in the gs.toml source.
+func renameService(data, oldName, newName string) string {
+	lines := strings.Split(data, "\n")
+	for i, line := range scanLines(lines) {
+		index := serviceTableIndex(line.table, oldName)
+		match := tableLineRegex.FindStringSubmatchIndex(lines[i])
+		if index == -1 || match == nil {
+			continue
+		}
+		table := append([]string{}, line.table...)
+		table[index] = newName
+		lines[i] = lines[i][:match[2]] + joinKey(table) + lines[i][match[3]:]
+	}
+	return strings.Join(lines, "\n")
+}
+
+// RemoveService removes the service and its profile addresses from gs.toml keeping the comments.
+func RemoveService(name string) error {
+	return edit(func(data string) string {
+		return removeService(data, name)
+	})
+}
+
+// RenameService renames the service and its profile addresses in gs.toml keeping the comments.
+func RenameService(oldName, newName string) error {
+	return edit(func(data string) string {
+		return renameService(data, oldName, newName)
+	})
+}
+
+// edit changes the gs.toml source, the result needs to be a valid configuration.
+func edit(change func(data string) string) error {
+	data, err := fs.ReadFile("gs.toml")
+	if err != nil {
+		return err
+	}
+	data = change(data)
+	if _, err := parse(data); err != nil {
+		return err
+	}
+	return fs.WriteFile("gs.toml", data)
+}
@@ -0,0 +1,132 @@
+package config
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testSource = `# the project configuration
+watch_extensions = []
+
+[services]
+
+  [services.billing]
+
+    [services.billing.http]
+      port = 8000 # the public port
+      url = ""
+`
+
+func TestSetValue(t *testing.T) {
+	data, err := SetValue(testSource, "services.billing.http.port", "9000")
+	assert.Nil(t, err, "should be nil")
+	assert.Contains(t, data, "      port = 9000 # the public port\n", "should keep the comment")
+
+	data, err = SetValue(data, "services.billing.http.url", "localhost")
+	assert.Nil(t, err, "should be nil")
+	assert.Contains(t, data, `      url = "localhost"`, "should quote strings")
+
+	data, err = SetValue(data, "services.billing.grpc.port", "2000")
+	assert.Nil(t, err, "should be nil")
+	assert.Contains(t, data, "\n[services.billing.grpc]\n  port = 2000\n", "should add missing tables")
+
+	data, err = SetValue(data, "templates", ".gs/templates")
+	assert.Nil(t, err, "should be nil")
+	assert.Contains(t, data, "watch_extensions = []\ntemplates = \".gs/templates\"\n", "should add top level keys before the tables")
+
+	cfg, err := parse(data)
+	assert.Nil(t, err, "should be nil")
+	assert.Equal(t, AddressConfig{Url: "localhost", Port: 9000}, cfg.Services["billing"].Http)
+	assert.Equal(t, 2000, cfg.Services["billing"].Grpc.Port)
+}
+
+func TestParseUnknownKeys(t *testing.T) {
+	_, err := parse("version = 1\n[services.billing.htp]\nport = 8000\n")
+	assert.EqualError(t, err, "invalid gs.toml:\n  line 2: unknown key `services.billing.htp`, did you mean `http`?")
+
+	_, err = parse("version = 1\n[services.billing.http]\nport = 80000\n")
+	assert.EqualError(t, err, "invalid gs.toml:\n  port 80000 of `services.billing.http` is out of range, use 1-65535 or leave it unset")
+
+	_, err = parse("version = 1\n[services.billing.http]\nport = 0\n")
+	assert.NoError(t, err, "port 0 is not set")
+}
+
+func TestParsePaths(t *testing.T) {
+	cfg, err := parse("version = 1\n[services.billing]\npath = \"services/billing/api/\"\n")
+	assert.NoError(t, err)
+	assert.Equal(t, "services/billing/api", cfg.Services["billing"].Folder("billing"))
+	assert.Equal(t, "invoice", cfg.Services["invoice"].Folder("invoice"))
+
+	_, err = parse("version = 1\n[services.billing]\npath = \"../billing\"\n")
+	assert.EqualError(t, err, "invalid gs.toml:\n  path `../billing` of `services.billing` must be a folder inside the project")
+
+	_, err = parse("version = 1\n[services.billing]\npath = \"invoice\"\n[services.invoice]\n")
+	assert.EqualError(t, err, "invalid gs.toml:\n  services `billing` and `invoice` use the same folder `invoice`")
+}
+
+func TestMigrateSource(t *testing.T) {
+	data, changes, err := MigrateSource("# my project\nmodule = \"abc\"\nwatch_extensions = []\n")
+	assert.Nil(t, err, "should be nil")
+	assert.Len(t, changes, 2)
+	assert.Equal(t, "# my project\nwatch_extensions = []\nversion = 1\n", data)
+}
+
+const servicesSource = `version = 1
+
+[services]
+
+  # the billing service
+  [services.billing]
+    path = "services/billing"
+
+    [services.billing.http]
+      port = 8000 # the public port
+
+  # the users service
+  [services.users]
+
+    [services.users.http]
+      port = 8001
+
+[profiles]
+
+  [profiles.docker.services.billing.http]
+    url = "billing"
+`
+
+func TestRemoveService(t *testing.T) {
+	data := removeService(servicesSource, "billing")
+	assert.Equal(t, `version = 1
+
+[services]
+
+  # the users service
+  [services.users]
+
+    [services.users.http]
+      port = 8001
+
+[profiles]
+`, data)
+	_, err := parse(data)
+	assert.Nil(t, err, "should be nil")
+
+	data = removeService(servicesSource, "users")
+	assert.NotContains(t, data, "users")
+	assert.Contains(t, data, "[profiles.docker.services.billing.http]", "should keep the other services")
+}
+
+func TestRenameService(t *testing.T) {
+	data := renameService(servicesSource, "billing", "payments")
+	assert.NotContains(t, data, "[services.billing")
+	assert.Contains(t, data, "  # the billing service\n  [services.payments]\n")
+	assert.Contains(t, data, "    [services.payments.http]\n      port = 8000 # the public port\n")
+	assert.Contains(t, data, "  [profiles.docker.services.payments.http]\n")
+	assert.Contains(t, data, "  [services.users]\n", "should not rename the other services")
+
+	cfg, err := parse(data)
+	assert.Nil(t, err, "should be nil")
+	assert.Equal(t, "services/billing", cfg.Services["payments"].Path)
+	assert.Equal(t, 8000, cfg.Services["payments"].Http.Port)
+}
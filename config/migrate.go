@@ -0,0 +1,82 @@
+package config
+
+import (
+	"fmt"
+	"gs/fs"
+	"strconv"
+
+	"github.com/pelletier/go-toml"
+)
+
+// migration upgrades the gs.toml source from the version before to the version of the migration,
+// it returns the new source and a description of the changes.
+type migration struct {
+	version int
+	migrate func(data string) (string, []string, error)
+}
+
+var migrations = []migration{
+	{
+		version: 1,
+		// the first layouts kept the module in the configuration, it is read from go.mod now
+		migrate: func(data string) (string, []string, error) {
+			tree, err := toml.LoadBytes([]byte(data))
+			if err != nil {
+				return "", nil, err
+			}
+			if !tree.Has("module") {
+				return data, nil, nil
+			}
+			return deleteValue(data, "module"), []string{"removed `module`, the module is read from go.mod"}, nil
+		},
+	},
+}
+
+// Migrate upgrades gs.toml to the current version keeping the comments, it returns the changes that were made.
+func Migrate() ([]string, error) {
+	data, err := fs.ReadFile("gs.toml")
+	if err != nil {
+		return nil, err
+	}
+	data, changes, err := MigrateSource(data)
+	if err != nil || len(changes) == 0 {
+		return changes, err
+	}
+	if _, err := parse(data); err != nil {
+		return nil, err
+	}
+	return changes, fs.WriteFile("gs.toml", data)
+}
+
+// MigrateSource upgrades the gs.toml source to the current version.
+func MigrateSource(data string) (string, []string, error) {
+	tree, err := toml.LoadBytes([]byte(data))
+	if err != nil {
+		return "", nil, fmt.Errorf("invalid gs.toml: %s", err)
+	}
+	version := 0
+	if v, ok := tree.Get("version").(int64); ok {
+		version = int(v)
+	}
+	if version > CurrentVersion {
+		return "", nil, fmt.Errorf("gs.toml has version %d but this gs only supports up to version %d", version, CurrentVersion)
+	}
+	var changes []string
+	for _, m := range migrations {
+		if m.version <= version {
+			continue
+		}
+		var migrationChanges []string
+		data, migrationChanges, err = m.migrate(data)
+		if err != nil {
+			return "", nil, fmt.Errorf("migrating to version %d: %s", m.version, err)
+		}
+		data, err = SetValue(data, "version", strconv.Itoa(m.version))
+		if err != nil {
+			return "", nil, err
+		}
+		changes = append(changes, migrationChanges...)
+		changes = append(changes, fmt.Sprintf("set version to %d", m.version))
+	}
+	return data, changes, nil
+}
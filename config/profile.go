@@ -0,0 +1,65 @@
+package config
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// ProfileConfig overrides the addresses of the services, only the values that are set are overridden.
+type ProfileConfig struct {
+	Services map[string]ProfileServiceConfig `toml:"services"`
+}
+
+type ProfileServiceConfig struct {
+	Http  AddressConfig `toml:"http"`
+	Grpc  AddressConfig `toml:"grpc"`
+	Debug AddressConfig `toml:"debug"`
+}
+
+// ReadProfile reads the configuration and applies the profile, if the profile is empty
+// the configuration is returned as it is.
+func ReadProfile(profile string) (*GSConfig, error) {
+	cfg, err := Read()
+	if err != nil {
+		return nil, err
+	}
+	if profile == "" {
+		return cfg, nil
+	}
+	return cfg, cfg.ApplyProfile(profile)
+}
+
+// ApplyProfile overrides the service addresses with the addresses of the profile.
+func (c *GSConfig) ApplyProfile(profile string) error {
+	p, ok := c.Profiles[profile]
+	if !ok {
+		var names []string
+		for name := range c.Profiles {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return fmt.Errorf("profile `%s` does not exist, the available profiles are: [%s]", profile, strings.Join(names, ", "))
+	}
+	for name, override := range p.Services {
+		svc, ok := c.Services[name]
+		if !ok {
+			return fmt.Errorf("profile `%s` overrides service `%s` that does not exist", profile, name)
+		}
+		svc.Http = svc.Http.override(override.Http)
+		svc.Grpc = svc.Grpc.override(override.Grpc)
+		svc.Debug = svc.Debug.override(override.Debug)
+		c.Services[name] = svc
+	}
+	return nil
+}
+
+func (a AddressConfig) override(other AddressConfig) AddressConfig {
+	if other.Url != "" {
+		a.Url = other.Url
+	}
+	if other.Port != 0 {
+		a.Port = other.Port
+	}
+	return a
+}
@@ -0,0 +1,231 @@
+package config
+
+import (
+	"fmt"
+	"gs/fs"
+	"path"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/pelletier/go-toml"
+)
+
+// CurrentVersion is the version of the gs.toml layout this gs understands,
+// gs.toml files without a version are from before the layout was versioned.
+const CurrentVersion = 1
+
+// keys that older layouts used and are ignored until the file is migrated
+var legacyKeys = map[string]bool{
+	"module": true,
+}
+
+// ValidationError has all the problems found in gs.toml.
+type ValidationError struct {
+	Problems []string
+}
+
+func (e *ValidationError) Error() string {
+	return "invalid gs.toml:\n  " + strings.Join(e.Problems, "\n  ")
+}
+
+// parse decodes and validates the gs.toml source.
+func parse(data string) (*GSConfig, error) {
+	tree, err := toml.LoadBytes([]byte(data))
+	if err != nil {
+		return nil, fmt.Errorf("invalid gs.toml: %s", err)
+	}
+	cfg := &GSConfig{
+		Services: make(map[string]ServiceConfig),
+	}
+	if err := tree.Unmarshal(cfg); err != nil {
+		return nil, fmt.Errorf("invalid gs.toml: %s", err)
+	}
+	if cfg.Version > CurrentVersion {
+		return nil, fmt.Errorf(
+			"gs.toml has version %d but this gs only supports up to version %d, please update gs",
+			cfg.Version,
+			CurrentVersion,
+		)
+	}
+	var problems []string
+	problems = append(problems, unknownKeys(tree, reflect.TypeOf(GSConfig{}), nil, cfg.Version < CurrentVersion)...)
+	problems = append(problems, cfg.checkPorts()...)
+	problems = append(problems, cfg.checkPaths()...)
+	if len(problems) > 0 {
+		return nil, &ValidationError{Problems: problems}
+	}
+	return cfg, nil
+}
+
+// unknownKeys returns a problem for each key of the tree that does not have a field in the type.
+func unknownKeys(tree *toml.Tree, tp reflect.Type, path []string, legacy bool) (problems []string) {
+	fields := map[string]reflect.Type{}
+	for i := 0; i < tp.NumField(); i++ {
+		name := strings.Split(tp.Field(i).Tag.Get("toml"), ",")[0]
+		if name == "" || name == "-" {
+			continue
+		}
+		fields[name] = tp.Field(i).Type
+	}
+	keys := tree.Keys()
+	sort.Strings(keys)
+	for _, key := range keys {
+		keyPath := append(append([]string{}, path...), key)
+		fieldType, ok := fields[key]
+		if !ok {
+			if legacy && len(path) == 0 && legacyKeys[key] {
+				continue
+			}
+			problem := fmt.Sprintf("line %d: unknown key `%s`", tree.GetPosition(key).Line, strings.Join(keyPath, "."))
+			if suggestion := closestKey(key, fields); suggestion != "" {
+				problem += fmt.Sprintf(", did you mean `%s`?", suggestion)
+			}
+			problems = append(problems, problem)
+			continue
+		}
+		subTree, ok := tree.Get(key).(*toml.Tree)
+		if !ok {
+			continue
+		}
+		for fieldType.Kind() == reflect.Ptr {
+			fieldType = fieldType.Elem()
+		}
+		switch fieldType.Kind() {
+		case reflect.Struct:
+			problems = append(problems, unknownKeys(subTree, fieldType, keyPath, legacy)...)
+		case reflect.Map:
+			elem := fieldType.Elem()
+			if elem.Kind() != reflect.Struct {
+				continue
+			}
+			names := subTree.Keys()
+			sort.Strings(names)
+			for _, name := range names {
+				if t, ok := subTree.Get(name).(*toml.Tree); ok {
+					problems = append(problems, unknownKeys(t, elem, append(keyPath, name), legacy)...)
+				}
+			}
+		}
+	}
+	return problems
+}
+
+// closestKey returns the known key that is the closest to the misspelled key.
+func closestKey(key string, fields map[string]reflect.Type) string {
+	best, bestDistance := "", 3
+	for name := range fields {
+		if d := distance(strings.ToLower(key), strings.ToLower(name)); d < bestDistance || d == bestDistance && name < best {
+			best, bestDistance = name, d
+		}
+	}
+	return best
+}
+
+// distance is the levenshtein distance of the two strings.
+func distance(a, b string) int {
+	previous := make([]int, len(b)+1)
+	for j := range previous {
+		previous[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		current := make([]int, len(b)+1)
+		current[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			current[j] = minInt(previous[j]+1, current[j-1]+1, previous[j-1]+cost)
+		}
+		previous = current
+	}
+	return previous[len(b)]
+}
+
+func minInt(values ...int) int {
+	m := values[0]
+	for _, v := range values[1:] {
+		if v < m {
+			m = v
+		}
+	}
+	return m
+}
+
+func (c *GSConfig) checkPorts() (problems []string) {
+	check := func(name string, addresses map[string]AddressConfig) {
+		for _, kind := range []string{HTTP, GRPC, "debug"} {
+			// port 0 is not set, the profiles only override the ports that are set
+			if port := addresses[kind].Port; port < 0 || port > 65535 {
+				problems = append(problems, fmt.Sprintf("port %d of `%s.%s` is out of range, use 1-65535 or leave it unset", port, name, kind))
+			}
+		}
+	}
+	names := ServiceNames(c.Services)
+	for _, name := range names {
+		check("services."+name, c.Services[name].addresses())
+	}
+	var profiles []string
+	for name := range c.Profiles {
+		profiles = append(profiles, name)
+	}
+	sort.Strings(profiles)
+	for _, profile := range profiles {
+		var names []string
+		for name := range c.Profiles[profile].Services {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			svc := c.Profiles[profile].Services[name]
+			check(fmt.Sprintf("profiles.%s.services.%s", profile, name), map[string]AddressConfig{
+				HTTP:    svc.Http,
+				GRPC:    svc.Grpc,
+				"debug": svc.Debug,
+			})
+		}
+	}
+	return problems
+}
+
+// checkPaths checks that the service folders are inside the project and not shared by two services.
+func (c *GSConfig) checkPaths() (problems []string) {
+	names := ServiceNames(c.Services)
+	folders := map[string]string{}
+	for _, name := range names {
+		folder := c.Services[name].Folder(name)
+		if folder == "." || path.IsAbs(folder) || folder == ".." || strings.HasPrefix(folder, "../") {
+			problems = append(problems, fmt.Sprintf("path `%s` of `services.%s` must be a folder inside the project", c.Services[name].Path, name))
+			continue
+		}
+		if other, ok := folders[folder]; ok {
+			problems = append(problems, fmt.Sprintf("services `%s` and `%s` use the same folder `%s`", other, name, folder))
+			continue
+		}
+		folders[folder] = name
+	}
+	return problems
+}
+
+// warnMissingFolders logs the services that do not have a folder,
+// it is not an error so the service can still be removed with `gs remove service`.
+func (c *GSConfig) warnMissingFolders() {
+	names := ServiceNames(c.Services)
+	for _, name := range names {
+		if b, _ := fs.Exists(c.Services[name].Folder(name)); !b {
+			warnOnce(fmt.Sprintf("the folder of service `%s` does not exist, create it or run `gs remove service %s`", name, name))
+		}
+	}
+}
+
+// the configuration is read many times by a command, the warnings only need to be shown once
+var warned = map[string]bool{}
+
+func warnOnce(msg string) {
+	if warned[msg] {
+		return
+	}
+	warned[msg] = true
+	log.Warn(msg)
+}
@@ -0,0 +1,44 @@
+package config
+
+import (
+	"fmt"
+	"gs/fs"
+	"path"
+	"strings"
+)
+
+// ModuleFile returns the closest go.mod in the service folder or one of its parents,
+// if the service is in the root module it returns `go.mod`.
+func (s ServiceConfig) ModuleFile(name string) (string, error) {
+	for dir := s.Folder(name); dir != "." && dir != "/"; dir = path.Dir(dir) {
+		goMod := path.Join(dir, "go.mod")
+		if b, err := fs.Exists(goMod); err != nil {
+			return "", err
+		} else if b {
+			return goMod, nil
+		}
+	}
+	return "go.mod", nil
+}
+
+// ResolveModule returns the module of the service and the import path of the service package.
+// The module is read from the closest go.mod of the service, this way the services of a
+// go.work workspace can belong to different modules.
+func (s ServiceConfig) ResolveModule(name, rootModule string) (module, importPath string, err error) {
+	folder := s.Folder(name)
+	goMod, err := s.ModuleFile(name)
+	if err != nil {
+		return "", "", err
+	}
+	if goMod == "go.mod" {
+		if rootModule == "" {
+			return "", "", fmt.Errorf("service `%s`: could not find the go.mod of `%s`", name, folder)
+		}
+		return rootModule, path.Join(rootModule, folder), nil
+	}
+	module, err = ReadModuleFile(goMod)
+	if err != nil {
+		return "", "", err
+	}
+	return module, path.Join(module, strings.TrimPrefix(folder, path.Dir(goMod))), nil
+}
@@ -0,0 +1,341 @@
+package doctor
+
+import (
+	"bufio"
+	"fmt"
+	"gs/config"
+	"gs/fs"
+	"gs/service"
+	"gs/template"
+	"os/exec"
+	"path"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+type Status string
+
+const (
+	PASS Status = "pass"
+	WARN Status = "warn"
+	FAIL Status = "fail"
+)
+
+// the minimum go version required by the generated code
+const minGoMinor = 14
+
+// Check is the result of one doctor check.
+type Check struct {
+	Name    string
+	Status  Status
+	Message string
+	// Fix is the suggested fix if the check did not pass
+	Fix string
+}
+
+var goVersionRegex = regexp.MustCompile(`go(\d+)\.(\d+)`)
+
+// Run runs all the checks for the toolchain and the project in the current folder.
+func Run() []Check {
+	checks := []Check{checkGo()}
+
+	cfg, err := config.Read()
+	if err != nil {
+		checks = append(checks, checkProtoc(false)...)
+		return append(checks, Check{
+			Name:    "gs.toml",
+			Status:  FAIL,
+			Message: err.Error(),
+			Fix:     "run gs in the root of a project created with `gs new project`",
+		})
+	}
+
+	serviceChecks, usesGrpc := checkServices(cfg)
+	checks = append(checks, checkProtoc(usesGrpc)...)
+	checks = append(checks, checkModule(cfg))
+	checks = append(checks, checkDependencies(cfg)...)
+	return append(checks, serviceChecks...)
+}
+
+func checkGo() Check {
+	check := Check{Name: "go"}
+	out, err := exec.Command("go", "version").Output()
+	if err != nil {
+		check.Status = FAIL
+		check.Message = "go is not installed"
+		check.Fix = "install go from https://golang.org/dl/"
+		return check
+	}
+	version := strings.TrimSpace(string(out))
+	match := goVersionRegex.FindStringSubmatch(version)
+	if match == nil {
+		check.Status = WARN
+		check.Message = fmt.Sprintf("could not read the go version from `%s`", version)
+		return check
+	}
+	major, _ := strconv.Atoi(match[1])
+	minor, _ := strconv.Atoi(match[2])
+	if major < 1 || major == 1 && minor < minGoMinor {
+		check.Status = FAIL
+		check.Message = fmt.Sprintf("%s is too old", match[0])
+		check.Fix = fmt.Sprintf("install go 1.%d or newer from https://golang.org/dl/", minGoMinor)
+		return check
+	}
+	check.Status = PASS
+	check.Message = match[0]
+	return check
+}
+
+// checkProtoc checks protoc and the go plugin, if no service uses grpc missing tools are only a warning.
+func checkProtoc(usesGrpc bool) []Check {
+	missing := WARN
+	if usesGrpc {
+		missing = FAIL
+	}
+	protoc := Check{Name: "protoc"}
+	if out, err := exec.Command("protoc", "--version").Output(); err != nil {
+		protoc.Status = missing
+		protoc.Message = "protoc is not installed, it is needed to generate the grpc transport"
+		protoc.Fix = "install protoc from https://github.com/protocolbuffers/protobuf/releases"
+	} else {
+		protoc.Status = PASS
+		protoc.Message = strings.TrimSpace(string(out))
+	}
+
+	plugin := Check{Name: "protoc-gen-go"}
+	if pth, err := exec.LookPath("protoc-gen-go"); err != nil {
+		plugin.Status = missing
+		plugin.Message = "protoc-gen-go is not in the PATH, it is needed to generate the grpc transport"
+		plugin.Fix = protocGenGoFix + " and add $GOPATH/bin to the PATH"
+	} else {
+		plugin.Status = PASS
+		plugin.Message = pth
+		// protoc-gen-go from github.com/golang/protobuf v1.3 does not know --version and reads the empty stdin
+		if out, err := exec.Command("protoc-gen-go", "--version").Output(); err == nil && len(out) > 0 {
+			plugin.Message = strings.TrimSpace(string(out))
+			if !supportsGrpcPlugin(plugin.Message) {
+				plugin.Status = missing
+				plugin.Message += " does not support `--go_out=plugins=grpc`, it is needed to generate the grpc transport"
+				plugin.Fix = protocGenGoFix
+			}
+		}
+	}
+	return []Check{protoc, plugin}
+}
+
+const protocGenGoFix = "run `go install github.com/golang/protobuf/protoc-gen-go@v1.3.2`"
+
+var protocGenGoVersionRegex = regexp.MustCompile(`v(\d+)\.(\d+)\.\d+`)
+
+// supportsGrpcPlugin checks if the protoc-gen-go with the version output still has the grpc plugin,
+// the protoc-gen-go of google.golang.org/protobuf (v1.20.0 and newer) removed it.
+func supportsGrpcPlugin(version string) bool {
+	match := protocGenGoVersionRegex.FindStringSubmatch(version)
+	if match == nil {
+		return true
+	}
+	major, _ := strconv.Atoi(match[1])
+	minor, _ := strconv.Atoi(match[2])
+	return major == 1 && minor < 20
+}
+
+func checkModule(cfg *config.GSConfig) Check {
+	check := Check{Name: "module"}
+	out, err := exec.Command("go", "list", "-m").Output()
+	if err != nil {
+		check.Status = WARN
+		check.Message = "could not read the module with `go list -m`"
+		check.Fix = "make sure go.mod is valid"
+		return check
+	}
+	// in a go.work workspace all the modules of the workspace are listed
+	modules := strings.Fields(string(out))
+	if cfg.Module == "" {
+		check.Status = PASS
+		check.Message = "workspace " + strings.Join(modules, ", ")
+		return check
+	}
+	for _, module := range modules {
+		if module == cfg.Module {
+			check.Status = PASS
+			check.Message = module
+			return check
+		}
+	}
+	check.Status = FAIL
+	check.Message = fmt.Sprintf("go reports module `%s` but gs reads `%s` from go.mod", strings.Join(modules, ", "), cfg.Module)
+	check.Fix = "fix the module directive in go.mod"
+	return check
+}
+
+// validatorModule is only imported by the generated validations of the format rules (e.x `email`).
+const validatorModule = "github.com/asaskevich/govalidator"
+
+// checkDependencies checks that the go.mod of every module with services requires
+// all the dependencies the generated code needs.
+func checkDependencies(cfg *config.GSConfig) (checks []Check) {
+	var files []string
+	seen := map[string]bool{}
+	validator := map[string]bool{}
+	for _, name := range config.ServiceNames(cfg.Services) {
+		goMod, err := cfg.Services[name].ModuleFile(name)
+		if err != nil {
+			continue
+		}
+		if importsValidator(cfg.Services[name].Folder(name)) {
+			validator[goMod] = true
+		}
+		if seen[goMod] {
+			continue
+		}
+		seen[goMod] = true
+		files = append(files, goMod)
+	}
+	if len(files) == 0 && cfg.Module != "" {
+		files = append(files, "go.mod")
+	}
+	for _, goMod := range files {
+		check := checkModuleDependencies(goMod, validator[goMod])
+		if len(files) > 1 {
+			check.Name += " " + goMod
+		}
+		checks = append(checks, check)
+	}
+	return checks
+}
+
+// importsValidator tells if the generated validations of the service in the folder import govalidator.
+func importsValidator(folder string) bool {
+	files, err := fs.ListFiles(path.Join(folder, "gen", "validation"))
+	if err != nil {
+		return false
+	}
+	for _, file := range files {
+		data, err := fs.ReadFile(file)
+		if err == nil && strings.Contains(data, strconv.Quote(validatorModule)) {
+			return true
+		}
+	}
+	return false
+}
+
+func checkModuleDependencies(file string, validator bool) Check {
+	check := Check{Name: "dependencies"}
+	module, err := config.ReadModuleFile(file)
+	if err != nil {
+		check.Status = FAIL
+		check.Message = err.Error()
+		return check
+	}
+	goMod, err := fs.ReadFile(file)
+	if err != nil {
+		check.Status = FAIL
+		check.Message = err.Error()
+		return check
+	}
+	required, err := template.CompileFromPath("project/go.mod.jet", map[string]string{
+		"Module": module,
+	})
+	if err != nil {
+		check.Status = FAIL
+		check.Message = err.Error()
+		return check
+	}
+	existing := map[string]bool{}
+	for _, req := range requirements(goMod) {
+		existing[req] = true
+	}
+	modules := requirements(required)
+	if validator {
+		modules = append(modules, validatorModule)
+	}
+	var missing []string
+	for _, req := range modules {
+		if !existing[req] {
+			missing = append(missing, req)
+		}
+	}
+	if len(missing) > 0 {
+		check.Status = WARN
+		check.Message = fmt.Sprintf("%s does not require %s", file, strings.Join(missing, ", "))
+		check.Fix = fmt.Sprintf("run `go get %s` in the module root", strings.Join(missing, " "))
+		if dir := path.Dir(file); dir != "." {
+			check.Fix = fmt.Sprintf("run `go get %s` in the module folder `%s`", strings.Join(missing, " "), dir)
+		}
+		return check
+	}
+	check.Status = PASS
+	check.Message = "all the runtime dependencies are required"
+	return check
+}
+
+func checkServices(cfg *config.GSConfig) (checks []Check, usesGrpc bool) {
+	for _, name := range config.ServiceNames(cfg.Services) {
+		check := Check{Name: "service " + name}
+		if b, _ := fs.Exists(cfg.Services[name].Folder(name)); !b {
+			check.Status = FAIL
+			check.Message = fmt.Sprintf("the folder `%s` does not exist", cfg.Services[name].Folder(name))
+			check.Fix = fmt.Sprintf("create the service again or run `gs remove service %s`", name)
+			checks = append(checks, check)
+			continue
+		}
+		if !cfg.Services[name].IsEnabled() {
+			check.Status = PASS
+			check.Message = "the service is disabled"
+			checks = append(checks, check)
+			continue
+		}
+		svc, err := service.Parse(name, cfg.Services[name], cfg.Module)
+		if err != nil {
+			check.Status = FAIL
+			check.Message = err.Error()
+			check.Fix = "run `gs lint` for more details"
+			checks = append(checks, check)
+			continue
+		}
+		if svc.GRPCTransport != nil {
+			usesGrpc = true
+		}
+		diff, err := service.Diff(name, cfg.Services[name], cfg.Module, cfg.Plugins)
+		if err != nil {
+			check.Status = FAIL
+			check.Message = err.Error()
+		} else if diff != "" {
+			check.Status = WARN
+			check.Message = "the generated code is out of date"
+			check.Fix = fmt.Sprintf("run `gs generate %s`", name)
+		} else {
+			check.Status = PASS
+			check.Message = "the generated code is up to date"
+		}
+		checks = append(checks, check)
+	}
+	return checks, usesGrpc
+}
+
+// requirements returns the module paths required in the go.mod source.
+func requirements(goMod string) (modules []string) {
+	scanner := bufio.NewScanner(strings.NewReader(goMod))
+	inBlock := false
+	for scanner.Scan() {
+		line := scanner.Text()
+		if i := strings.Index(line, "//"); i >= 0 {
+			line = line[:i]
+		}
+		fields := strings.Fields(line)
+		switch {
+		case len(fields) == 0:
+			continue
+		case inBlock && fields[0] == ")":
+			inBlock = false
+		case inBlock:
+			modules = append(modules, fields[0])
+		case fields[0] == "require" && len(fields) > 1 && fields[1] == "(":
+			inBlock = true
+		case fields[0] == "require" && len(fields) > 2:
+			modules = append(modules, fields[1])
+		}
+	}
+	return modules
+}
@@ -0,0 +1,189 @@
+package doctor
+
+import (
+	"fmt"
+	"gs/config"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const completeGoMod = `module %s
+
+go 1.14
+
+require (
+	github.com/go-kit/kit v0.10.0
+	github.com/golang/protobuf v1.3.2 // indirect
+	github.com/gorilla/mux v1.7.4
+	github.com/gorilla/schema v1.1.0
+	github.com/oklog/run v1.1.0
+	google.golang.org/grpc v1.27.0
+)
+`
+
+// inProject writes the files to a new folder and runs fn with the folder as the working directory.
+func inProject(t *testing.T, files map[string]string, fn func()) {
+	dir, err := ioutil.TempDir("", "gs-doctor")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	for name, data := range files {
+		pth := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(pth), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(pth, []byte(data), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(dir); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Chdir(wd)
+	// the workspace mode does not allow -mod=mod
+	defer os.Setenv("GOFLAGS", os.Getenv("GOFLAGS"))
+	os.Setenv("GOFLAGS", "")
+	fn()
+}
+
+func TestCheckModule(t *testing.T) {
+	inProject(t, map[string]string{"go.mod": "module shop\n"}, func() {
+		check := checkModule(&config.GSConfig{Module: "shop"})
+		assert.Equal(t, PASS, check.Status)
+		assert.Equal(t, "shop", check.Message)
+
+		check = checkModule(&config.GSConfig{Module: "store"})
+		assert.Equal(t, FAIL, check.Status)
+		assert.Equal(t, "go reports module `shop` but gs reads `store` from go.mod", check.Message)
+	})
+}
+
+func TestCheckModuleWorkspace(t *testing.T) {
+	inProject(t, map[string]string{
+		"go.work":        "go 1.18\n\nuse (\n\t./orders\n\t./users\n)\n",
+		"orders/go.mod":  "module shop/orders\n",
+		"users/go.mod":   "module shop/users\n",
+		"users/users.go": "package users\n",
+	}, func() {
+		check := checkModule(&config.GSConfig{})
+		assert.Equal(t, PASS, check.Status)
+		assert.Equal(t, "workspace shop/orders, shop/users", check.Message)
+	})
+}
+
+func TestCheckDependencies(t *testing.T) {
+	inProject(t, map[string]string{
+		"go.mod": "module shop\n\nrequire github.com/go-kit/kit v0.10.0\n",
+	}, func() {
+		checks := checkDependencies(&config.GSConfig{Module: "shop"})
+		assert.Len(t, checks, 1)
+		assert.Equal(t, "dependencies", checks[0].Name)
+		assert.Equal(t, WARN, checks[0].Status)
+		assert.Contains(t, checks[0].Message, "go.mod does not require github.com/golang/protobuf, github.com/gorilla/mux")
+		assert.NotContains(t, checks[0].Message, "go-kit")
+	})
+
+	inProject(t, map[string]string{
+		"go.mod": fmt.Sprintf(completeGoMod, "shop"),
+	}, func() {
+		checks := checkDependencies(&config.GSConfig{Module: "shop"})
+		assert.Equal(t, PASS, checks[0].Status)
+	})
+}
+
+func TestCheckDependenciesValidator(t *testing.T) {
+	cfg := &config.GSConfig{Module: "shop", Services: map[string]config.ServiceConfig{"users": {}}}
+	inProject(t, map[string]string{
+		"go.mod":                       fmt.Sprintf(completeGoMod, "shop"),
+		"users/gen/validation/list.go": "package validation\n\nimport \"unicode/utf8\"\n",
+	}, func() {
+		checks := checkDependencies(cfg)
+		assert.Equal(t, PASS, checks[0].Status, "govalidator should not be required if the generated code does not import it")
+	})
+
+	inProject(t, map[string]string{
+		"go.mod":                         fmt.Sprintf(completeGoMod, "shop"),
+		"users/gen/validation/create.go": "package validation\n\nimport \"github.com/asaskevich/govalidator\"\n",
+	}, func() {
+		checks := checkDependencies(cfg)
+		assert.Equal(t, WARN, checks[0].Status)
+		assert.Equal(t, "go.mod does not require github.com/asaskevich/govalidator", checks[0].Message)
+	})
+}
+
+func TestCheckDependenciesWorkspace(t *testing.T) {
+	inProject(t, map[string]string{
+		"go.work":       "go 1.18\n\nuse (\n\t./orders\n\t./users\n)\n",
+		"orders/go.mod": fmt.Sprintf(completeGoMod, "shop/orders"),
+		"users/go.mod":  "module shop/users\n",
+	}, func() {
+		checks := checkDependencies(&config.GSConfig{
+			Services: map[string]config.ServiceConfig{
+				"orders":   {},
+				"users":    {},
+				"accounts": {Path: "users/accounts"},
+			},
+		})
+		assert.Len(t, checks, 2, "every module should be checked once")
+		// `accounts` is the first service and belongs to the users module
+		assert.Equal(t, "dependencies users/go.mod", checks[0].Name)
+		assert.Equal(t, WARN, checks[0].Status)
+		assert.Contains(t, checks[0].Fix, "in the module folder `users`")
+		assert.Equal(t, "dependencies orders/go.mod", checks[1].Name)
+		assert.Equal(t, PASS, checks[1].Status)
+	})
+}
+
+func TestSupportsGrpcPlugin(t *testing.T) {
+	for version, supported := range map[string]bool{
+		"":                      true,
+		"protoc-gen-go v1.3.2":  true,
+		"protoc-gen-go v1.4.3":  true,
+		"protoc-gen-go v1.20.0": false,
+		"protoc-gen-go v1.28.1": false,
+		"protoc-gen-go v2.0.0":  false,
+	} {
+		assert.Equal(t, supported, supportsGrpcPlugin(version), version)
+	}
+}
+
+func TestCheckProtocGenGo(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gs-doctor-bin")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	defer os.Setenv("PATH", os.Getenv("PATH"))
+	os.Setenv("PATH", dir)
+
+	plugin := filepath.Join(dir, "protoc-gen-go")
+	for _, test := range []struct {
+		script   string
+		usesGrpc bool
+		status   Status
+	}{
+		{script: "#!/bin/sh\necho protoc-gen-go v1.28.1\n", usesGrpc: true, status: FAIL},
+		{script: "#!/bin/sh\necho protoc-gen-go v1.28.1\n", usesGrpc: false, status: WARN},
+		{script: "#!/bin/sh\necho protoc-gen-go v1.4.3\n", usesGrpc: true, status: PASS},
+		// protoc-gen-go v1.3 does not print a version
+		{script: "#!/bin/sh\ncat > /dev/null\n", usesGrpc: true, status: PASS},
+	} {
+		if err := ioutil.WriteFile(plugin, []byte(test.script), 0755); err != nil {
+			t.Fatal(err)
+		}
+		check := checkProtoc(test.usesGrpc)[1]
+		assert.Equal(t, test.status, check.Status, test.script)
+		if test.status != PASS {
+			assert.Equal(t, "run `go install github.com/golang/protobuf/protoc-gen-go@v1.3.2`", check.Fix)
+		}
+	}
+}
@@ -3,6 +3,7 @@ module stringsvc
 go 1.14
 
 require (
+	github.com/asaskevich/govalidator v0.0.0-20200108200545-475eaeb16496
 	github.com/go-kit/kit v0.10.0
 	github.com/golang/protobuf v1.3.2
 	github.com/gorilla/mux v1.7.4
@@ -2,12 +2,11 @@ package strings
 
 import (
 	"context"
-	"errors"
 	"strings"
 )
 
 type UppercaseRequest struct {
-	S string `json:"s"`
+	S string `json:"s" valid:"required"`
 }
 
 type UppercaseResponse struct {
@@ -21,8 +20,6 @@ type CountResponse struct {
 	V int `json:"v"`
 }
 
-var ErrEmpty = errors.New("empty string")
-
 // @service()
 type Service interface {
 	// @http(method="post", route="/uppercase")
@@ -40,9 +37,6 @@ func New() Service {
 }
 
 func (s stringsService) Uppercase(_ context.Context, req UppercaseRequest) (*UppercaseResponse, error) {
-	if req.S == "" {
-		return nil, ErrEmpty
-	}
 	return &UppercaseResponse{V: strings.ToUpper(req.S)}, nil
 }
 
@@ -2,6 +2,7 @@ package fs
 
 import (
 	"fmt"
+	"os"
 	"path/filepath"
 
 	"github.com/sirupsen/logrus"
@@ -32,6 +33,11 @@ func DeleteFolder(path string) error {
 	return appFs().RemoveAll(path)
 }
 
+func DeleteFile(path string) error {
+	log.Debugf("Deleting `%s`", path)
+	return appFs().Remove(path)
+}
+
 func CreateFolder(path string) error {
 	log.Debugf("Creating `%s`", path)
 	b, _ := afero.Exists(appFs(), path)
@@ -62,3 +68,34 @@ func ReadFile(path string) (string, error) {
 func Exists(path string) (bool, error) {
 	return afero.Exists(appFs(), path)
 }
+
+// ListFiles returns the paths of all the files in the folder and its sub folders,
+// if the folder does not exist the list is empty.
+func ListFiles(path string) ([]string, error) {
+	var files []string
+	if b, _ := afero.Exists(appFs(), path); !b {
+		return files, nil
+	}
+	err := afero.Walk(appFs(), path, func(p string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if !info.IsDir() {
+			files = append(files, p)
+		}
+		return nil
+	})
+	return files, err
+}
+
+func Rename(oldPath, newPath string) error {
+	log.Debugf("Renaming `%s` to `%s`", oldPath, newPath)
+	b, _ := afero.Exists(appFs(), newPath)
+	if b {
+		return fmt.Errorf("`%s` already exists", newPath)
+	}
+	if err := appFs().MkdirAll(filepath.Dir(newPath), 0755); err != nil {
+		return err
+	}
+	return appFs().Rename(oldPath, newPath)
+}
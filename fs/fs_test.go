@@ -37,3 +37,32 @@ func TestAppFs_CreateNestedFolders(t *testing.T) {
 	b, _ := afero.Exists(testFs, "abc/123/xyz")
 	assert.True(t, b, "should be true")
 }
+
+func TestAppFs_ListFiles(t *testing.T) {
+	setup()
+
+	_ = WriteFile("abc/a.go", "a")
+	_ = WriteFile("abc/123/b.go", "b")
+	files, err := ListFiles("abc")
+	assert.Nil(t, err, "should be nil")
+	assert.ElementsMatch(t, []string{"abc/a.go", "abc/123/b.go"}, files)
+}
+
+func TestAppFs_ListFilesMissingFolder(t *testing.T) {
+	setup()
+
+	files, err := ListFiles("abc")
+	assert.Nil(t, err, "should be nil")
+	assert.Empty(t, files, "should be empty")
+}
+
+func TestAppFs_DeleteFile(t *testing.T) {
+	setup()
+
+	_ = WriteFile("abc/a.go", "a")
+	_ = WriteFile("abc/b.go", "b")
+	err := DeleteFile("abc/a.go")
+	assert.Nil(t, err, "should be nil")
+	files, _ := ListFiles("abc")
+	assert.Equal(t, []string{"abc/b.go"}, files)
+}
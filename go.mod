@@ -12,6 +12,7 @@ require (
 	github.com/gorilla/mux v1.7.4
 	github.com/ozgio/strutil v0.3.0
 	github.com/pelletier/go-toml v1.7.0
+	github.com/pmezard/go-difflib v1.0.0
 	github.com/radovskyb/watcher v1.0.7
 	github.com/sirupsen/logrus v1.5.0
 	github.com/spf13/afero v1.2.2
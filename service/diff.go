@@ -0,0 +1,78 @@
+package service
+
+import (
+	"gs/config"
+	"gs/fs"
+	"sort"
+	"strings"
+
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+// Diff renders the generated code of the service in memory and returns a unified diff
+// against the generated code that is currently on disk, the diff is empty if the generated
+// code is up to date.
+func Diff(name string, config config.ServiceConfig, module string, plugins map[string]config.PluginConfig) (string, error) {
+	service, err := Parse(name, config, module)
+	if err != nil {
+		return "", err
+	}
+	service.Plugins = plugins
+	if err := service.CheckValidations(); err != nil {
+		return "", err
+	}
+	files, err := service.renderFiles()
+	if err != nil {
+		return "", err
+	}
+	return service.diffFiles(files)
+}
+
+func (s *Service) diffFiles(files map[string]string) (string, error) {
+	existing, err := fs.ListFiles(s.GetPath("gen"))
+	if err != nil {
+		return "", err
+	}
+	paths := map[string]bool{}
+	for k := range files {
+		paths[k] = true
+	}
+	for _, f := range existing {
+		// files generated by protoc are not rendered from templates
+		if strings.HasSuffix(f, ".pb.go") || strings.HasSuffix(f, manifestFile) || strings.Contains(f, stagingFolder) {
+			continue
+		}
+		paths[f] = true
+	}
+	var sorted []string
+	for k := range paths {
+		sorted = append(sorted, k)
+	}
+	sort.Strings(sorted)
+
+	diff := ""
+	for _, pth := range sorted {
+		current := ""
+		if b, _ := fs.Exists(pth); b {
+			current, err = fs.ReadFile(pth)
+			if err != nil {
+				return "", err
+			}
+		}
+		if current == files[pth] {
+			continue
+		}
+		text, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+			A:        difflib.SplitLines(current),
+			B:        difflib.SplitLines(files[pth]),
+			FromFile: "a/" + pth,
+			ToFile:   "b/" + pth,
+			Context:  3,
+		})
+		if err != nil {
+			return "", err
+		}
+		diff += text
+	}
+	return diff, nil
+}
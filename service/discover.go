@@ -0,0 +1,73 @@
+package service
+
+import (
+	"gs/fs"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-services/source"
+	"github.com/ozgio/strutil"
+)
+
+// Discover scans the packages of the module for interfaces annotated with @service()
+// and returns the folders of the services found by service name.
+// The service name is the name of the folder, services declared in the root package
+// are skipped with a warning.
+func Discover() (services map[string]string, err error) {
+	files, err := moduleGoFiles()
+	if err != nil {
+		return nil, err
+	}
+	services = map[string]string{}
+	for _, file := range files {
+		if !isPackageFile(file) || isGenerated(file) {
+			continue
+		}
+		data, err := fs.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		src, err := source.New(data)
+		if err != nil {
+			log.Warnf("skipping `%s`: %s", file, err)
+			continue
+		}
+		for _, inf := range findServiceInterfaces(src) {
+			dir := filepath.ToSlash(filepath.Dir(file))
+			if dir == "." {
+				log.Warnf(
+					"skipping service `%s` in `%s`, services need to be declared in their own package",
+					inf.Name(),
+					file,
+				)
+				continue
+			}
+			name := strings.ReplaceAll(strutil.ToSnakeCase(path.Base(dir)), "_", "")
+			if other, ok := services[name]; ok {
+				if other == dir {
+					log.Warnf(
+						"`%s` has more than one @service() interface, set `interface` of service `%s` in gs.toml",
+						dir,
+						name,
+					)
+				} else {
+					log.Warnf("skipping service `%s` in `%s`, service `%s` is already declared in `%s`", inf.Name(), file, name, other)
+				}
+				continue
+			}
+			services[name] = dir
+		}
+	}
+	return services, nil
+}
+
+// isGenerated checks if the file is part of the generated code of a service.
+func isGenerated(file string) bool {
+	for _, part := range strings.Split(filepath.ToSlash(file), "/") {
+		if part == "gen" {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,37 @@
+package service
+
+import (
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDiscover(t *testing.T) {
+	service := func(pkg string) string {
+		return "package " + pkg + "\n\n// @service()\ntype Service interface{}\n"
+	}
+	dir := writeModule(t, map[string]string{
+		"go.mod":                          "module shop\n",
+		"main.go":                         service("main"),
+		"users/service.go":                service("users"),
+		"users/gen/service.go":            service("gen"),
+		"users/service_test.go":           service("users"),
+		"services/order_items/service.go": service("orderitems"),
+		"legacy/users/service.go":         service("users"),
+		"vendor/lib/service.go":           service("lib"),
+		".gs/templates/service.go":        service("templates"),
+		"store/store.go":                  "package store\n\ntype Store interface{}\n",
+	})
+	defer os.RemoveAll(dir)
+
+	inFolder(t, dir, func() {
+		services, err := Discover()
+		assert.NoError(t, err)
+		// the folders are scanned in lexical order, the second `users` service is skipped
+		assert.Equal(t, map[string]string{
+			"users":      "legacy/users",
+			"orderitems": "services/order_items",
+		}, services)
+	})
+}
@@ -3,10 +3,8 @@ package service
 import (
 	"errors"
 	"gs/config"
-	"io/ioutil"
 	"os"
 	"path"
-	"path/filepath"
 	"unicode"
 	"unicode/utf8"
 
@@ -32,10 +30,16 @@ type Endpoint struct {
 
 	HttpTransport *HttpTransport
 
+	// Validations are the rules of the request fields checked after the request is decoded
+	Validations []FieldValidation
+	// validationErr lists the rules that can not be generated, only the generation fails on them
+	validationErr error
+
 	Annotations []annotation.Annotation
 }
 
-func parseEndpoint(method source.InterfaceMethod, serviceImport, serviceName string) (ep *Endpoint, err error) {
+func parseEndpoint(method source.InterfaceMethod, service Service) (ep *Endpoint, err error) {
+	serviceImport, serviceFolder := service.Import, service.Path
 	if err = checkEndpointParams(method.Params()); err != nil {
 		return nil, err
 	}
@@ -44,28 +48,29 @@ func parseEndpoint(method source.InterfaceMethod, serviceImport, serviceName str
 	}
 	ep = &Endpoint{
 		Name:        method.Name(),
+		Config:      service.Config,
 		Annotations: method.Annotations(),
 	}
 
 	// this fixes the import for parameters in the same package
 	for _, param := range method.Params() {
-		param.Type = fixMethodImport(param.Type, serviceImport, serviceName)
+		param.Type = fixMethodImport(param.Type, serviceImport, serviceFolder)
 		ep.Params = append(ep.Params, param)
 	}
 	// find the request struct and the import of the request
-	ep.Request, ep.RequestImport, err = findRequest(ep.Params, serviceImport, serviceName)
+	ep.Request, ep.RequestImport, err = findRequest(ep.Params, serviceImport, serviceFolder)
 	if err != nil {
 		return nil, err
 	}
 
 	// this fixes the import for parameters in the same package
 	for _, param := range method.Results() {
-		param.Type = fixMethodImport(param.Type, serviceImport, serviceName)
+		param.Type = fixMethodImport(param.Type, serviceImport, serviceFolder)
 		ep.Results = append(ep.Results, param)
 	}
 
 	// find the response struct and the import of the response
-	ep.Response, ep.ResponseImport, err = findResponse(ep.Results, serviceImport, serviceName)
+	ep.Response, ep.ResponseImport, err = findResponse(ep.Results, serviceImport, serviceFolder)
 	if err != nil {
 		return nil, err
 	}
@@ -77,7 +82,7 @@ func parseEndpoint(method source.InterfaceMethod, serviceImport, serviceName str
 	return
 }
 
-func findRequest(params []code.Parameter, serviceImport, serviceName string) (*code.Struct, *code.Import, error) {
+func findRequest(params []code.Parameter, serviceImport, serviceFolder string) (*code.Struct, *code.Import, error) {
 	if len(params) < 2 {
 		return nil, nil, nil
 	}
@@ -88,14 +93,14 @@ func findRequest(params []code.Parameter, serviceImport, serviceName string) (*c
 
 	// this fixes the import for parameters in the same package
 	for inx, field := range request.Fields {
-		field.Type = fixMethodImport(field.Type, serviceImport, serviceName)
+		field.Type = fixMethodImport(field.Type, serviceImport, serviceFolder)
 		request.Fields[inx] = field
 	}
 
 	return request, params[1].Type.Import, nil
 }
 
-func findResponse(params []code.Parameter, serviceImport, serviceName string) (*code.Struct, *code.Import, error) {
+func findResponse(params []code.Parameter, serviceImport, serviceFolder string) (*code.Struct, *code.Import, error) {
 	if len(params) < 2 {
 		return nil, nil, nil
 	}
@@ -105,7 +110,7 @@ func findResponse(params []code.Parameter, serviceImport, serviceName string) (*
 	}
 	// this fixes the import for parameters in the same package
 	for inx, field := range response.Fields {
-		field.Type = fixMethodImport(field.Type, serviceImport, serviceName)
+		field.Type = fixMethodImport(field.Type, serviceImport, serviceFolder)
 		response.Fields[inx] = field
 	}
 	return response, params[0].Type.Import, nil
@@ -143,7 +148,7 @@ func checkEndpointResults(params []code.Parameter) error {
 	return nil
 }
 
-func fixMethodImport(tp code.Type, serviceImport, serviceName string) code.Type {
+func fixMethodImport(tp code.Type, serviceImport, serviceFolder string) code.Type {
 	if tp.Import == nil && isExported(tp.Qualifier) {
 		currentPath, err := os.Getwd()
 		if err != nil {
@@ -152,7 +157,7 @@ func fixMethodImport(tp code.Type, serviceImport, serviceName string) code.Type
 		tp.Import = code.NewImportWithFilePath(
 			"service",
 			serviceImport,
-			path.Join(currentPath, serviceName),
+			path.Join(currentPath, serviceFolder),
 		)
 	}
 	return tp
@@ -168,53 +173,3 @@ func fixStructFieldImport(tp code.Type, alias, structImport, structFile string)
 	}
 	return tp
 }
-
-func findStruct(tp code.Type) (*code.Struct, error) {
-	notFoundErr := errors.New(
-		"could not find structure, make sure that you are using a structure as request/response parameters",
-	)
-	if tp.Import.FilePath == "" {
-		return nil, notFoundErr
-	}
-	fls, err := ioutil.ReadDir(tp.Import.FilePath)
-	if err != nil {
-		return nil, err
-	}
-	if fls == nil {
-		return nil, notFoundErr
-	}
-	for _, file := range fls {
-		if file.IsDir() || filepath.Ext(file.Name()) != ".go" {
-			continue
-		}
-		var fileSource *source.Source
-		filePath := path.Join(tp.Import.FilePath, file.Name())
-		if src, ok := fileSourceCache[filePath]; ok {
-			fileSource = src
-		} else {
-			data, err := ioutil.ReadFile(filePath)
-			if err != nil {
-				return nil, err
-			}
-			fileSource, err = source.New(string(data))
-			fileSourceCache[filePath] = fileSource
-			if err != nil {
-				return nil, err
-			}
-		}
-		for _, structure := range fileSource.Structures() {
-			if structure.Name() == tp.Qualifier {
-				strc := structure.Code().(*code.Struct)
-				for inx, field := range strc.Fields {
-					field.Type = fixStructFieldImport(field.Type, tp.Import.Alias, tp.Import.Path, tp.Import.FilePath)
-					if field.Name == "" {
-						field.Name = field.Type.Qualifier
-					}
-					strc.Fields[inx] = field
-				}
-				return strc, nil
-			}
-		}
-	}
-	return nil, notFoundErr
-}
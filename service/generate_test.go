@@ -0,0 +1,329 @@
+package service
+
+import (
+	"gs/config"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// copyFolder copies the files of the folder to a temporary folder and returns its path.
+func copyFolder(t *testing.T, folder string) string {
+	dir, err := ioutil.TempDir("", "gs-example")
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = filepath.Walk(folder, func(pth string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(folder, pth)
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return os.MkdirAll(filepath.Join(dir, rel), 0755)
+		}
+		data, err := ioutil.ReadFile(pth)
+		if err != nil {
+			return err
+		}
+		return ioutil.WriteFile(filepath.Join(dir, rel), data, 0644)
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return dir
+}
+
+// inFolder runs fn with the folder as the working directory.
+func inFolder(t *testing.T, folder string, fn func()) {
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(folder); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Chdir(wd)
+	fn()
+}
+
+// TestGenerateExamples generates the example projects and builds them, so that
+// the templates can not generate code that does not compile.
+func TestGenerateExamples(t *testing.T) {
+	if testing.Short() {
+		t.Skip("generating and building the examples is slow")
+	}
+	withGrpc := true
+	for _, bin := range []string{"protoc", "protoc-gen-go"} {
+		if _, err := exec.LookPath(bin); err != nil {
+			t.Logf("%s is not installed, only the http transport is generated", bin)
+			withGrpc = false
+		}
+	}
+	for _, example := range []string{"addsvc", "stringsvc"} {
+		t.Run(example, func(t *testing.T) {
+			dir := copyFolder(t, filepath.Join("..", "example", example))
+			defer os.RemoveAll(dir)
+
+			inFolder(t, dir, func() {
+				cfg, err := config.Read()
+				if err != nil {
+					t.Fatal(err)
+				}
+				for name, svcCfg := range cfg.Services {
+					if !withGrpc {
+						svcCfg.Transports = []string{config.HTTP}
+					}
+					if err := Generate(name, svcCfg, cfg.Module, cfg.Plugins); err != nil {
+						t.Fatal(err)
+					}
+				}
+			})
+
+			cmd := exec.Command("go", "build", "./...")
+			cmd.Dir = dir
+			if out, err := cmd.CombinedOutput(); err != nil {
+				t.Fatalf("the generated code does not build: %s\n%s", err, out)
+			}
+		})
+	}
+}
+
+func TestGenerateServicesChecksAddresses(t *testing.T) {
+	dir := copyFolder(t, filepath.Join("..", "example", "stringsvc"))
+	defer os.RemoveAll(dir)
+
+	inFolder(t, dir, func() {
+		cfg, err := config.Read()
+		if err != nil {
+			t.Fatal(err)
+		}
+		cfg.Services["numbers"] = config.ServiceConfig{Http: config.AddressConfig{Port: 8000}}
+		err = GenerateServices(cfg, []string{"strings"})
+		assert.EqualError(t, err, "address `:8000` of `strings.http` conflicts with address `:8000` of `numbers.http`")
+	})
+	_, err := os.Stat(filepath.Join(dir, "strings", "gen"))
+	assert.True(t, os.IsNotExist(err), "nothing should be generated if the addresses conflict")
+}
+
+// fakeProtoc puts a protoc in the PATH that writes an empty go file for the proto and logs
+// every call, it fails if $GS_TEST_PROTOC_FAIL is set.
+func fakeProtoc(t *testing.T) (log string, cleanup func()) {
+	dir, err := ioutil.TempDir("", "gs-protoc")
+	if err != nil {
+		t.Fatal(err)
+	}
+	log = filepath.Join(dir, "calls")
+	script := "#!/bin/sh\n" +
+		"echo \"$1\" >> " + log + "\n" +
+		"if [ -n \"$GS_TEST_PROTOC_FAIL\" ]; then echo invalid proto; exit 1; fi\n" +
+		"echo 'package grpc' > \"$(basename \"$1\" .proto).pb.go\"\n"
+	if err := ioutil.WriteFile(filepath.Join(dir, "protoc"), []byte(script), 0755); err != nil {
+		t.Fatal(err)
+	}
+	path := os.Getenv("PATH")
+	os.Setenv("PATH", dir+string(os.PathListSeparator)+path)
+	return log, func() {
+		os.Setenv("PATH", path)
+		os.Unsetenv("GS_TEST_PROTOC_FAIL")
+		os.RemoveAll(dir)
+	}
+}
+
+func TestGenerateCompilesProtoAfterFailure(t *testing.T) {
+	dir := copyFolder(t, filepath.Join("..", "example", "stringsvc"))
+	defer os.RemoveAll(dir)
+	log, cleanup := fakeProtoc(t)
+	defer cleanup()
+	calls := func() int {
+		data, _ := ioutil.ReadFile(log)
+		return strings.Count(string(data), "\n")
+	}
+
+	inFolder(t, dir, func() {
+		cfg, err := config.Read()
+		if err != nil {
+			t.Fatal(err)
+		}
+		svcCfg := cfg.Services["strings"]
+		assert.NoError(t, Generate("strings", svcCfg, cfg.Module, nil))
+		assert.Equal(t, 1, calls())
+
+		// a different proto package changes the proto
+		svcCfg.ProtoPackage = "strings"
+		os.Setenv("GS_TEST_PROTOC_FAIL", "1")
+		assert.EqualError(t, Generate("strings", svcCfg, cfg.Module, nil), "protoc: exit status 1 invalid proto")
+		assert.Equal(t, 2, calls())
+
+		os.Unsetenv("GS_TEST_PROTOC_FAIL")
+		assert.NoError(t, Generate("strings", svcCfg, cfg.Module, nil))
+		assert.Equal(t, 3, calls(), "the proto needs to be compiled again after protoc failed")
+
+		assert.NoError(t, Generate("strings", svcCfg, cfg.Module, nil))
+		assert.Equal(t, 3, calls(), "an unchanged proto should not be compiled")
+	})
+}
+
+// TestGeneratedServiceFlags builds a service with the main package of an older gs that does not
+// register the address flags, the generated code needs to register them.
+func TestGeneratedServiceFlags(t *testing.T) {
+	if testing.Short() {
+		t.Skip("building the example is slow")
+	}
+	dir := copyFolder(t, filepath.Join("..", "example", "stringsvc"))
+	defer os.RemoveAll(dir)
+
+	inFolder(t, dir, func() {
+		cfg, err := config.Read()
+		if err != nil {
+			t.Fatal(err)
+		}
+		svcCfg := cfg.Services["strings"]
+		svcCfg.Transports = []string{config.HTTP}
+		if err := Generate("strings", svcCfg, cfg.Module, nil); err != nil {
+			t.Fatal(err)
+		}
+	})
+	main, err := ioutil.ReadFile(filepath.Join(dir, "strings", "cmd", "main.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.NotContains(t, string(main), "flag", "the main package of the example should not be changed")
+
+	binary := filepath.Join(dir, "strings-service")
+	cmd := exec.Command("go", "build", "-o", binary, "./strings/cmd")
+	cmd.Dir = dir
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("the generated code does not build: %s\n%s", err, out)
+	}
+	// -h prints the flags and exits before the service starts
+	out, _ := exec.Command(binary, "-h").CombinedOutput()
+	assert.Contains(t, string(out), "-debug-addr")
+	assert.Contains(t, string(out), "-http-addr")
+	assert.Contains(t, string(out), "STRINGS_HTTP_ADDR")
+	assert.NotContains(t, string(out), "-grpc-addr", "the grpc transport is not generated")
+}
+
+const decoderServiceSource = `package strings
+
+import "context"
+
+type SearchRequest struct {
+	Query  string ` + "`query:\"q,required\"`" + `
+	Limit  int    ` + "`query:\"limit\"`" + `
+	Tenant string ` + "`header:\"X-Tenant-ID,required\"`" + `
+}
+
+type SearchResponse struct {
+	Count int ` + "`json:\"count\"`" + `
+}
+
+// @service()
+type Service interface {
+	// @http(method="GET", route="/search")
+	Search(ctx context.Context, req SearchRequest) (*SearchResponse, error)
+}
+
+type stringsService struct{}
+
+func New() Service {
+	return &stringsService{}
+}
+
+func (stringsService) Search(ctx context.Context, req SearchRequest) (*SearchResponse, error) {
+	return &SearchResponse{Count: req.Limit}, nil
+}
+`
+
+// decoderTestSource runs in the generated project and checks that all the parameter errors
+// of a request are returned in one bad request.
+const decoderTestSource = `package strings_test
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	service "stringsvc/strings"
+	"stringsvc/strings/gen/endpoint"
+	"stringsvc/strings/gen/transport/http"
+)
+
+func TestDecoder(t *testing.T) {
+	transport := http.MakeHttpTransport(endpoint.MakeEndpoints(service.New()))
+
+	recorder := httptest.NewRecorder()
+	transport.Router().ServeHTTP(recorder, httptest.NewRequest("GET", "/search?limit=ten", nil))
+	if recorder.Code != 400 {
+		t.Fatalf("expected status 400, got %d: %s", recorder.Code, recorder.Body)
+	}
+	var body struct {
+		Fields []map[string]string
+	}
+	if err := json.Unmarshal(recorder.Body.Bytes(), &body); err != nil {
+		t.Fatal(err)
+	}
+	var fields []string
+	for _, field := range body.Fields {
+		fields = append(fields, field["location"]+" "+field["name"]+" "+field["reason"])
+	}
+	expected := []string{
+		"query q required",
+		"query limit strconv.ParseInt: parsing \"ten\": invalid syntax",
+		"header X-Tenant-ID required",
+	}
+	if !reflect.DeepEqual(fields, expected) {
+		t.Fatalf("expected the fields %q, got %q", expected, fields)
+	}
+
+	recorder = httptest.NewRecorder()
+	request := httptest.NewRequest("GET", "/search?q=gs&limit=10", nil)
+	request.Header.Set("X-Tenant-ID", "shop")
+	transport.Router().ServeHTTP(recorder, request)
+	if recorder.Code != 200 {
+		t.Fatalf("expected status 200, got %d: %s", recorder.Code, recorder.Body)
+	}
+}
+`
+
+func TestGeneratedDecoderFieldErrors(t *testing.T) {
+	if testing.Short() {
+		t.Skip("testing the generated code is slow")
+	}
+	dir := copyFolder(t, filepath.Join("..", "example", "stringsvc"))
+	defer os.RemoveAll(dir)
+	for name, src := range map[string]string{
+		"service.go":      decoderServiceSource,
+		"decoder_test.go": decoderTestSource,
+	} {
+		if err := ioutil.WriteFile(filepath.Join(dir, "strings", name), []byte(src), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	inFolder(t, dir, func() {
+		cfg, err := config.Read()
+		if err != nil {
+			t.Fatal(err)
+		}
+		svcCfg := cfg.Services["strings"]
+		svcCfg.Transports = []string{config.HTTP}
+		if err := Generate("strings", svcCfg, cfg.Module, nil); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	cmd := exec.Command("go", "test", "./strings/")
+	cmd.Dir = dir
+	if out, err := cmd.CombinedOutput(); err != nil {
+		t.Fatalf("the generated decoder test failed: %s\n%s", err, out)
+	}
+}
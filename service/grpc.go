@@ -2,6 +2,7 @@ package service
 
 import (
 	"fmt"
+	"gs/config"
 	"strings"
 
 	"github.com/go-services/code"
@@ -25,15 +26,23 @@ type ProtoMessage struct {
 	Params []ProtoMessageParam
 	Type   code.Type
 	Struct *code.Struct
+	// Embeds are the embedded pointers of the structure the params are promoted through
+	Embeds []Embed
 }
 type ProtoMessageParam struct {
-	Repeat   bool
-	Name     string
-	GoName   string
+	Repeat bool
+	Name   string
+	GoName string
+	// GoPath is the selector of the field, for promoted fields it includes the embedded fields (e.x `Pagination.Limit`)
+	GoPath   string
 	Type     string
 	GoType   code.Type
 	Position int
 	Message  *ProtoMessage
+	// Basic is the go type of the proto field, for named basic types this is the underlying type
+	Basic code.Type
+	// Named is the named type the value is converted to when decoding (e.x `service.ID` for `type ID string`)
+	Named string
 }
 type GRPCEndpoint struct {
 	Name            string
@@ -45,6 +54,8 @@ type GRPCEndpoint struct {
 
 type GRPCTransport struct {
 	GRPCEndpoint []GRPCEndpoint
+	// Imports are the packages of the message and param types that are not in the service package.
+	Imports []code.Import
 }
 
 func (p *ProtoMessageParam) String() string {
@@ -64,7 +75,15 @@ func (m *ProtoMessage) String() string {
 	return s
 }
 
+// GRPCMethod returns the full grpc method name of the endpoint (e.x `/grpc.Users/List`).
+func (s Service) GRPCMethod(endpoint string) string {
+	return fmt.Sprintf("/%s.%s/%s", s.ProtoPackage(), s.Interface, endpoint)
+}
+
 func parseGRPCTransport(svc Service) *GRPCTransport {
+	if !svc.Config.HasTransport(config.GRPC) {
+		return nil
+	}
 	tp := &GRPCTransport{}
 	seen := map[string]*ProtoMessage{}
 	for _, ep := range svc.Endpoints {
@@ -94,8 +113,35 @@ func parseGRPCTransport(svc Service) *GRPCTransport {
 	if len(tp.GRPCEndpoint) == 0 {
 		return nil
 	}
+	tp.Imports = grpcImports(tp.GRPCEndpoint, svc.Import)
 	return tp
 }
+
+// grpcImports returns the imports used by the encoders and decoders, every package is imported once.
+func grpcImports(endpoints []GRPCEndpoint, serviceImport string) (imports []code.Import) {
+	seen := map[string]bool{serviceImport: true}
+	add := func(imp *code.Import) {
+		if imp == nil || seen[imp.Path] {
+			return
+		}
+		seen[imp.Path] = true
+		imports = append(imports, *imp)
+	}
+	for _, ep := range endpoints {
+		for _, msg := range ep.Messages {
+			add(msg.Type.Import)
+			for _, embed := range msg.Embeds {
+				add(embed.Type.Import)
+			}
+			for _, param := range msg.Params {
+				if param.Named != "" {
+					add(param.GoType.Import)
+				}
+			}
+		}
+	}
+	return imports
+}
 func parseGRPCEndpoint(ep Endpoint, errParam, respParam string, seen map[string]*ProtoMessage) GRPCEndpoint {
 	grpcEp := GRPCEndpoint{
 		Name:     ep.Name,
@@ -146,6 +192,25 @@ func parseGRPCEndpoint(ep Endpoint, errParam, respParam string, seen map[string]
 	return grpcEp
 }
 
+// addParam adds the param and the embedded pointers it is promoted through.
+func (m *ProtoMessage) addParam(param ProtoMessageParam, embeds []Embed) {
+	m.Params = append(m.Params, param)
+	for _, embed := range embeds {
+		if embed.Pointer && !m.hasEmbed(embed.Path) {
+			m.Embeds = append(m.Embeds, embed)
+		}
+	}
+}
+
+func (m *ProtoMessage) hasEmbed(path string) bool {
+	for _, embed := range m.Embeds {
+		if embed.Path == path {
+			return true
+		}
+	}
+	return false
+}
+
 func getMessageName(imp *code.Import, name string) string {
 	return strings.Title(strutil.ToCamelCase(imp.Alias)) + name
 }
@@ -161,7 +226,7 @@ func generateMessage(messages *[]ProtoMessage, tp code.Type, structure *code.Str
 		Name:   name,
 	}
 	seen[name] = &message
-	for _, field := range structure.Fields {
+	for _, field := range promotedFields(structure) {
 		tag := ""
 		if field.Tags != nil {
 			tag = getTag("grpc", *field.Tags)
@@ -177,17 +242,25 @@ func generateMessage(messages *[]ProtoMessage, tp code.Type, structure *code.Str
 			Repeat:   field.Type.ArrayType,
 			Name:     name,
 			GoName:   field.Name,
+			GoPath:   field.Path,
 			GoType:   field.Type,
 			Position: len(message.Params) + 1,
+			Basic:    field.Type,
+		}
+		// named basic types are sent as their underlying type
+		if underlying, ok := underlyingType(field.Type); ok && !underlying.Pointer {
+			param.Basic = underlying
+			param.Named = field.Type.String()
+			param.Repeat = underlying.ArrayType
 		}
-		if protoType, ok := goToProtoTypeMap[field.Type.Qualifier]; ok {
+		if protoType, ok := goToProtoTypeMap[param.Basic.Qualifier]; ok {
 			param.Type = protoType
-			message.Params = append(message.Params, param)
+			message.addParam(param, field.Embeds)
 			continue
 		}
-		if field.Type.String() == "[]byte" {
+		if param.Basic.String() == "[]byte" {
 			param.Type = "bytes"
-			message.Params = append(message.Params, param)
+			message.addParam(param, field.Embeds)
 			continue
 		}
 		// if the type is not exported we need to ignore it
@@ -198,7 +271,7 @@ func generateMessage(messages *[]ProtoMessage, tp code.Type, structure *code.Str
 		// recursive struct
 		if field.Type.Qualifier == structure.Name && field.Type.Import == nil {
 			param.Type = message.Name
-			message.Params = append(message.Params, param)
+			message.addParam(param, field.Embeds)
 			continue
 		}
 		if field.Type.Import != nil {
@@ -215,7 +288,7 @@ func generateMessage(messages *[]ProtoMessage, tp code.Type, structure *code.Str
 			}
 			param.Type = msgName
 			param.Message = obj
-			message.Params = append(message.Params, param)
+			message.addParam(param, field.Embeds)
 			continue
 		}
 	}
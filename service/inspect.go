@@ -0,0 +1,280 @@
+package service
+
+import (
+	"github.com/go-services/annotation"
+	"github.com/go-services/code"
+)
+
+// InspectVersion is the version of the inspect schema, it needs to be
+// changed every time a field is removed or changes meaning.
+const InspectVersion = 1
+
+// Model is the machine readable representation of the parsed services.
+type Model struct {
+	Version  int            `json:"version"`
+	Module   string         `json:"module"`
+	Services []ServiceModel `json:"services"`
+}
+
+type ServiceModel struct {
+	Name         string            `json:"name"`
+	Package      string            `json:"package"`
+	Import       string            `json:"import"`
+	Interface    string            `json:"interface"`
+	HttpAddress  string            `json:"http_address"`
+	GRPCAddress  string            `json:"grpc_address"`
+	DebugAddress string            `json:"debug_address"`
+	Annotations  []AnnotationModel `json:"annotations"`
+	Endpoints    []EndpointModel   `json:"endpoints"`
+}
+
+// AnnotationModel is a parsed annotation, only the parameters gs understands are included.
+type AnnotationModel struct {
+	Name       string                 `json:"name"`
+	Parameters map[string]interface{} `json:"parameters"`
+}
+
+type EndpointModel struct {
+	Name        string            `json:"name"`
+	Annotations []AnnotationModel `json:"annotations"`
+	Params      []ParameterModel  `json:"params"`
+	Results     []ParameterModel  `json:"results"`
+	Request     *StructModel      `json:"request"`
+	Response    *StructModel      `json:"response"`
+	Http        *HttpModel        `json:"http"`
+	GRPC        *GRPCModel        `json:"grpc"`
+}
+
+type ParameterModel struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+type StructModel struct {
+	Name   string       `json:"name"`
+	Import string       `json:"import"`
+	Fields []FieldModel `json:"fields"`
+}
+
+type FieldModel struct {
+	Name string            `json:"name"`
+	Type string            `json:"type"`
+	Tags map[string]string `json:"tags"`
+	// Validation are the validation rules of request fields (e.x `required`, `min=1`, `email`)
+	Validation []string `json:"validation,omitempty"`
+}
+
+type HttpModel struct {
+	Routes         []HttpRouteModel `json:"routes"`
+	RequestFormat  string           `json:"request_format"`
+	ResponseFormat string           `json:"response_format"`
+	Params         []HttpParamModel `json:"params"`
+}
+
+type HttpRouteModel struct {
+	Name    string   `json:"name"`
+	Methods []string `json:"methods"`
+	Route   string   `json:"route"`
+}
+
+type HttpParamModel struct {
+	Field string `json:"field"`
+	// Name is the url/query parameter, header or cookie name or the body format
+	Name     string `json:"name"`
+	Type     string `json:"type"`
+	Kind     string `json:"kind"`
+	Required bool   `json:"required"`
+}
+
+type GRPCModel struct {
+	Method   string         `json:"method"`
+	Request  string         `json:"request"`
+	Response string         `json:"response"`
+	Messages []MessageModel `json:"messages"`
+}
+
+type MessageModel struct {
+	Name   string              `json:"name"`
+	Fields []MessageFieldModel `json:"fields"`
+}
+
+type MessageFieldModel struct {
+	Name     string `json:"name"`
+	GoName   string `json:"go_name"`
+	Type     string `json:"type"`
+	Repeated bool   `json:"repeated"`
+	Number   int    `json:"number"`
+}
+
+// Inspect returns the machine readable model of the service.
+func (s *Service) Inspect() ServiceModel {
+	model := ServiceModel{
+		Name:         s.Name,
+		Package:      s.Package,
+		Import:       s.Import,
+		Interface:    s.Interface,
+		HttpAddress:  s.Config.Http.String(),
+		GRPCAddress:  s.Config.Grpc.String(),
+		DebugAddress: s.Config.Debug.String(),
+		Annotations:  inspectAnnotations(s.Annotations),
+		Endpoints:    []EndpointModel{},
+	}
+	grpcEndpoints := map[string]GRPCEndpoint{}
+	if s.GRPCTransport != nil {
+		for _, ep := range s.GRPCTransport.GRPCEndpoint {
+			grpcEndpoints[ep.Name] = ep
+		}
+	}
+	for _, ep := range s.Endpoints {
+		endpoint := EndpointModel{
+			Name:        ep.Name,
+			Annotations: inspectAnnotations(ep.Annotations),
+			Params:      inspectParameters(ep.Params),
+			Results:     inspectParameters(ep.Results),
+			Request:     inspectStruct(ep.Request, ep.RequestImport, ep.Validations),
+			Response:    inspectStruct(ep.Response, ep.ResponseImport, nil),
+			Http:        inspectHttp(ep.HttpTransport),
+		}
+		if grpcEp, ok := grpcEndpoints[ep.Name]; ok {
+			endpoint.GRPC = inspectGRPC(s.GRPCMethod(ep.Name), grpcEp)
+		}
+		model.Endpoints = append(model.Endpoints, endpoint)
+	}
+	return model
+}
+
+func inspectAnnotations(annotations []annotation.Annotation) []AnnotationModel {
+	models := []AnnotationModel{}
+	for _, ann := range annotations {
+		model := AnnotationModel{
+			Name:       ann.Name,
+			Parameters: map[string]interface{}{},
+		}
+		for _, p := range annotationParameters[ann.Name] {
+			v := ann.Get(p.name)
+			switch v.Type() {
+			case annotation.STRING:
+				model.Parameters[p.name] = v.String()
+			case annotation.INT:
+				model.Parameters[p.name] = v.Int()
+			case annotation.FLOAT:
+				model.Parameters[p.name] = v.Float()
+			case annotation.BOOL:
+				model.Parameters[p.name] = v.Bool()
+			}
+		}
+		models = append(models, model)
+	}
+	return models
+}
+
+func inspectParameters(params []code.Parameter) []ParameterModel {
+	models := []ParameterModel{}
+	for _, p := range params {
+		models = append(models, ParameterModel{
+			Name: p.Name,
+			Type: p.Type.String(),
+		})
+	}
+	return models
+}
+
+func inspectStruct(structure *code.Struct, imp *code.Import, validations []FieldValidation) *StructModel {
+	if structure == nil {
+		return nil
+	}
+	model := &StructModel{
+		Name:   structure.Name,
+		Fields: []FieldModel{},
+	}
+	if imp != nil {
+		model.Import = imp.Path
+	}
+	for _, field := range promotedFields(structure) {
+		f := FieldModel{
+			Name: field.Name,
+			Type: field.Type.String(),
+			Tags: map[string]string{},
+		}
+		if field.Tags != nil {
+			for k, v := range *field.Tags {
+				f.Tags[k] = v
+			}
+		}
+		for _, validation := range validations {
+			if validation.Field != field.Path {
+				continue
+			}
+			if validation.Optional {
+				f.Validation = append(f.Validation, "omitempty")
+			}
+			for _, rule := range validation.Rules {
+				f.Validation = append(f.Validation, rule.String())
+			}
+		}
+		model.Fields = append(model.Fields, f)
+	}
+	return model
+}
+
+func inspectHttp(transport *HttpTransport) *HttpModel {
+	if transport == nil {
+		return nil
+	}
+	model := &HttpModel{
+		Routes:         []HttpRouteModel{},
+		ResponseFormat: transport.ResponseFormat,
+		Params:         []HttpParamModel{},
+	}
+	for _, r := range transport.MethodRoutes {
+		model.Routes = append(model.Routes, HttpRouteModel{
+			Name:    r.Name,
+			Methods: r.Methods,
+			Route:   r.Route,
+		})
+	}
+	if transport.Request == nil {
+		return model
+	}
+	model.RequestFormat = string(transport.Request.Format)
+	for _, p := range transport.Request.Params {
+		param := HttpParamModel{
+			Field:    p.Field,
+			Name:     p.Name,
+			Kind:     string(p.ParamType),
+			Required: p.Required,
+		}
+		// body params do not have a type
+		if p.ParamType != BODY {
+			param.Type = p.Type.String()
+		}
+		model.Params = append(model.Params, param)
+	}
+	return model
+}
+
+func inspectGRPC(method string, ep GRPCEndpoint) *GRPCModel {
+	model := &GRPCModel{
+		Method:   method,
+		Request:  ep.RequestMessage.Name,
+		Response: ep.ResponseMessage.Name,
+		Messages: []MessageModel{},
+	}
+	for _, m := range ep.Messages {
+		message := MessageModel{
+			Name:   m.Name,
+			Fields: []MessageFieldModel{},
+		}
+		for _, p := range m.Params {
+			message.Fields = append(message.Fields, MessageFieldModel{
+				Name:     p.Name,
+				GoName:   p.GoName,
+				Type:     p.Type,
+				Repeated: p.Repeat,
+				Number:   p.Position,
+			})
+		}
+		model.Messages = append(model.Messages, message)
+	}
+	return model
+}
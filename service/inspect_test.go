@@ -0,0 +1,84 @@
+package service
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestInspect(t *testing.T) {
+	data, err := json.Marshal(Model{
+		Version:  InspectVersion,
+		Module:   "shop",
+		Services: []ServiceModel{parseTestService(t).Inspect()},
+	})
+	assert.NoError(t, err)
+
+	// the json is decoded without the go types so the test fails if the schema changes
+	var model struct {
+		Version  int    `json:"version"`
+		Module   string `json:"module"`
+		Services []struct {
+			Name        string `json:"name"`
+			Import      string `json:"import"`
+			Interface   string `json:"interface"`
+			HttpAddress string `json:"http_address"`
+			Endpoints   []struct {
+				Name    string `json:"name"`
+				Request *struct {
+					Name   string `json:"name"`
+					Fields []struct {
+						Name       string            `json:"name"`
+						Tags       map[string]string `json:"tags"`
+						Validation []string          `json:"validation"`
+					} `json:"fields"`
+				} `json:"request"`
+				Http *struct {
+					Routes []struct {
+						Methods []string `json:"methods"`
+						Route   string   `json:"route"`
+					} `json:"routes"`
+					Params []struct {
+						Field string `json:"field"`
+						Name  string `json:"name"`
+						Kind  string `json:"kind"`
+					} `json:"params"`
+				} `json:"http"`
+				GRPC *struct {
+					Method string `json:"method"`
+				} `json:"grpc"`
+			} `json:"endpoints"`
+		} `json:"services"`
+	}
+	assert.NoError(t, json.Unmarshal(data, &model))
+	assert.Equal(t, 1, model.Version)
+	assert.Equal(t, "shop", model.Module)
+
+	svc := model.Services[0]
+	assert.Equal(t, "users", svc.Name)
+	assert.Equal(t, "shop/users", svc.Import)
+	assert.Equal(t, "Users", svc.Interface)
+	assert.Equal(t, ":8000", svc.HttpAddress)
+	assert.Len(t, svc.Endpoints, 3)
+
+	get := svc.Endpoints[0]
+	assert.Equal(t, "Get", get.Name)
+	assert.Equal(t, "GetRequest", get.Request.Name)
+	assert.Equal(t, "/users/{id}", get.Http.Routes[0].Route)
+	assert.Equal(t, []string{"GET"}, get.Http.Routes[0].Methods)
+	assert.Equal(t, "ID", get.Http.Params[0].Field)
+	assert.Equal(t, "id", get.Http.Params[0].Name)
+	assert.Equal(t, "URL", get.Http.Params[0].Kind)
+	assert.Equal(t, "/grpc.Users/Get", get.GRPC.Method)
+
+	create := svc.Endpoints[1]
+	assert.Nil(t, create.GRPC)
+	assert.Equal(t, "name", create.Request.Fields[0].Tags["json"])
+	assert.Equal(t, []string{"required"}, create.Request.Fields[0].Validation)
+
+	ping := svc.Endpoints[2]
+	assert.Nil(t, ping.Request)
+	assert.Nil(t, ping.Http)
+	assert.Equal(t, "/grpc.Users/Ping", ping.GRPC.Method)
+}
@@ -0,0 +1,375 @@
+package service
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"gs/config"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/go-services/annotation"
+)
+
+type Severity string
+
+const (
+	ERROR   Severity = "error"
+	WARNING Severity = "warning"
+)
+
+// Diagnostic is a problem found while linting a service.
+type Diagnostic struct {
+	Service  string   `json:"service"`
+	File     string   `json:"file"`
+	Line     int      `json:"line"`
+	Severity Severity `json:"severity"`
+	Message  string   `json:"message"`
+}
+
+func (d Diagnostic) String() string {
+	return fmt.Sprintf("%s:%d: %s: %s", d.File, d.Line, d.Severity, d.Message)
+}
+
+// these are the annotations gs understands with all the parameters they accept.
+var annotationParameters = map[string][]annotationParameter{
+	"service": nil,
+	"http": {
+		{name: "method", required: true, tp: annotation.STRING},
+		{name: "route", required: true, tp: annotation.STRING},
+		{name: "request", tp: annotation.STRING},
+		{name: "response", tp: annotation.STRING},
+		{name: "keepTrailingSlash", tp: annotation.BOOL},
+		{name: "Name", tp: annotation.STRING},
+	},
+	"grpc": {
+		{name: "error_param", tp: annotation.STRING},
+		{name: "response_param", tp: annotation.STRING},
+	},
+}
+
+var annotationDefinitions = newAnnotationDefinitions(annotationParameters)
+
+type annotationParameter struct {
+	name     string
+	required bool
+	tp       annotation.ValueType
+}
+
+func newAnnotationDefinitions(annotations map[string][]annotationParameter) map[string]annotation.Definition {
+	definitions := map[string]annotation.Definition{}
+	for name, parameters := range annotations {
+		var parameterDefinitions []annotation.ParameterDefinition
+		for _, p := range parameters {
+			parameterDefinitions = append(
+				parameterDefinitions,
+				annotation.NewParameterDefinition(p.name, p.required, p.tp),
+			)
+		}
+		definitions[name] = annotation.NewDefinition(name, false, parameterDefinitions...)
+	}
+	return definitions
+}
+
+type linter struct {
+	service     string
+	file        string
+	data        string
+	diagnostics []Diagnostic
+}
+
+// Lint runs the service parser and reports all the problems it finds in the annotations
+// and the request/response structures without generating any code.
+func Lint(name string, cfg config.ServiceConfig, module string) ([]Diagnostic, error) {
+	resetPackageCache()
+
+	serviceModule, serviceImport, err := cfg.ResolveModule(name, module)
+	if err != nil {
+		return nil, err
+	}
+	ss, err := readServiceSource(cfg.Folder(name), cfg.Interface)
+	if err != nil {
+		return nil, err
+	}
+	l := &linter{
+		service: name,
+		file:    ss.File,
+		data:    ss.Data,
+	}
+
+	inf := ss.Interface
+	if inf == nil {
+		l.file = cfg.Folder(name)
+		l.report(ERROR, 1, "could not find service interface, make sure you are using @service()")
+		return l.diagnostics, nil
+	}
+	l.checkAnnotations(inf.Annotations(), l.line(inf.Begin()))
+
+	service := Service{
+		Interface:   inf.Name(),
+		Config:      cfg,
+		Name:        name,
+		Path:        cfg.Folder(name),
+		Module:      serviceModule,
+		Import:      serviceImport,
+		Package:     ss.Source.Package(),
+		Annotations: inf.Annotations(),
+	}
+	lines := map[string]int{}
+	for _, method := range filterMethods(inf.Methods()) {
+		line := l.line(method.Begin())
+		l.checkAnnotations(method.Annotations(), line)
+		ep, err := parseEndpoint(method, service)
+		if err != nil {
+			l.report(ERROR, line, fmt.Sprintf("endpoint `%s`: %s", method.Name(), err))
+			continue
+		}
+		l.checkRequest(*ep, line)
+		lines[ep.Name] = line
+		service.Endpoints = append(service.Endpoints, *ep)
+	}
+	l.checkRoutes(service.Endpoints, lines)
+	return l.diagnostics, nil
+}
+
+func (l *linter) report(severity Severity, line int, message string) {
+	l.reportInFile(severity, l.file, line, message)
+}
+
+func (l *linter) reportInFile(severity Severity, file string, line int, message string) {
+	l.diagnostics = append(l.diagnostics, Diagnostic{
+		Service:  l.service,
+		File:     file,
+		Line:     line,
+		Severity: severity,
+		Message:  message,
+	})
+}
+
+// line converts a source offset to a line number.
+func (l *linter) line(offset int) int {
+	if offset > len(l.data) {
+		offset = len(l.data)
+	}
+	return strings.Count(l.data[:offset], "\n") + 1
+}
+
+func (l *linter) checkAnnotations(annotations []annotation.Annotation, line int) {
+	seen := map[string]bool{}
+	for _, ann := range annotations {
+		definition, ok := annotationDefinitions[ann.Name]
+		if !ok {
+			continue
+		}
+		if seen[ann.Name] {
+			l.report(WARNING, line, fmt.Sprintf("only the first @%s() annotation is used", ann.Name))
+			continue
+		}
+		seen[ann.Name] = true
+		if err := definition.Check(ann); err != nil {
+			l.report(ERROR, line, err.Error())
+		}
+	}
+}
+
+func (l *linter) checkRequest(ep Endpoint, line int) {
+	if ep.Request == nil {
+		if ep.HttpTransport != nil {
+			for _, route := range ep.HttpTransport.MethodRoutes {
+				if routeVarRegex.MatchString(route.Route) {
+					l.report(ERROR, line, fmt.Sprintf(
+						"route `%s` of endpoint `%s` has url parameters but the endpoint has no request",
+						route.Route,
+						ep.Name,
+					))
+					break
+				}
+			}
+		}
+		return
+	}
+	file, lines := structFieldLines(structDir(ep.Params[1].Type), ep.Request.Name)
+	fieldLine := func(field promotedField) (string, int) {
+		file, lines := file, lines
+		if len(field.Embeds) > 0 {
+			// promoted fields are declared in the embedded structure
+			embedded := field.Embeds[len(field.Embeds)-1].Type
+			file, lines = structFieldLines(structDir(embedded), embedded.Qualifier)
+		}
+		if n, ok := lines[field.Name]; ok {
+			return file, n
+		}
+		return l.file, line
+	}
+
+	// url parameter names to field names and back
+	urlParams := map[string]string{}
+	urlParamNames := map[string]string{}
+	fields := promotedFields(ep.Request)
+	for _, field := range fields {
+		file, line := fieldLine(field)
+		if !isExported(field.Name) {
+			l.reportInFile(WARNING, file, line, fmt.Sprintf(
+				"field `%s` of `%s` is not exported and will be ignored by the transports",
+				field.Name,
+				ep.Request.Name,
+			))
+			continue
+		}
+		if field.Tags == nil {
+			continue
+		}
+		tp := field.Type.String()
+		if underlying, ok := underlyingType(field.Type); ok {
+			tp = underlying.String()
+		}
+		if tag := getTag("url", *field.Tags); tag != "" {
+			if !isUrlTypeSupported(tp) {
+				l.reportInFile(ERROR, file, line, fmt.Sprintf(
+					"field `%s` of type `%s` is not supported for url parameters",
+					field.Name,
+					field.Type,
+				))
+			}
+			name, _ := getParameter(tag)
+			urlParams[name] = field.Name
+			urlParamNames[field.Name] = name
+		}
+		for _, kind := range []string{"query", "header", "cookie"} {
+			if tag := getTag(kind, *field.Tags); tag != "" && !isQueryTypeSupported(tp) {
+				l.reportInFile(ERROR, file, line, fmt.Sprintf(
+					"field `%s` of type `%s` is not supported for %s parameters",
+					field.Name,
+					field.Type,
+					kind,
+				))
+			}
+		}
+		if tag := getTag("body", *field.Tags); tag != "" {
+			name, _ := getParameter(tag)
+			switch requestFormat(strings.ToUpper(name)) {
+			case JSON, XML, FORM:
+			default:
+				l.reportInFile(WARNING, file, line, fmt.Sprintf(
+					"body format `%s` of field `%s` is not supported, `json` will be used",
+					name,
+					field.Name,
+				))
+			}
+		}
+		// the rules that can not be generated would silently not be checked
+		_, errs, warnings := parseFieldValidation(field)
+		for _, err := range errs {
+			l.reportInFile(ERROR, file, line, err.Error())
+		}
+		for _, warning := range warnings {
+			l.reportInFile(WARNING, file, line, warning)
+		}
+	}
+
+	if ep.HttpTransport == nil {
+		return
+	}
+	routeVars := map[string]bool{}
+	var routeVarNames []string
+	for _, route := range ep.HttpTransport.MethodRoutes {
+		for _, match := range routeVarRegex.FindAllStringSubmatch(route.Route, -1) {
+			if !routeVars[match[1]] {
+				routeVarNames = append(routeVarNames, match[1])
+			}
+			routeVars[match[1]] = true
+		}
+	}
+	for _, name := range routeVarNames {
+		if _, ok := urlParams[name]; !ok {
+			l.report(ERROR, line, fmt.Sprintf(
+				"url parameter `{%s}` of endpoint `%s` has no matching `url` tag in `%s`",
+				name,
+				ep.Name,
+				ep.Request.Name,
+			))
+		}
+	}
+	for _, field := range fields {
+		name, ok := urlParamNames[field.Name]
+		if ok && !routeVars[name] {
+			file, line := fieldLine(field)
+			l.reportInFile(WARNING, file, line, fmt.Sprintf(
+				"url tag `%s` of field `%s` does not match any parameter in the route of endpoint `%s`",
+				name,
+				field.Name,
+				ep.Name,
+			))
+		}
+	}
+}
+
+func (l *linter) checkRoutes(endpoints []Endpoint, lines map[string]int) {
+	for _, conflict := range findRouteConflicts(endpoints) {
+		l.report(ERROR, lines[conflict.Endpoint], conflict.Error())
+	}
+}
+
+// structFieldLines finds the structure in the package folder and returns the file
+// of the structure and the line of each field.
+func structFieldLines(dir, name string) (string, map[string]int) {
+	lines := map[string]int{}
+	fSet := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fSet, dir, func(info os.FileInfo) bool {
+		return isPackageFile(filepath.Join(dir, info.Name()))
+	}, 0)
+	if err != nil {
+		return "", lines
+	}
+	file := ""
+	for _, pkg := range pkgs {
+		ast.Inspect(pkg, func(node ast.Node) bool {
+			spec, ok := node.(*ast.TypeSpec)
+			if !ok || spec.Name.Name != name {
+				return true
+			}
+			structure, ok := spec.Type.(*ast.StructType)
+			if !ok {
+				return false
+			}
+			file = relativePath(fSet.Position(spec.Pos()).Filename)
+			for _, field := range structure.Fields.List {
+				for _, n := range field.Names {
+					lines[n.Name] = fSet.Position(n.Pos()).Line
+				}
+				if len(field.Names) == 0 {
+					lines[embeddedName(field.Type)] = fSet.Position(field.Pos()).Line
+				}
+			}
+			return false
+		})
+	}
+	return file, lines
+}
+
+func embeddedName(expr ast.Expr) string {
+	switch t := expr.(type) {
+	case *ast.StarExpr:
+		return embeddedName(t.X)
+	case *ast.SelectorExpr:
+		return t.Sel.Name
+	case *ast.Ident:
+		return t.Name
+	}
+	return ""
+}
+
+// relativePath returns the path relative to the working directory if possible.
+func relativePath(pth string) string {
+	currentPath, err := os.Getwd()
+	if err != nil {
+		return pth
+	}
+	rel, err := filepath.Rel(currentPath, pth)
+	if err != nil {
+		return pth
+	}
+	return rel
+}
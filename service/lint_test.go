@@ -0,0 +1,157 @@
+package service
+
+import (
+	"fmt"
+	"gs/config"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const lintSource = `package users
+
+import "context"
+
+// @service()
+type Users interface {
+%s
+}
+
+type Response struct{}
+
+%s
+`
+
+// lintService writes the service source to a new module and lints it.
+func lintService(t *testing.T, methods, structs string) []Diagnostic {
+	dir := writePackage(t, map[string]string{
+		"go.mod": "module lint\n",
+	})
+	defer os.RemoveAll(dir)
+	if err := os.Mkdir(filepath.Join(dir, "users"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	source := fmt.Sprintf(lintSource, methods, structs)
+	if err := ioutil.WriteFile(filepath.Join(dir, "users", "service.go"), []byte(source), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	var diagnostics []Diagnostic
+	inFolder(t, dir, func() {
+		var err error
+		diagnostics, err = Lint("users", config.ServiceConfig{}, "lint")
+		assert.NoError(t, err)
+	})
+	return diagnostics
+}
+
+func TestLint(t *testing.T) {
+	tests := []struct {
+		name      string
+		methods   string
+		structs   string
+		severity  Severity
+		diagnosis string
+	}{
+		{
+			name: "unknown annotation parameter",
+			methods: `	// @http(method="GET", route="/users", color="red")
+	List(ctx context.Context) (*Response, error)`,
+			severity:  ERROR,
+			diagnosis: "unknown parameter: `color` in `@http()` Annotation",
+		},
+		{
+			name: "unsupported url type",
+			methods: `	// @http(method="GET", route="/users/{ids}")
+	Get(ctx context.Context, req GetRequest) (*Response, error)`,
+			structs:   "type GetRequest struct {\n\tIDs map[string]int `url:\"ids\"`\n}",
+			severity:  ERROR,
+			diagnosis: "field `IDs` of type `map[string]int` is not supported for url parameters",
+		},
+		{
+			name: "unsupported query type",
+			methods: `	// @http(method="GET", route="/users")
+	List(ctx context.Context, req ListRequest) (*Response, error)`,
+			structs:   "type ListRequest struct {\n\tFilter map[string]string `query:\"filter\"`\n}",
+			severity:  ERROR,
+			diagnosis: "field `Filter` of type `map[string]string` is not supported for query parameters",
+		},
+		{
+			name: "route parameter without url tag",
+			methods: `	// @http(method="GET", route="/users/{id}")
+	Get(ctx context.Context, req GetRequest) (*Response, error)`,
+			structs:   "type GetRequest struct {\n\tID string `json:\"id\"`\n}",
+			severity:  ERROR,
+			diagnosis: "url parameter `{id}` of endpoint `Get` has no matching `url` tag in `GetRequest`",
+		},
+		{
+			name: "url tag without route parameter",
+			methods: `	// @http(method="GET", route="/users")
+	Get(ctx context.Context, req GetRequest) (*Response, error)`,
+			structs:   "type GetRequest struct {\n\tID string `url:\"id\"`\n}",
+			severity:  WARNING,
+			diagnosis: "url tag `id` of field `ID` does not match any parameter in the route of endpoint `Get`",
+		},
+		{
+			name: "route parameter without request",
+			methods: `	// @http(method="GET", route="/users/{id}")
+	Get(ctx context.Context) (*Response, error)`,
+			severity:  ERROR,
+			diagnosis: "route `/users/{id}` of endpoint `Get` has url parameters but the endpoint has no request",
+		},
+		{
+			name: "duplicate routes",
+			methods: `	// @http(method="GET", route="/users")
+	List(ctx context.Context) (*Response, error)
+	// @http(method="GET", route="/users")
+	Search(ctx context.Context) (*Response, error)`,
+			severity:  ERROR,
+			diagnosis: "route `GET /users` of endpoint `Search` conflicts with route `GET /users` of endpoint `List`",
+		},
+		{
+			name: "unexported tagged field",
+			methods: `	// @http(method="POST", route="/users")
+	Create(ctx context.Context, req CreateRequest) (*Response, error)`,
+			structs:   "type CreateRequest struct {\n\tName string `json:\"name\"`\n\temail string `json:\"email\"`\n}",
+			severity:  WARNING,
+			diagnosis: "field `email` of `CreateRequest` is not exported and will be ignored by the transports",
+		},
+		{
+			name: "unknown validation rule",
+			methods: `	// @http(method="POST", route="/users")
+	Create(ctx context.Context, req CreateRequest) (*Response, error)`,
+			structs:   "type CreateRequest struct {\n\tName string `json:\"name\" validate:\"unique\"`\n}",
+			severity:  ERROR,
+			diagnosis: "field `Name`: unknown validation rule `unique`",
+		},
+		{
+			name: "item validation rules",
+			methods: `	// @http(method="POST", route="/users")
+	Create(ctx context.Context, req CreateRequest) (*Response, error)`,
+			structs:   "type CreateRequest struct {\n\tTags []string `json:\"tags\" validate:\"dive,required\"`\n}",
+			severity:  WARNING,
+			diagnosis: "field `Tags`: the rules after `dive` validate the items and are not generated",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			var messages []string
+			for _, d := range lintService(t, test.methods, test.structs) {
+				if d.Severity == test.severity {
+					messages = append(messages, d.Message)
+				}
+			}
+			assert.Contains(t, messages, test.diagnosis)
+		})
+	}
+}
+
+func TestLintValidService(t *testing.T) {
+	diagnostics := lintService(t, `	// @http(method="GET", route="/users/{id}")
+	Get(ctx context.Context, req GetRequest) (*Response, error)`,
+		"type GetRequest struct {\n\tID int `url:\"id\"`\n\tFields []string `query:\"fields\"`\n}")
+	assert.Empty(t, diagnostics)
+}
@@ -0,0 +1,128 @@
+package service
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"gs/fs"
+	"gs/template"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+)
+
+const manifestFile = ".gs-manifest"
+
+// manifest records the hashes of the inputs used to generate the service and of the files
+// that were generated so the next generation can be skipped if nothing changed.
+type manifest struct {
+	Inputs map[string]string `json:"inputs"`
+	Files  map[string]string `json:"files"`
+}
+
+// the hash of the running gs executable, a different gs version can generate different code.
+var executableHash string
+
+func hash(data string) string {
+	sum := sha256.Sum256([]byte(data))
+	return hex.EncodeToString(sum[:])
+}
+
+func gsHash() string {
+	if executableHash != "" {
+		return executableHash
+	}
+	executableHash = "unknown"
+	if pth, err := os.Executable(); err == nil {
+		if data, err := ioutil.ReadFile(pth); err == nil {
+			executableHash = hash(string(data))
+		}
+	}
+	return executableHash
+}
+
+// inputHashes returns the hashes of everything that the generated code depends on,
+// it needs to be called after the service is parsed so all the structure files are known.
+func (s *Service) inputHashes() (map[string]string, error) {
+	inputs := map[string]string{
+		"gs": gsHash(),
+	}
+	cfg, err := json.Marshal(struct {
+		Module  string
+		Config  interface{}
+		Plugins interface{}
+	}{s.Module, s.Config, s.Plugins})
+	if err != nil {
+		return nil, err
+	}
+	inputs["config"] = hash(string(cfg))
+
+	// all the files of the service package are inputs, the service interface can be in any of them
+	files, err := packageFiles(s.Path)
+	if err != nil {
+		return nil, err
+	}
+	files = append(files, loadedFiles()...)
+	if dir := template.Overrides(); dir != "" {
+		templates, err := fs.ListFiles(dir)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, templates...)
+	}
+	currentPath, err := os.Getwd()
+	if err != nil {
+		return nil, err
+	}
+	for _, pth := range files {
+		if rel, err := filepath.Rel(currentPath, pth); err == nil && filepath.IsAbs(pth) {
+			pth = filepath.ToSlash(rel)
+		}
+		data, err := fs.ReadFile(pth)
+		if err != nil {
+			return nil, err
+		}
+		inputs[pth] = hash(data)
+	}
+	return inputs, nil
+}
+
+func (s *Service) readManifest() *manifest {
+	data, err := fs.ReadFile(s.GetPath("gen", manifestFile))
+	if err != nil {
+		return nil
+	}
+	m := &manifest{}
+	if err := json.Unmarshal([]byte(data), m); err != nil {
+		log.Debugf("Ignoring invalid manifest: %s", err)
+		return nil
+	}
+	return m
+}
+
+func (s *Service) writeManifest(m manifest) error {
+	data, err := json.MarshalIndent(m, "", "  ")
+	if err != nil {
+		return err
+	}
+	return fs.WriteFile(s.GetPath("gen", manifestFile), string(data)+"\n")
+}
+
+// upToDate checks that the inputs did not change and that the generated files were not changed by hand.
+func (m *manifest) upToDate(inputs map[string]string) bool {
+	if len(m.Inputs) != len(inputs) {
+		return false
+	}
+	for k, v := range inputs {
+		if m.Inputs[k] != v {
+			return false
+		}
+	}
+	for pth, h := range m.Files {
+		data, err := fs.ReadFile(pth)
+		if err != nil || hash(data) != h {
+			return false
+		}
+	}
+	return true
+}
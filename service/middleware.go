@@ -0,0 +1,163 @@
+package service
+
+import (
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"gs/config"
+	"gs/fs"
+	"gs/template"
+	"path"
+
+	"github.com/go-services/code"
+	"github.com/go-services/source"
+	"github.com/ozgio/strutil"
+)
+
+// GenerateNewMiddleware creates a new service middleware that implements all the methods of the
+// service interface, if the middleware already exists only the missing methods are added.
+// If endpoint is not empty an endpoint middleware for that endpoint is created instead.
+func GenerateNewMiddleware(serviceName, name, endpoint string) error {
+	cfg, err := config.Read()
+	if err != nil {
+		return err
+	}
+	svcCfg, ok := cfg.Services[serviceName]
+	if !ok {
+		return fmt.Errorf("service `%s` does not exits in the configuration file", serviceName)
+	}
+	svc, err := Parse(serviceName, svcCfg, cfg.Module)
+	if err != nil {
+		return err
+	}
+	middlewareName := template.ToUpperFirst(strutil.ToCamelCase(name))
+	fileName := strutil.ToSnakeCase(name)
+	if endpoint == "" {
+		return svc.generateServiceMiddleware(
+			middlewareName+"Middleware",
+			svc.GetPath("middleware", fileName+".go"),
+		)
+	}
+	for _, ep := range svc.Endpoints {
+		if ep.Name == endpoint {
+			return svc.generateEndpointMiddleware(
+				middlewareName+ep.Name+"Middleware",
+				svc.GetPath("middleware", fileName+"_"+strutil.ToSnakeCase(ep.Name)+".go"),
+				ep,
+			)
+		}
+	}
+	return fmt.Errorf("endpoint `%s` does not exist in service `%s`", endpoint, serviceName)
+}
+
+func (s *Service) generateEndpointMiddleware(name, filePath string, endpoint Endpoint) error {
+	if b, err := fs.Exists(filePath); err != nil {
+		return err
+	} else if b {
+		return fmt.Errorf("middleware `%s` already exists", filePath)
+	}
+	if err := checkDeclarations(filePath, name); err != nil {
+		return err
+	}
+	src, err := template.CompileGoFromPath("middleware/endpoint_middleware.jet", map[string]interface{}{
+		"Name":     name,
+		"Service":  s,
+		"Endpoint": endpoint,
+	})
+	if err != nil {
+		return err
+	}
+	return fs.WriteFile(filePath, src)
+}
+
+func (s *Service) generateServiceMiddleware(name, filePath string) error {
+	if err := checkDeclarations(filePath, name, template.ToLowerFirst(name)); err != nil {
+		return err
+	}
+	data, err := fs.ReadFile(filePath)
+	if err != nil {
+		data, err = template.CompileFromPath("middleware/service_middleware.jet", map[string]interface{}{
+			"Name":    name,
+			"Service": s,
+			"Imports": s.endpointImports(),
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	// find the methods that are already implemented so we don't touch them
+	src, err := source.New(data)
+	if err != nil {
+		return err
+	}
+	implemented := map[string]bool{}
+	for _, fn := range src.Functions() {
+		if fn.Receiver() != nil && fn.Receiver().Type.Qualifier == template.ToLowerFirst(name) {
+			implemented[fn.Name()] = true
+		}
+	}
+	for _, ep := range s.Endpoints {
+		if implemented[ep.Name] {
+			continue
+		}
+		method, err := template.CompileFromPath("middleware/service_middleware_method.jet", map[string]interface{}{
+			"Name":     name,
+			"Endpoint": ep,
+		})
+		if err != nil {
+			return err
+		}
+		data += method
+	}
+	data, err = template.FormatGo(path.Base(filePath), data)
+	if err != nil {
+		return err
+	}
+	return fs.WriteFile(filePath, data)
+}
+
+// checkDeclarations checks that the names are not declared by the other files of the
+// package the file belongs to, so the new middleware does not break the package.
+func checkDeclarations(filePath string, names ...string) error {
+	files, err := packageFiles(path.Dir(filePath))
+	if err != nil {
+		return err
+	}
+	for _, file := range files {
+		if file == filePath {
+			continue
+		}
+		data, err := fs.ReadFile(file)
+		if err != nil {
+			return err
+		}
+		f, err := parser.ParseFile(token.NewFileSet(), file, data, 0)
+		if err != nil {
+			return err
+		}
+		for _, name := range names {
+			if obj := f.Scope.Lookup(name); obj != nil && obj.Kind != ast.Bad {
+				return fmt.Errorf("`%s` is already declared in `%s`, choose another name for the middleware", name, file)
+			}
+		}
+	}
+	return nil
+}
+
+// endpointImports returns the imports of requests and responses that are not
+// in the service package.
+func (s *Service) endpointImports() (imports []*code.Import) {
+	seen := map[string]bool{s.Import: true}
+	for _, ep := range s.Endpoints {
+		for _, imp := range []*code.Import{ep.RequestImport, ep.ResponseImport} {
+			if imp == nil || seen[imp.Path] {
+				continue
+			}
+			seen[imp.Path] = true
+			imports = append(imports, imp)
+		}
+	}
+	return imports
+}
@@ -0,0 +1,51 @@
+package service
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGenerateNewMiddleware(t *testing.T) {
+	dir := copyFolder(t, filepath.Join("..", "example", "addsvc"))
+	defer os.RemoveAll(dir)
+	folder := filepath.Join(dir, "add", "middleware")
+
+	inFolder(t, dir, func() {
+		assert.NoError(t, GenerateNewMiddleware("add", "audit", ""))
+		assert.NoError(t, GenerateNewMiddleware("add", "audit", ""), "an existing service middleware should be completed")
+		assert.NoError(t, GenerateNewMiddleware("add", "audit", "Sum"))
+
+		assert.EqualError(
+			t,
+			GenerateNewMiddleware("add", "logging", ""),
+			"`LoggingMiddleware` is already declared in `add/middleware/service_logging.go`, choose another name for the middleware",
+		)
+		assert.EqualError(t, GenerateNewMiddleware("add", "audit", "Sum"), "middleware `add/middleware/audit_sum.go` already exists")
+		assert.EqualError(t, GenerateNewMiddleware("add", "audit", "Divide"), "endpoint `Divide` does not exist in service `add`")
+		assert.EqualError(t, GenerateNewMiddleware("numbers", "audit", ""), "service `numbers` does not exits in the configuration file")
+	})
+
+	data, err := ioutil.ReadFile(filepath.Join(folder, "audit.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	src := string(data)
+	assert.Contains(t, src, "func AuditMiddleware() genService.Middleware {")
+	assert.Contains(t, src, "type auditMiddleware struct {")
+	assert.Equal(t, 1, strings.Count(src, "func (mw auditMiddleware) Sum("))
+	assert.Equal(t, 1, strings.Count(src, "func (mw auditMiddleware) Concat("))
+
+	data, err = ioutil.ReadFile(filepath.Join(folder, "audit_sum.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Contains(t, string(data), "func AuditSumMiddleware() definitions.SumEndpointMiddleware {")
+
+	_, err = os.Stat(filepath.Join(folder, "logging.go"))
+	assert.True(t, os.IsNotExist(err), "a conflicting middleware should not be written")
+}
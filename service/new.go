@@ -0,0 +1,193 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"go/parser"
+	"go/token"
+	"gs/config"
+	"gs/fs"
+	"gs/template"
+	"strconv"
+	"strings"
+
+	"github.com/go-services/code"
+	"github.com/ozgio/strutil"
+)
+
+// GenerateNewEndpoint adds a new endpoint to an existing service, it adds the method to the
+// service interface, creates the request/response structures and a stub implementation.
+// httpMethodRoute has the format `method:/route` e.x `post:/sum`, if it is empty the endpoint
+// will not have an http transport.
+func GenerateNewEndpoint(serviceName, name, httpMethodRoute string, grpc bool) error {
+	cfg, err := config.Read()
+	if err != nil {
+		return err
+	}
+	svcCfg, ok := cfg.Services[serviceName]
+	if !ok {
+		return fmt.Errorf("service `%s` does not exits in the configuration file", serviceName)
+	}
+
+	// endpoint names need to be exported
+	endpointName := template.ToUpperFirst(strutil.ToCamelCase(name))
+
+	ss, err := readServiceSource(svcCfg.Folder(serviceName), svcCfg.Interface)
+	if err != nil {
+		return err
+	}
+	servicePath, data, src, inf := ss.File, ss.Data, ss.Source, ss.Interface
+	if inf == nil {
+		return errors.New("could not find service interface, make sure you are using @service()")
+	}
+	for _, method := range inf.Methods() {
+		if method.Name() == endpointName {
+			return fmt.Errorf("endpoint `%s` already exists in service `%s`", endpointName, serviceName)
+		}
+	}
+
+	var docs []code.Comment
+	if httpMethodRoute != "" {
+		method, route, err := parseHttpMethodRoute(httpMethodRoute)
+		if err != nil {
+			return err
+		}
+		docs = append(docs, code.NewComment(fmt.Sprintf(`@http(method="%s", route="%s")`, method, route)))
+	}
+	if grpc {
+		docs = append(docs, code.NewComment("@grpc()"))
+	}
+
+	if !hasImport(data, "context") {
+		if err := src.AppendImport(code.Import{Path: "context"}); err != nil {
+			return err
+		}
+	}
+
+	requestName := endpointName + "Request"
+	responseName := endpointName + "Response"
+	for _, name := range []string{requestName, responseName} {
+		if ss.hasStructure(name) {
+			continue
+		}
+		if err := src.AppendStructure(*code.NewStruct(name)); err != nil {
+			return err
+		}
+	}
+
+	params := []code.Parameter{
+		*code.NewParameter("ctx", code.NewType("Context", code.ImportTypeOption(code.NewImport("context", "context")))),
+		*code.NewParameter("request", code.NewType(requestName)),
+	}
+	results := []code.Parameter{
+		*code.NewParameter("", code.NewType(responseName, code.PointerTypeOption())),
+		*code.NewParameter("", code.NewType("error")),
+	}
+	method := code.NewInterfaceMethod(
+		endpointName,
+		code.ParamsFunctionOption(params...),
+		code.ResultsFunctionOption(results...),
+		code.DocsFunctionOption(docs...),
+	)
+	if err := src.AppendMethodToInterface(inf.Name(), method); err != nil {
+		return err
+	}
+
+	data, err = src.String()
+	if err != nil {
+		return err
+	}
+	if err := fs.WriteFile(servicePath, data); err != nil {
+		return err
+	}
+
+	// the implementation can be in any file of the package
+	implementationPath, implementation := findServiceImplementation(ss, serviceName)
+	if implementation != "" {
+		implementationSrc := ss.Files[implementationPath]
+		if implementationPath != servicePath {
+			if data, err = implementationSrc.String(); err != nil {
+				return err
+			}
+			if !hasImport(data, "context") {
+				if err := implementationSrc.AppendImport(code.Import{Path: "context"}); err != nil {
+					return err
+				}
+			}
+		}
+		stub := code.NewFunction(
+			endpointName,
+			code.RecvFunctionOption(code.NewParameter(
+				strings.ToLower(implementation[:1]),
+				code.NewType(implementation),
+			)),
+			code.ParamsFunctionOption(params...),
+			code.ResultsFunctionOption(results...),
+		)
+		stub.AddStringBody(fmt.Sprintf("return &%s{}, nil", responseName))
+		if err := implementationSrc.AppendFunction(*stub); err != nil {
+			return err
+		}
+		data, err = implementationSrc.String()
+		if err != nil {
+			return err
+		}
+		if err := fs.WriteFile(implementationPath, data); err != nil {
+			return err
+		}
+	}
+	return GenerateServices(cfg, []string{serviceName})
+}
+
+// parseHttpMethodRoute parses the `method:/route` format used by the cli.
+func parseHttpMethodRoute(s string) (method string, route string, err error) {
+	parts := strings.SplitN(s, ":", 2)
+	if len(parts) != 2 || parts[0] == "" {
+		return "", "", fmt.Errorf("http must be in the format `method:/route`, got `%s`", s)
+	}
+	method = strings.ToLower(strings.TrimSpace(parts[0]))
+	route = strings.TrimSpace(parts[1])
+	if !strings.HasPrefix(route, "/") {
+		route = "/" + route
+	}
+	return method, route, nil
+}
+
+func hasImport(data, pth string) bool {
+	file, err := parser.ParseFile(token.NewFileSet(), "", data, parser.ImportsOnly)
+	if err != nil {
+		return false
+	}
+	for _, imp := range file.Imports {
+		if p, _ := strconv.Unquote(imp.Path.Value); p == pth {
+			return true
+		}
+	}
+	return false
+}
+
+// findServiceImplementation finds the structure that implements the service and the file
+// that declares it, by default this is the structure created by `gs new service`.
+func findServiceImplementation(ss *serviceSource, serviceName string) (file, implementation string) {
+	files := append([]string{ss.File}, ss.files()...)
+	name := template.ToLowerFirst(serviceName) + "Service"
+	for _, file := range files {
+		if _, err := ss.Files[file].GetStructure(name); err == nil {
+			return file, name
+		}
+	}
+	// if the default structure does not exist we try to find a structure that
+	// already implements one of the service methods.
+	for _, file := range files {
+		src := ss.Files[file]
+		for _, fn := range src.Functions() {
+			if fn.Receiver() == nil {
+				continue
+			}
+			if _, err := src.GetStructure(fn.Receiver().Type.Qualifier); err == nil {
+				return file, fn.Receiver().Type.Qualifier
+			}
+		}
+	}
+	return "", ""
+}
@@ -0,0 +1,130 @@
+package service
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseHttpMethodRoute(t *testing.T) {
+	tests := []struct {
+		in     string
+		method string
+		route  string
+		err    bool
+	}{
+		{in: "post:/sum", method: "post", route: "/sum"},
+		{in: "GET:/users/{id}", method: "get", route: "/users/{id}"},
+		{in: " put : users ", method: "put", route: "/users"},
+		{in: "get:/time?format=iso", method: "get", route: "/time?format=iso"},
+		{in: "/sum", err: true},
+		{in: ":/sum", err: true},
+	}
+	for _, test := range tests {
+		method, route, err := parseHttpMethodRoute(test.in)
+		if test.err {
+			assert.Error(t, err, test.in)
+			continue
+		}
+		assert.NoError(t, err, test.in)
+		assert.Equal(t, test.method, method, test.in)
+		assert.Equal(t, test.route, route, test.in)
+	}
+}
+
+func TestHasImport(t *testing.T) {
+	data := "package users\n\nimport (\n\t\"context\"\n\tstd \"strings\"\n)\n"
+	assert.True(t, hasImport(data, "context"))
+	assert.True(t, hasImport(data, "strings"), "named imports should be found")
+	assert.False(t, hasImport(data, "fmt"))
+	assert.False(t, hasImport("not go", "context"))
+}
+
+func TestFindServiceImplementation(t *testing.T) {
+	service := "package users\n\n// @service()\ntype Users interface {\n\tList()\n}\n"
+	tests := []struct {
+		name           string
+		files          map[string]string
+		file           string
+		implementation string
+	}{
+		{
+			name: "default structure",
+			files: map[string]string{
+				"service.go": service,
+				"impl.go":    "package users\n\ntype usersService struct{}\n",
+			},
+			file:           "impl.go",
+			implementation: "usersService",
+		},
+		{
+			name: "structure with methods",
+			files: map[string]string{
+				"service.go": service,
+				"store.go":   "package users\n\ntype store struct{}\n\nfunc (s store) List() {}\n",
+			},
+			file:           "store.go",
+			implementation: "store",
+		},
+		{
+			name: "no implementation",
+			files: map[string]string{
+				"service.go": service,
+			},
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			dir := writePackage(t, test.files)
+			defer os.RemoveAll(dir)
+
+			ss, err := readServiceSource(dir, "")
+			assert.NoError(t, err)
+			file, implementation := findServiceImplementation(ss, "users")
+			assert.Equal(t, test.implementation, implementation)
+			if test.file != "" {
+				assert.Equal(t, filepath.Join(dir, test.file), file)
+			}
+		})
+	}
+}
+
+func TestGenerateNewEndpoint(t *testing.T) {
+	dir := copyFolder(t, filepath.Join("..", "example", "stringsvc"))
+	defer os.RemoveAll(dir)
+	// only the http transport is generated so the test does not need protoc
+	cfg := "version = 1\n\n[services]\n\n  [services.strings]\n    transports = [\"http\"]\n"
+	if err := ioutil.WriteFile(filepath.Join(dir, "gs.toml"), []byte(cfg), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	inFolder(t, dir, func() {
+		assert.NoError(t, GenerateNewEndpoint("strings", "reverseWords", "get:reverse", false))
+		assert.EqualError(t, GenerateNewEndpoint("strings", "count", "", false), "endpoint `Count` already exists in service `strings`")
+		assert.EqualError(t, GenerateNewEndpoint("numbers", "add", "", false), "service `numbers` does not exits in the configuration file")
+		assert.Error(t, GenerateNewEndpoint("strings", "split", "/split", false))
+	})
+
+	data, err := ioutil.ReadFile(filepath.Join(dir, "strings", "service.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	src := string(data)
+	assert.Contains(t, src, `// @http(method="get", route="/reverse")`)
+	assert.Contains(t, src, "ReverseWords(ctx context.Context, request ReverseWordsRequest) (*ReverseWordsResponse, error)")
+	assert.Contains(t, src, "type ReverseWordsRequest struct")
+	assert.Contains(t, src, "type ReverseWordsResponse struct")
+	assert.Contains(t, src, "func (s stringsService) ReverseWords(")
+	assert.Equal(t, 1, strings.Count(src, "\"context\""), "context should only be imported once")
+	assert.NotContains(t, src, "Split", "a failed endpoint should not change the service")
+
+	gen, err := ioutil.ReadFile(filepath.Join(dir, "strings", "gen", "transport", "http", "reversewords.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	assert.Contains(t, string(gen), "ReverseWords")
+}
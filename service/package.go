@@ -0,0 +1,129 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"go/build"
+	"gs/fs"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/go-services/source"
+)
+
+// serviceSource is the service package, the service interface can be declared in any file of the package.
+type serviceSource struct {
+	// File is the file that declares the service interface.
+	File      string
+	Data      string
+	Source    *source.Source
+	Interface *source.Interface
+
+	// Files are the sources of all the files of the package by path.
+	Files map[string]*source.Source
+}
+
+// readServiceSource reads all the files of the service package and finds the @service() interface,
+// if the package declares more than one service interface the interface needs to be chosen with
+// `interface` in the service configuration.
+// If the package does not have a service interface the returned source has no interface.
+func readServiceSource(folder, name string) (*serviceSource, error) {
+	files, err := packageFiles(folder)
+	if err != nil {
+		return nil, errors.New("A read error occurred. Please update your code..: " + err.Error())
+	}
+	ss := &serviceSource{
+		Files: map[string]*source.Source{},
+	}
+	var found []string
+	for _, file := range files {
+		data, err := fs.ReadFile(file)
+		if err != nil {
+			return nil, errors.New("A read error occurred. Please update your code..: " + err.Error())
+		}
+		src, err := source.New(data)
+		if err != nil {
+			return nil, fmt.Errorf("A read error occurred. Please update your code..: %s: %s", file, err)
+		}
+		ss.Files[file] = src
+		for _, inf := range findServiceInterfaces(src) {
+			if name != "" && inf.Name() != name {
+				continue
+			}
+			found = append(found, fmt.Sprintf("`%s` (%s)", inf.Name(), file))
+			if ss.Interface == nil {
+				inf := inf
+				ss.File, ss.Data, ss.Source, ss.Interface = file, data, src, &inf
+			}
+		}
+	}
+	if len(found) > 1 {
+		return nil, fmt.Errorf(
+			"found more than one @service() interface in `%s`: %s, choose one with `interface` in the service configuration",
+			folder,
+			strings.Join(found, ", "),
+		)
+	}
+	if ss.Interface == nil && name != "" {
+		return nil, fmt.Errorf("could not find the @service() interface `%s` in `%s`", name, folder)
+	}
+	return ss, nil
+}
+
+// files returns the paths of the package files without the interface file.
+func (ss *serviceSource) files() (files []string) {
+	for file := range ss.Files {
+		if file != ss.File {
+			files = append(files, file)
+		}
+	}
+	sort.Strings(files)
+	return files
+}
+
+// hasStructure checks if any file of the package declares the structure.
+func (ss *serviceSource) hasStructure(name string) bool {
+	for _, src := range ss.Files {
+		if _, err := src.GetStructure(name); err == nil {
+			return true
+		}
+	}
+	return false
+}
+
+// packageFiles returns the go files of the package in the folder,
+// test files and files excluded by build constraints are skipped.
+func packageFiles(folder string) (files []string, err error) {
+	all, err := fs.ListFiles(folder)
+	if err != nil {
+		return nil, err
+	}
+	for _, file := range all {
+		if filepath.Dir(file) != filepath.Clean(folder) || !isPackageFile(file) {
+			continue
+		}
+		files = append(files, filepath.ToSlash(file))
+	}
+	sort.Strings(files)
+	return files, nil
+}
+
+// isPackageFile checks if the go file is part of the package for the current build context.
+func isPackageFile(file string) bool {
+	if filepath.Ext(file) != ".go" || strings.HasSuffix(file, "_test.go") {
+		return false
+	}
+	match, err := build.Default.MatchFile(filepath.Dir(file), filepath.Base(file))
+	return err == nil && match
+}
+
+func findServiceInterfaces(src *source.Source) (interfaces []source.Interface) {
+	for _, inf := range src.Interfaces() {
+		annotations := source.FindAnnotations("service", &inf)
+		if len(annotations) > 0 {
+			interfaces = append(interfaces, inf)
+		}
+	}
+	return interfaces
+}
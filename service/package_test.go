@@ -0,0 +1,59 @@
+package service
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func writePackage(t *testing.T, files map[string]string) string {
+	dir, err := ioutil.TempDir("", "gs-package")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, data := range files {
+		if err := ioutil.WriteFile(filepath.Join(dir, name), []byte(data), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+func TestReadServiceSource(t *testing.T) {
+	dir := writePackage(t, map[string]string{
+		"api.go":      "package billing\n\n// @service()\ntype Billing interface {}\n",
+		"impl.go":     "package billing\n\ntype billingService struct{}\n",
+		"legacy.go":   "// +build ignore\n\npackage billing\n\n// @service()\ntype Legacy interface {}\n",
+		"api_test.go": "package billing\n\n// @service()\ntype Mock interface {}\n",
+		"README.md":   "# billing\n",
+	})
+	defer os.RemoveAll(dir)
+
+	ss, err := readServiceSource(dir, "")
+	assert.NoError(t, err)
+	assert.Equal(t, "Billing", ss.Interface.Name())
+	assert.Equal(t, filepath.ToSlash(filepath.Join(dir, "api.go")), ss.File)
+	assert.Len(t, ss.Files, 2, "should skip test files and files excluded by build constraints")
+	assert.True(t, ss.hasStructure("billingService"))
+}
+
+func TestReadServiceSourceMultipleInterfaces(t *testing.T) {
+	dir := writePackage(t, map[string]string{
+		"api.go":   "package billing\n\n// @service()\ntype Billing interface {}\n",
+		"admin.go": "package billing\n\n// @service()\ntype Admin interface {}\n",
+	})
+	defer os.RemoveAll(dir)
+
+	_, err := readServiceSource(dir, "")
+	assert.Error(t, err)
+
+	ss, err := readServiceSource(dir, "Admin")
+	assert.NoError(t, err)
+	assert.Equal(t, "Admin", ss.Interface.Name())
+
+	_, err = readServiceSource(dir, "Users")
+	assert.Error(t, err)
+}
@@ -0,0 +1,110 @@
+package service
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"gs/config"
+	"os/exec"
+	"path"
+	"sort"
+	"strings"
+)
+
+// PluginRequest is written as json to the stdin of the plugin.
+type PluginRequest struct {
+	Version int          `json:"version"`
+	Plugin  string       `json:"plugin"`
+	Module  string       `json:"module"`
+	Service ServiceModel `json:"service"`
+}
+
+// PluginResponse is read as json from the stdout of the plugin,
+// if the plugin sets the error no file is written.
+type PluginResponse struct {
+	Files []PluginFile `json:"files"`
+	Error string       `json:"error,omitempty"`
+}
+
+// PluginFile is a file generated by a plugin, the path is relative to the output folder of the plugin.
+type PluginFile struct {
+	Path    string `json:"path"`
+	Content string `json:"content"`
+}
+
+// renderPlugins runs all the configured plugins and adds the files they return.
+func (s *Service) renderPlugins(files map[string]string) error {
+	var names []string
+	for name := range s.Plugins {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		if err := s.renderPlugin(name, s.Plugins[name], files); err != nil {
+			return fmt.Errorf("plugin `%s`: %s", name, err)
+		}
+	}
+	return nil
+}
+
+func (s *Service) renderPlugin(name string, plugin config.PluginConfig, files map[string]string) error {
+	if plugin.Cmd == "" {
+		return errors.New("the plugin has no cmd")
+	}
+	out := plugin.Out
+	if out == "" {
+		out = "gen"
+	}
+	out = path.Clean(out)
+	if !isRelativePath(out) {
+		return fmt.Errorf("the output folder `%s` needs to be inside the service folder", plugin.Out)
+	}
+
+	request, err := json.Marshal(PluginRequest{
+		Version: InspectVersion,
+		Plugin:  name,
+		Module:  s.Module,
+		Service: s.Inspect(),
+	})
+	if err != nil {
+		return err
+	}
+	var stdout, stderr bytes.Buffer
+	cmd := exec.Command(plugin.Cmd, plugin.Args...)
+	cmd.Stdin = bytes.NewReader(request)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	log.Debugf("Running plugin `%s`", name)
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return fmt.Errorf("%s: %s", err, msg)
+		}
+		return err
+	}
+
+	response := PluginResponse{}
+	if err := json.Unmarshal(stdout.Bytes(), &response); err != nil {
+		return fmt.Errorf("could not read the response: %s", err)
+	}
+	if response.Error != "" {
+		return errors.New(response.Error)
+	}
+	for _, f := range response.Files {
+		pth := path.Clean(f.Path)
+		if f.Path == "" || !isRelativePath(pth) {
+			return fmt.Errorf("the file `%s` needs to be inside the output folder", f.Path)
+		}
+		pth = s.GetPath(out, pth)
+		if _, ok := files[pth]; ok {
+			return fmt.Errorf("the file `%s` is already generated", pth)
+		}
+		files[pth] = f.Content
+	}
+	return nil
+}
+
+// isRelativePath checks that a cleaned path does not leave the folder it is relative to.
+func isRelativePath(pth string) bool {
+	return pth != "." && pth != ".." && !path.IsAbs(pth) && !strings.HasPrefix(pth, "../")
+}
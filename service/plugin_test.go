@@ -0,0 +1,106 @@
+package service
+
+import (
+	"encoding/json"
+	"gs/config"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// TestPluginHelper is not a real test, it is the plugin process started by the plugin tests.
+// It answers with the response in $GS_TEST_PLUGIN_RESPONSE and adds the request it received
+// as `request.json` if the response has no error.
+func TestPluginHelper(t *testing.T) {
+	if os.Getenv("GS_TEST_PLUGIN") != "1" {
+		return
+	}
+	request, err := ioutil.ReadAll(os.Stdin)
+	if err != nil {
+		os.Exit(1)
+	}
+	response := PluginResponse{}
+	if err := json.Unmarshal([]byte(os.Getenv("GS_TEST_PLUGIN_RESPONSE")), &response); err != nil {
+		os.Exit(1)
+	}
+	if response.Error == "" {
+		response.Files = append(response.Files, PluginFile{Path: "request.json", Content: string(request)})
+	}
+	_ = json.NewEncoder(os.Stdout).Encode(response)
+	os.Exit(0)
+}
+
+// runTestPlugin runs the helper plugin that returns the response.
+func runTestPlugin(t *testing.T, out string, response PluginResponse, files map[string]string) error {
+	data, err := json.Marshal(response)
+	if err != nil {
+		t.Fatal(err)
+	}
+	os.Setenv("GS_TEST_PLUGIN", "1")
+	os.Setenv("GS_TEST_PLUGIN_RESPONSE", string(data))
+	defer os.Unsetenv("GS_TEST_PLUGIN")
+	defer os.Unsetenv("GS_TEST_PLUGIN_RESPONSE")
+
+	s := &Service{
+		Name:   "billing",
+		Path:   "services/billing",
+		Module: "example.com/shop",
+		Import: "example.com/shop/services/billing",
+		Plugins: map[string]config.PluginConfig{
+			"docs": {Cmd: os.Args[0], Args: []string{"-test.run=TestPluginHelper"}, Out: out},
+		},
+	}
+	return s.renderPlugins(files)
+}
+
+func TestRenderPlugins(t *testing.T) {
+	files := map[string]string{}
+	err := runTestPlugin(t, "docs", PluginResponse{
+		Files: []PluginFile{{Path: "api/index.md", Content: "# billing"}},
+	}, files)
+	assert.NoError(t, err)
+	assert.Equal(t, "# billing", files["services/billing/docs/api/index.md"])
+
+	request := PluginRequest{}
+	assert.NoError(t, json.Unmarshal([]byte(files["services/billing/docs/request.json"]), &request))
+	assert.Equal(t, InspectVersion, request.Version)
+	assert.Equal(t, "docs", request.Plugin)
+	assert.Equal(t, "example.com/shop", request.Module)
+	assert.Equal(t, "billing", request.Service.Name)
+
+	files = map[string]string{}
+	assert.NoError(t, runTestPlugin(t, "", PluginResponse{}, files))
+	assert.Contains(t, files, "services/billing/gen/request.json", "the files should be written to gen by default")
+}
+
+func TestRenderPluginsErrors(t *testing.T) {
+	err := runTestPlugin(t, "", PluginResponse{Error: "no endpoints"}, map[string]string{})
+	assert.EqualError(t, err, "plugin `docs`: no endpoints")
+
+	err = runTestPlugin(t, "../docs", PluginResponse{}, map[string]string{})
+	assert.EqualError(t, err, "plugin `docs`: the output folder `../docs` needs to be inside the service folder")
+
+	for _, pth := range []string{"../../go.mod", "/etc/passwd", "a/../../b", ""} {
+		err = runTestPlugin(t, "", PluginResponse{Files: []PluginFile{{Path: pth}}}, map[string]string{})
+		assert.Error(t, err, pth)
+	}
+
+	err = runTestPlugin(t, "", PluginResponse{}, map[string]string{"services/billing/gen/request.json": ""})
+	assert.EqualError(t, err, "plugin `docs`: the file `services/billing/gen/request.json` is already generated")
+}
+
+func TestIsRelativePath(t *testing.T) {
+	for pth, relative := range map[string]bool{
+		"gen":       true,
+		"gen/docs":  true,
+		"..gen":     true,
+		".":         false,
+		"..":        false,
+		"../gen":    false,
+		"/tmp/docs": false,
+	} {
+		assert.Equal(t, relative, isRelativePath(pth), pth)
+	}
+}
@@ -0,0 +1,321 @@
+package service
+
+import (
+	"bytes"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"gs/config"
+	"gs/fs"
+	"path"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ozgio/strutil"
+)
+
+// Remove deletes the service folder and removes the service from the configuration.
+func Remove(name string) error {
+	cfg, err := config.Read()
+	if err != nil {
+		return err
+	}
+	svcCfg, ok := cfg.Services[name]
+	if !ok {
+		return fmt.Errorf("service `%s` does not exits in the configuration file", name)
+	}
+	_, serviceImport, err := svcCfg.ResolveModule(name, cfg.Module)
+	if err != nil {
+		return err
+	}
+	importers, err := findImporters(serviceImport, svcCfg.Folder(name))
+	if err != nil {
+		return err
+	}
+	for _, file := range importers {
+		log.Warnf("`%s` imports service `%s` and needs to be updated", file, name)
+	}
+	if err := fs.DeleteFolder(svcCfg.Folder(name)); err != nil {
+		return err
+	}
+	return config.RemoveService(name)
+}
+
+// Rename moves the service to a new folder, renames the service package, fixes all the
+// imports of the service in the module and generates the service again.
+// Services with a custom path keep their folder, only the package is renamed.
+// All the changed files are parsed and rewritten in memory first, nothing is changed if any of
+// them can not be rewritten and the changes are reverted if they can not all be written.
+func Rename(oldName, newName string) error {
+	cfg, err := config.Read()
+	if err != nil {
+		return err
+	}
+	svcCfg, ok := cfg.Services[oldName]
+	if !ok {
+		return fmt.Errorf("service `%s` does not exits in the configuration file", oldName)
+	}
+
+	// we should remove the '_' because of this guide https://blog.golang.org/package-names
+	serviceName := strings.ReplaceAll(strutil.ToSnakeCase(newName), "_", "")
+	if _, ok := cfg.Services[serviceName]; ok {
+		return fmt.Errorf("service `%s` already exists in the configuration file", serviceName)
+	}
+	oldFolder := svcCfg.Folder(oldName)
+	newFolder := svcCfg.Folder(serviceName)
+	if oldFolder != newFolder {
+		if b, _ := fs.Exists(newFolder); b {
+			return fmt.Errorf("folder `%s` already exists", newFolder)
+		}
+	}
+	ss, err := readServiceSource(oldFolder, svcCfg.Interface)
+	if err != nil {
+		return err
+	}
+	oldPackage := ss.Source.Package()
+	_, oldImport, err := svcCfg.ResolveModule(oldName, cfg.Module)
+	if err != nil {
+		return err
+	}
+	_, newImport, err := svcCfg.ResolveModule(serviceName, cfg.Module)
+	if err != nil {
+		return err
+	}
+
+	changed := map[string]string{}
+	if err := renamePackage(oldFolder, oldPackage, serviceName, changed); err != nil {
+		return err
+	}
+	// the generated code is created again after the rename
+	genFolder := path.Join(oldFolder, "gen")
+	if err := rewriteImports(oldImport, newImport, oldPackage, serviceName, genFolder, changed); err != nil {
+		return err
+	}
+
+	if err := moveService(oldFolder, newFolder, changed); err != nil {
+		return err
+	}
+	if err := fs.DeleteFolder(path.Join(newFolder, "gen")); err != nil {
+		return err
+	}
+	if err := config.RenameService(oldName, serviceName); err != nil {
+		return err
+	}
+	delete(cfg.Services, oldName)
+	cfg.Services[serviceName] = svcCfg
+	return GenerateServices(cfg, []string{serviceName})
+}
+
+// moveService moves the service folder and writes the changed files, the files in the service
+// folder are written to the new folder. If anything fails the folder and the files are restored.
+func moveService(oldFolder, newFolder string, changed map[string]string) (err error) {
+	target := func(file string) string {
+		if rel := strings.TrimPrefix(filepath.ToSlash(file), oldFolder+"/"); rel != filepath.ToSlash(file) {
+			return path.Join(newFolder, rel)
+		}
+		return file
+	}
+	var files []string
+	originals := map[string]string{}
+	for file := range changed {
+		files = append(files, file)
+		if originals[file], err = fs.ReadFile(file); err != nil {
+			return err
+		}
+	}
+	sort.Strings(files)
+
+	moved := false
+	var written []string
+	defer func() {
+		if err == nil {
+			return
+		}
+		for _, file := range written {
+			if restoreErr := fs.WriteFile(target(file), originals[file]); restoreErr != nil {
+				log.Errorf("could not restore `%s`: %s", file, restoreErr)
+			}
+		}
+		if moved {
+			if restoreErr := fs.Rename(newFolder, oldFolder); restoreErr != nil {
+				log.Errorf("could not move `%s` back to `%s`: %s", newFolder, oldFolder, restoreErr)
+			}
+		}
+	}()
+
+	if oldFolder != newFolder {
+		if err := fs.Rename(oldFolder, newFolder); err != nil {
+			return err
+		}
+		moved = true
+	}
+	for _, file := range files {
+		if err := fs.WriteFile(target(file), changed[file]); err != nil {
+			return err
+		}
+		written = append(written, file)
+	}
+	return nil
+}
+
+// renamePackage changes the package clause of all the go files in the folder,
+// the new sources are added to changed.
+func renamePackage(folder, oldPackage, newPackage string, changed map[string]string) error {
+	files, err := fs.ListFiles(folder)
+	if err != nil {
+		return err
+	}
+	for _, file := range files {
+		// only files of the service package, sub packages keep their names
+		if filepath.Dir(file) != filepath.Clean(folder) || filepath.Ext(file) != ".go" {
+			continue
+		}
+		err := rewriteGoFile(file, changed, func(fSet *token.FileSet, f *ast.File) bool {
+			if f.Name.Name != oldPackage && f.Name.Name != oldPackage+"_test" {
+				return false
+			}
+			f.Name.Name = strings.Replace(f.Name.Name, oldPackage, newPackage, 1)
+			return true
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// rewriteImports changes all the imports of the old import path (and its sub packages)
+// in the module to the new import path, if the service package is imported without an
+// alias the references to the package are renamed too. The files in the skip folder are
+// not changed and the new sources are added to changed.
+func rewriteImports(oldImport, newImport, oldPackage, newPackage, skip string, changed map[string]string) error {
+	files, err := moduleGoFiles()
+	if err != nil {
+		return err
+	}
+	for _, file := range files {
+		if skip != "" && strings.HasPrefix(filepath.ToSlash(file), skip+"/") {
+			continue
+		}
+		err := rewriteGoFile(file, changed, func(fSet *token.FileSet, f *ast.File) bool {
+			rewritten := false
+			renameReferences := false
+			for _, imp := range f.Imports {
+				pth, err := strconv.Unquote(imp.Path.Value)
+				if err != nil {
+					continue
+				}
+				if pth != oldImport && !strings.HasPrefix(pth, oldImport+"/") {
+					continue
+				}
+				imp.Path.Value = strconv.Quote(newImport + strings.TrimPrefix(pth, oldImport))
+				if pth == oldImport && imp.Name == nil {
+					renameReferences = true
+				}
+				rewritten = true
+			}
+			if renameReferences && oldPackage != newPackage {
+				ast.Inspect(f, func(node ast.Node) bool {
+					sel, ok := node.(*ast.SelectorExpr)
+					if !ok {
+						return true
+					}
+					// package references are not resolved by the parser
+					if ident, ok := sel.X.(*ast.Ident); ok && ident.Name == oldPackage && ident.Obj == nil {
+						ident.Name = newPackage
+					}
+					return true
+				})
+			}
+			return rewritten
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// findImporters returns all the go files in the module that import the service
+// or one of its sub packages from outside of the service folder.
+func findImporters(serviceImport, folder string) (importers []string, err error) {
+	files, err := moduleGoFiles()
+	if err != nil {
+		return nil, err
+	}
+	for _, file := range files {
+		if strings.HasPrefix(filepath.ToSlash(file), folder+"/") {
+			continue
+		}
+		data, err := fs.ReadFile(file)
+		if err != nil {
+			return nil, err
+		}
+		f, err := parser.ParseFile(token.NewFileSet(), file, data, parser.ImportsOnly)
+		if err != nil {
+			continue
+		}
+		for _, imp := range f.Imports {
+			pth, _ := strconv.Unquote(imp.Path.Value)
+			if pth == serviceImport || strings.HasPrefix(pth, serviceImport+"/") {
+				importers = append(importers, file)
+				break
+			}
+		}
+	}
+	return importers, nil
+}
+
+// moduleGoFiles returns all the go files of the module without the vendored and hidden folders.
+func moduleGoFiles() (files []string, err error) {
+	all, err := fs.ListFiles(".")
+	if err != nil {
+		return nil, err
+	}
+	for _, file := range all {
+		if filepath.Ext(file) != ".go" {
+			continue
+		}
+		skip := false
+		for _, part := range strings.Split(filepath.ToSlash(file), "/") {
+			if part == "vendor" || strings.HasPrefix(part, ".") {
+				skip = true
+				break
+			}
+		}
+		if !skip {
+			files = append(files, file)
+		}
+	}
+	return files, nil
+}
+
+// rewriteGoFile parses the file and adds the formatted source to changed if the rewrite function changed it,
+// the file is read from changed if it was already rewritten.
+func rewriteGoFile(file string, changed map[string]string, rewrite func(*token.FileSet, *ast.File) bool) error {
+	data, ok := changed[file]
+	if !ok {
+		var err error
+		if data, err = fs.ReadFile(file); err != nil {
+			return err
+		}
+	}
+	fSet := token.NewFileSet()
+	f, err := parser.ParseFile(fSet, file, data, parser.ParseComments)
+	if err != nil {
+		return err
+	}
+	if !rewrite(fSet, f) {
+		return nil
+	}
+	var buf bytes.Buffer
+	if err := format.Node(&buf, fSet, f); err != nil {
+		return err
+	}
+	changed[file] = buf.String()
+	return nil
+}
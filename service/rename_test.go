@@ -0,0 +1,114 @@
+package service
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// writeModule writes the files to a new folder, the file names can include sub folders.
+func writeModule(t *testing.T, files map[string]string) string {
+	dir, err := ioutil.TempDir("", "gs-module")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, data := range files {
+		pth := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(pth), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(pth, []byte(data), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+func TestRenamePackage(t *testing.T) {
+	dir := writeModule(t, map[string]string{
+		"users/service.go":      "package users\n\ntype Service interface{}\n",
+		"users/service_test.go": "package users_test\n",
+		"users/store/store.go":  "package store\n",
+		"users/README.md":       "# users\n",
+	})
+	defer os.RemoveAll(dir)
+
+	inFolder(t, dir, func() {
+		changed := map[string]string{}
+		assert.NoError(t, renamePackage("users", "users", "accounts", changed))
+		assert.Equal(t, map[string]string{
+			"users/service.go":      "package accounts\n\ntype Service interface{}\n",
+			"users/service_test.go": "package accounts_test\n",
+		}, changed, "sub packages should keep their name")
+
+		data, _ := ioutil.ReadFile("users/service.go")
+		assert.Equal(t, "package users\n\ntype Service interface{}\n", string(data), "files should only change in memory")
+	})
+}
+
+func TestRewriteImports(t *testing.T) {
+	dir := writeModule(t, map[string]string{
+		"main.go": "package main\n\nimport (\n\t\"shop/users\"\n\t\"shop/users/gen\"\n)\n\n" +
+			"func main() {\n\tgen.New(users.New()).Run()\n}\n",
+		"admin/admin.go":   "package admin\n\nimport u \"shop/users\"\n\nvar svc = u.New()\n",
+		"orders/orders.go": "package orders\n\nimport \"shop/usersettings\"\n\nvar s = usersettings.Default\n",
+		"users/gen/gen.go": "package gen\n\nimport service \"shop/users\"\n",
+	})
+	defer os.RemoveAll(dir)
+
+	inFolder(t, dir, func() {
+		changed := map[string]string{}
+		assert.NoError(t, rewriteImports("shop/users", "shop/accounts", "users", "accounts", "users/gen", changed))
+		assert.Len(t, changed, 2, "only the files importing the service outside of the skipped folder should change")
+		assert.Equal(t, "package main\n\nimport (\n\t\"shop/accounts\"\n\t\"shop/accounts/gen\"\n)\n\n"+
+			"func main() {\n\tgen.New(accounts.New()).Run()\n}\n", changed["main.go"])
+		assert.Equal(t, "package admin\n\nimport u \"shop/accounts\"\n\nvar svc = u.New()\n", changed[filepath.Join("admin", "admin.go")],
+			"references of aliased imports should not change")
+	})
+}
+
+func TestRenameDoesNotChangeAnythingOnError(t *testing.T) {
+	files := map[string]string{
+		"go.mod":  "module shop\n",
+		"gs.toml": "version = 1\n\n[services.users.http]\nport = 8000\n",
+		"users/service.go": "package users\n\nimport \"context\"\n\n// @service()\n" +
+			"type Users interface {\n\tList(ctx context.Context) error\n}\n",
+		"main.go": "package main\n\nimport \"shop/users\"\n\nfunc main() {\n\tusers.New(\n}\n",
+	}
+	dir := writeModule(t, files)
+	defer os.RemoveAll(dir)
+
+	inFolder(t, dir, func() {
+		assert.Error(t, Rename("users", "accounts"), "main.go can not be parsed")
+		for name, data := range files {
+			current, err := ioutil.ReadFile(name)
+			assert.NoError(t, err)
+			assert.Equal(t, data, string(current), name)
+		}
+		_, err := os.Stat("accounts")
+		assert.True(t, os.IsNotExist(err))
+	})
+}
+
+func TestRemoveKeepsTheConfiguration(t *testing.T) {
+	dir := writeModule(t, map[string]string{
+		"go.mod": "module shop\n",
+		"gs.toml": "# shop services\nversion = 1\n\n[services]\n\n  # users are shared with the admin\n  [services.users.http]\n    port = 8000\n\n" +
+			"  [services.orders.http]\n    port = 8001\n",
+		"users/service.go":  "package users\n",
+		"orders/service.go": "package orders\n",
+	})
+	defer os.RemoveAll(dir)
+
+	inFolder(t, dir, func() {
+		assert.NoError(t, Remove("users"))
+		data, err := ioutil.ReadFile("gs.toml")
+		assert.NoError(t, err)
+		assert.Equal(t, "# shop services\nversion = 1\n\n[services]\n\n  [services.orders.http]\n    port = 8001\n", string(data))
+		_, err = os.Stat("users")
+		assert.True(t, os.IsNotExist(err))
+	})
+}
@@ -0,0 +1,51 @@
+package service
+
+// Route describes one http route or grpc method exposed by a service endpoint.
+type Route struct {
+	Service        string   `json:"service"`
+	Endpoint       string   `json:"endpoint"`
+	Methods        []string `json:"methods,omitempty"`
+	Route          string   `json:"route,omitempty"`
+	RequestFormat  string   `json:"request_format,omitempty"`
+	ResponseFormat string   `json:"response_format,omitempty"`
+	GRPCMethod     string   `json:"grpc_method,omitempty"`
+	HttpAddress    string   `json:"http_address,omitempty"`
+	GRPCAddress    string   `json:"grpc_address,omitempty"`
+}
+
+// Routes returns all the http routes and grpc methods of the service,
+// http routes include the trailing slash routes that are added by default.
+func (s *Service) Routes() (routes []Route) {
+	grpcMethods := map[string]string{}
+	if s.GRPCTransport != nil {
+		for _, ep := range s.GRPCTransport.GRPCEndpoint {
+			grpcMethods[ep.Name] = s.GRPCMethod(ep.Name)
+		}
+	}
+	for _, ep := range s.Endpoints {
+		route := Route{
+			Service:    s.Name,
+			Endpoint:   ep.Name,
+			GRPCMethod: grpcMethods[ep.Name],
+		}
+		if route.GRPCMethod != "" {
+			route.GRPCAddress = s.Config.Grpc.String()
+		}
+		if ep.HttpTransport == nil {
+			routes = append(routes, route)
+			continue
+		}
+		route.HttpAddress = s.Config.Http.String()
+		route.ResponseFormat = ep.HttpTransport.ResponseFormat
+		if ep.HttpTransport.Request != nil {
+			route.RequestFormat = string(ep.HttpTransport.Request.Format)
+		}
+		for _, methodRoute := range ep.HttpTransport.MethodRoutes {
+			r := route
+			r.Methods = methodRoute.Methods
+			r.Route = methodRoute.Route
+			routes = append(routes, r)
+		}
+	}
+	return routes
+}
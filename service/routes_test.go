@@ -0,0 +1,116 @@
+package service
+
+import (
+	"gs/config"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+const testServiceSource = `package users
+
+import "context"
+
+// @service()
+type Users interface {
+	// @http(method="GET", route="/users/{id}")
+	// @grpc()
+	Get(ctx context.Context, req GetRequest) (*User, error)
+	// @http(method="POST", route="/users", request="json")
+	Create(ctx context.Context, req CreateRequest) (*User, error)
+	// @grpc()
+	Ping(ctx context.Context) error
+}
+
+type GetRequest struct {
+	ID     int      ` + "`url:\"id\"`" + `
+	Fields []string ` + "`query:\"fields\"`" + `
+}
+
+type CreateRequest struct {
+	Name string ` + "`json:\"name\" validate:\"required\"`" + `
+}
+
+type User struct {
+	ID   int    ` + "`json:\"id\"`" + `
+	Name string ` + "`json:\"name\"`" + `
+}
+`
+
+// parseTestService parses the users service of testServiceSource.
+func parseTestService(t *testing.T) *Service {
+	dir := writeModule(t, map[string]string{
+		"go.mod":           "module shop\n",
+		"users/service.go": testServiceSource,
+	})
+	defer os.RemoveAll(dir)
+
+	var svc *Service
+	inFolder(t, dir, func() {
+		var err error
+		svc, err = Parse("users", config.ServiceConfig{
+			Http:  config.AddressConfig{Port: 8000},
+			Grpc:  config.AddressConfig{Port: 2000},
+			Debug: config.AddressConfig{Port: 3000},
+		}, "shop")
+		if err != nil {
+			t.Fatal(err)
+		}
+	})
+	return svc
+}
+
+func TestRoutes(t *testing.T) {
+	routes := parseTestService(t).Routes()
+	var paths []string
+	for _, r := range routes {
+		paths = append(paths, r.Endpoint+" "+r.Route)
+	}
+	assert.Equal(t, []string{
+		"Get /users/{id}",
+		"Get /users/{id}/",
+		"Create /users",
+		"Create /users/",
+		"Ping ",
+	}, paths, "the trailing slash routes should be included")
+
+	assert.Equal(t, Route{
+		Service:        "users",
+		Endpoint:       "Create",
+		Methods:        []string{"POST"},
+		Route:          "/users",
+		RequestFormat:  "JSON",
+		ResponseFormat: "JSON",
+		HttpAddress:    ":8000",
+	}, routes[2])
+	assert.Equal(t, "/grpc.Users/Get", routes[0].GRPCMethod)
+	assert.Equal(t, Route{
+		Service:     "users",
+		Endpoint:    "Ping",
+		GRPCMethod:  "/grpc.Users/Ping",
+		GRPCAddress: ":2000",
+	}, routes[4], "endpoints without http should only have the grpc method")
+}
+
+func TestRoutesWithInvalidValidation(t *testing.T) {
+	source := strings.Replace(testServiceSource, `validate:"required"`, `validate:"unique"`, 1)
+	dir := writeModule(t, map[string]string{
+		"go.mod":           "module shop\n",
+		"users/service.go": source,
+	})
+	defer os.RemoveAll(dir)
+
+	cfg := config.ServiceConfig{Http: config.AddressConfig{Port: 8000}}
+	msg := "service `users`: endpoint `Create`: invalid validation rules:\n  field `Name`: unknown validation rule `unique`"
+	inFolder(t, dir, func() {
+		svc, err := Parse("users", cfg, "shop")
+		if !assert.NoError(t, err, "the rules should only fail the generation") {
+			return
+		}
+		assert.Len(t, svc.Routes(), 5)
+		assert.EqualError(t, svc.CheckValidations(), msg)
+		assert.EqualError(t, Generate("users", cfg, "shop", nil), msg)
+	})
+}
@@ -1,7 +1,6 @@
 package service
 
 import (
-	"errors"
 	"fmt"
 	"gs/config"
 	"gs/fs"
@@ -26,32 +25,87 @@ type Middleware struct {
 
 type Service struct {
 	Name string
+	// Path is the folder of the service relative to the project root.
+	Path string
 
 	Interface string
 	Config    config.ServiceConfig
+	Module    string
 	Import    string
 	Package   string
 
 	Endpoints     []Endpoint
 	GRPCTransport *GRPCTransport
 	Annotations   []annotation.Annotation
+
+	// Plugins are the project plugins that generate files for the service.
+	Plugins map[string]config.PluginConfig
+}
+
+// Generate generates the code of the service and runs the plugins of the project.
+func Generate(name string, config config.ServiceConfig, module string, plugins map[string]config.PluginConfig) error {
+	if !config.IsEnabled() {
+		log.Infof("Skipping disabled service `%s`", name)
+		return nil
+	}
+	service, err := Parse(name, config, module)
+	if err != nil {
+		return err
+	}
+	service.Plugins = plugins
+	if err := service.CheckValidations(); err != nil {
+		return err
+	}
+	if conflicts := findRouteConflicts(service.Endpoints); len(conflicts) > 0 {
+		return fmt.Errorf("service `%s`: %s", name, conflicts[0])
+	}
+	return service.generateFiles()
+}
+
+// CheckValidations returns the validation rules of the first endpoint that can not be generated,
+// `gs lint` reports the invalid rules of each field, generating would drop them.
+func (s *Service) CheckValidations() error {
+	for _, ep := range s.Endpoints {
+		if ep.validationErr != nil {
+			return fmt.Errorf("service `%s`: endpoint `%s`: %s", s.Name, ep.Name, ep.validationErr)
+		}
+	}
+	return nil
 }
 
-// this is used from findStruct() if the file was already read we don't
-// want to spend all the time to read and parse it again
-var fileSourceCache map[string]*source.Source
+// GenerateServices checks that the addresses of the project do not conflict and generates
+// the services with the given names.
+func GenerateServices(cfg *config.GSConfig, names []string) error {
+	if err := cfg.CheckAddresses(); err != nil {
+		return err
+	}
+	for _, name := range names {
+		if err := Generate(name, cfg.Services[name], cfg.Module, cfg.Plugins); err != nil {
+			return err
+		}
+	}
+	return nil
+}
 
-func Generate(name string, config config.ServiceConfig, module string) error {
-	fileSourceCache = map[string]*source.Source{}
+// Parse reads the service source and parses the service model without generating any files.
+func Parse(name string, cfg config.ServiceConfig, module string) (*Service, error) {
+	resetPackageCache()
 
-	src, err := readServiceSource(name)
+	if err := cfg.CheckTransports(); err != nil {
+		return nil, fmt.Errorf("service `%s`: %s", name, err)
+	}
+	serviceModule, serviceImport, err := cfg.ResolveModule(name, module)
 	if err != nil {
-		return err
+		return nil, err
+	}
+	ss, err := readServiceSource(cfg.Folder(name), cfg.Interface)
+	if err != nil {
+		return nil, err
 	}
 
-	inf := findServiceInterface(src)
+	inf := ss.Interface
 	if inf == nil {
-		return fmt.Errorf(
+		return nil, fmt.Errorf(
 			"error while parsing service : %s",
 			"Could not find service interface, make sure you are using @service()",
 		)
@@ -59,36 +113,46 @@ func Generate(name string, config config.ServiceConfig, module string) error {
 
 	service := Service{
 		Interface:   inf.Name(),
-		Config:      config,
+		Config:      cfg,
 		Name:        name,
-		Import:      fmt.Sprintf("%s/%s", module, name),
-		Package:     src.Package(),
+		Path:        cfg.Folder(name),
+		Module:      serviceModule,
+		Import:      serviceImport,
+		Package:     ss.Source.Package(),
 		Annotations: inf.Annotations(),
 	}
 
 	for _, method := range filterMethods(inf.Methods()) {
-		ep, err := parseEndpoint(method, service.Import, service.Name)
+		ep, err := parseEndpoint(method, service)
 		if err != nil {
-			return err
+			return nil, err
+		}
+		var warnings []string
+		ep.Validations, warnings, ep.validationErr = parseValidations(ep.Request)
+		for _, warning := range warnings {
+			log.Warnf("service `%s`: endpoint `%s`: %s", name, ep.Name, warning)
 		}
 		service.Endpoints = append(service.Endpoints, *ep)
 	}
 	service.GRPCTransport = parseGRPCTransport(service)
-	return service.generateFiles()
+	return &service, nil
 }
 
-func (s *Service) generateEndpoints() error {
+func (s *Service) renderEndpoints(files map[string]string) error {
 	for _, endpoint := range s.Endpoints {
 		endpointFile := strutil.ToSnakeCase(endpoint.Name) + ".go"
-		files := map[string]string{
+		templates := map[string]string{
 			"service/gen/endpoint/definitions/method.jet": s.GetPath("gen", "endpoint", "definitions", endpointFile),
 			"service/gen/endpoint/method.jet":             s.GetPath("gen", "endpoint", endpointFile),
 		}
 		if endpoint.HttpTransport != nil {
-			files["service/gen/transport/http/method.jet"] = s.GetPath("gen", "transport", "http", endpointFile)
+			templates["service/gen/transport/http/method.jet"] = s.GetPath("gen", "transport", "http", endpointFile)
+		}
+		if len(endpoint.Validations) > 0 {
+			templates["service/gen/validation/method.jet"] = s.GetPath("gen", "validation", endpointFile)
 		}
 
-		for k, v := range files {
+		for k, v := range templates {
 			src, err := template.CompileGoFromPath(k, struct {
 				Endpoint Endpoint
 				Service  Service
@@ -99,57 +163,99 @@ func (s *Service) generateEndpoints() error {
 			if err != nil {
 				return err
 			}
-			err = fs.WriteFile(v, src)
-			if err != nil {
-				return err
-			}
+			files[v] = src
 		}
 	}
 	return nil
 }
-func (s *Service) generateFiles() error {
-	err := fs.DeleteFolder(s.GetPath("gen"))
-	if err != nil {
-		return err
-	}
-	files := map[string]string{
-		"service/gen/service.jet":                s.GetPath("gen", "gen.go"),
-		"service/gen/options.jet":                s.GetPath("gen", "options.go"),
-		"service/gen/service/service.jet":        s.GetPath("gen", "service", "service.go"),
-		"service/gen/errors/errors.jet":          s.GetPath("gen", "errors", "errors.go"),
-		"service/gen/errors/http.jet":            s.GetPath("gen", "errors", "http.go"),
-		"service/gen/utils/utils.jet":            s.GetPath("gen", "utils", "utils.go"),
-		"service/gen/endpoint/endpoint.jet":      s.GetPath("gen", "endpoint", "endpoint$.go"),
-		"service/gen/endpoint/options.jet":       s.GetPath("gen", "endpoint", "options$.go"),
-		"service/gen/transport/transport.jet":    s.GetPath("gen", "transport", "transport.go"),
-		"service/gen/transport/http/http.jet":    s.GetPath("gen", "transport", "http", "http$.go"),
-		"service/gen/transport/http/options.jet": s.GetPath("gen", "transport", "http", "options$.go"),
-	}
-
-	for k, v := range files {
+
+// renderFiles compiles all the templates of the generated code and returns
+// the sources keyed by the path of the file they need to be written to.
+func (s *Service) renderFiles() (map[string]string, error) {
+	files := map[string]string{}
+	templates := map[string]string{
+		"service/gen/service.jet":             s.GetPath("gen", "gen.go"),
+		"service/gen/options.jet":             s.GetPath("gen", "options.go"),
+		"service/gen/address.jet":             s.GetPath("gen", "address.go"),
+		"service/gen/service/service.jet":     s.GetPath("gen", "service", "service.go"),
+		"service/gen/errors/errors.jet":       s.GetPath("gen", "errors", "errors.go"),
+		"service/gen/errors/http.jet":         s.GetPath("gen", "errors", "http.go"),
+		"service/gen/utils/utils.jet":         s.GetPath("gen", "utils", "utils.go"),
+		"service/gen/version/version.jet":     s.GetPath("gen", "version", "version.go"),
+		"service/gen/endpoint/endpoint.jet":   s.GetPath("gen", "endpoint", "endpoint$.go"),
+		"service/gen/endpoint/options.jet":    s.GetPath("gen", "endpoint", "options$.go"),
+		"service/gen/transport/transport.jet": s.GetPath("gen", "transport", "transport.go"),
+	}
+	if s.HasHttp() {
+		templates["service/gen/transport/http/http.jet"] = s.GetPath("gen", "transport", "http", "http$.go")
+		templates["service/gen/transport/http/options.jet"] = s.GetPath("gen", "transport", "http", "options$.go")
+	}
+
+	for k, v := range templates {
 		src, err := template.CompileGoFromPath(k, s)
 		if err != nil {
-			return err
+			return nil, err
 		}
-		err = fs.WriteFile(v, src)
+		files[v] = src
+	}
+	if err := s.renderEndpoints(files); err != nil {
+		return nil, err
+	}
+
+	if s.GRPCTransport != nil {
+		err := s.renderGrpcTransport(files)
 		if err != nil {
-			return err
+			return nil, err
 		}
 	}
-	if err := s.generateEndpoints(); err != nil {
+	if err := s.renderPlugins(files); err != nil {
+		return nil, err
+	}
+	return files, nil
+}
+
+func (s *Service) generateFiles() error {
+	inputs, err := s.inputHashes()
+	if err != nil {
 		return err
 	}
+	previous := s.readManifest()
+	if previous != nil && previous.upToDate(inputs) {
+		log.Debugf("Service `%s` is up to date", s.Name)
+		return s.generateCmd()
+	}
 
+	files, err := s.renderFiles()
+	if err != nil {
+		return err
+	}
+	if _, err := s.writeFiles(files, previous); err != nil {
+		return err
+	}
 	if s.GRPCTransport != nil {
-		err := s.generateGrpcTransport()
-		if err != nil {
-			return err
+		// the proto is compared with the manifest of the last successful generation and not with
+		// the file on disk, if protoc failed the new proto is already written but was never compiled
+		protoFile := s.GetPath("gen", "transport", "grpc", s.Name+".proto")
+		protoChanged := previous == nil || previous.Files[protoFile] != hash(files[protoFile])
+		pbFile := s.GetPath("gen", "transport", "grpc", s.Name+".pb.go")
+		if b, _ := fs.Exists(pbFile); protoChanged || !b {
+			if err := s.compileProto(); err != nil {
+				return err
+			}
 		}
 	}
 	if err := s.generateCmd(); err != nil {
 		return err
 	}
-	return nil
+
+	m := manifest{
+		Inputs: inputs,
+		Files:  map[string]string{},
+	}
+	for pth, src := range files {
+		m.Files[pth] = hash(src)
+	}
+	return s.writeManifest(m)
 }
 
 func (s Service) generateCmd() error {
@@ -167,42 +273,26 @@ func (s Service) generateCmd() error {
 	}
 	return fs.WriteFile(s.GetPath("cmd", "main.go"), src)
 }
-func (s *Service) generateGrpcTransport() error {
-	src, err := template.CompileGoFromPath("service/gen/transport/grpc/grpc.jet", s)
-	if err != nil {
-		return err
-	}
-	err = fs.WriteFile(s.GetPath("gen", "transport", "grpc", "grpc$.go"), src)
-	if err != nil {
-		return err
+func (s *Service) renderGrpcTransport(files map[string]string) error {
+	templates := map[string]string{
+		"service/gen/transport/grpc/grpc.jet":          s.GetPath("gen", "transport", "grpc", "grpc$.go"),
+		"service/gen/transport/grpc/encode_decode.jet": s.GetPath("gen", "transport", "grpc", "encode_decode$.go"),
+		"service/gen/transport/grpc/options.jet":       s.GetPath("gen", "transport", "grpc", "options$.go"),
 	}
-
-	src, err = template.CompileGoFromPath("service/gen/transport/grpc/encode_decode.jet", s)
-	if err != nil {
-		return err
-	}
-	err = fs.WriteFile(s.GetPath("gen", "transport", "grpc", "encode_decode$.go"), src)
-	if err != nil {
-		return err
+	for k, v := range templates {
+		src, err := template.CompileGoFromPath(k, s)
+		if err != nil {
+			return err
+		}
+		files[v] = src
 	}
 
-	src, err = template.CompileGoFromPath("service/gen/transport/grpc/options.jet", s)
-	if err != nil {
-		return err
-	}
-	err = fs.WriteFile(s.GetPath("gen", "transport", "grpc", "options$.go"), src)
+	src, err := template.CompileFromPath("service/gen/transport/grpc/proto.jet", s)
 	if err != nil {
 		return err
 	}
+	files[s.GetPath("gen", "transport", "grpc", s.Name+".proto")] = src
 
-	src, err = template.CompileFromPath("service/gen/transport/grpc/proto.jet", s)
-	if err != nil {
-		return err
-	}
-	err = fs.WriteFile(s.GetPath("gen", "transport", "grpc", s.Name+".proto"), src)
-	if err != nil {
-		return err
-	}
 	for _, v := range s.GRPCTransport.GRPCEndpoint {
 		data := map[string]interface{}{
 			"Service":      s,
@@ -212,47 +302,60 @@ func (s *Service) generateGrpcTransport() error {
 		if err != nil {
 			return err
 		}
-		err = fs.WriteFile(s.GetPath("gen", "transport", "grpc", template.ToLowerFirst(v.Name)+".go"), src)
-		if err != nil {
-			return err
-		}
+		files[s.GetPath("gen", "transport", "grpc", template.ToLowerFirst(v.Name)+".go")] = src
 	}
+	return nil
+}
+
+// compileProto runs protoc to generate the go code of the service proto file.
+func (s *Service) compileProto() error {
 	currentPath, err := os.Getwd()
 	if err != nil {
 		return err
 	}
 
 	cmd := exec.Command("protoc", s.Name+".proto", "--go_out=plugins=grpc:.")
-	cmd.Dir = path.Join(currentPath, s.Name, "gen", "transport", "grpc")
-	if err := cmd.Start(); err != nil {
-		return err
+	cmd.Dir = path.Join(currentPath, s.GetPath("gen", "transport", "grpc"))
+	// wait for protoc so the generated code can be built right after generating
+	if out, err := cmd.CombinedOutput(); err != nil {
+		return fmt.Errorf("protoc: %s %s", err, strings.TrimSpace(string(out)))
 	}
 	return nil
 }
-func (s *Service) GetPath(pth ...string) string {
-	return path.Join(append([]string{s.Name}, pth...)...)
+
+// HasHttp checks if the http transport of the service is generated.
+func (s Service) HasHttp() bool {
+	return s.Config.HasTransport(config.HTTP)
 }
 
-func readServiceSource(name string) (*source.Source, error) {
-	data, err := fs.ReadFile(fmt.Sprintf("%s/service.go", name))
-	if err != nil {
-		return nil, errors.New("A read error occurred. Please update your code..: " + err.Error())
-	}
-	src, err := source.New(data)
-	if err != nil {
-		return nil, errors.New("A read error occurred. Please update your code..: " + err.Error())
+// EnvPrefix returns the prefix of the environment variables of the generated service,
+// the characters that can not be used in environment variable names are replaced with `_`.
+func (s Service) EnvPrefix() string {
+	prefix := strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z':
+			return r - 'a' + 'A'
+		case r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '_':
+			return r
+		}
+		return '_'
+	}, s.Name)
+	if prefix == "" || prefix[0] >= '0' && prefix[0] <= '9' {
+		prefix = "_" + prefix
 	}
-	return src, nil
+	return prefix
 }
 
-func findServiceInterface(src *source.Source) *source.Interface {
-	for _, inf := range src.Interfaces() {
-		annotations := source.FindAnnotations("service", &inf)
-		if len(annotations) > 0 {
-			return &inf
-		}
+// ProtoPackage returns the package of the service proto file.
+func (s Service) ProtoPackage() string {
+	if s.Config.ProtoPackage != "" {
+		return s.Config.ProtoPackage
 	}
-	return nil
+	return "grpc"
+}
+
+func (s *Service) GetPath(pth ...string) string {
+	return path.Join(append([]string{s.Path}, pth...)...)
 }
 
 func filterMethods(methods []source.InterfaceMethod) (list []source.InterfaceMethod) {
@@ -264,7 +367,9 @@ func filterMethods(methods []source.InterfaceMethod) (list []source.InterfaceMet
 	return list
 }
 
-func GenerateNew(name string) error {
+// GenerateNew creates a new service, the service is created in the folder with the
+// service name unless folder is set.
+func GenerateNew(name, folder string) error {
 	cfg, err := config.Read()
 	if err != nil {
 		return err
@@ -272,8 +377,9 @@ func GenerateNew(name string) error {
 
 	// we should remove the '_' because of this guide https://blog.golang.org/package-names
 	serviceName := strings.ReplaceAll(strutil.ToSnakeCase(name), "_", "")
+	svcCfg := config.ServiceConfig{Path: folder}
 
-	if err := fs.CreateFolder(serviceName); err != nil {
+	if err := fs.CreateFolder(svcCfg.Folder(serviceName)); err != nil {
 		return err
 	}
 
@@ -285,39 +391,23 @@ func GenerateNew(name string) error {
 	if err != nil {
 		return err
 	}
-	err = fs.WriteFile(path.Join(serviceName, "service.go"), src)
+	err = fs.WriteFile(path.Join(svcCfg.Folder(serviceName), "service.go"), src)
 	if err != nil {
 		return err
 	}
 
-	httpPort := 8000
-	for _, v := range cfg.Services {
-		if v.Http.Port == httpPort {
-			httpPort += 1
-		}
+	httpPort := cfg.FreePort(8000)
+	grpcPort := cfg.FreePort(2000, httpPort)
+	debugPort := cfg.FreePort(3000, httpPort, grpcPort)
+	svcCfg.Http = config.AddressConfig{
+		Port: httpPort,
 	}
-	grpcPort := 2000
-	for _, v := range cfg.Services {
-		if v.Grpc.Port == grpcPort {
-			grpcPort += 1
-		}
-	}
-	debugPort := 3000
-	for _, v := range cfg.Services {
-		if v.Debug.Port == debugPort {
-			debugPort += 1
-		}
+	svcCfg.Grpc = config.AddressConfig{
+		Port: grpcPort,
 	}
-	cfg.Services[serviceName] = config.ServiceConfig{
-		Http: config.AddressConfig{
-			Port: httpPort,
-		},
-		Grpc: config.AddressConfig{
-			Port: grpcPort,
-		},
-		Debug: config.AddressConfig{
-			Port: debugPort,
-		},
+	svcCfg.Debug = config.AddressConfig{
+		Port: debugPort,
 	}
+	cfg.Services[serviceName] = svcCfg
 	return config.Write(*cfg)
 }
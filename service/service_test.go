@@ -0,0 +1,19 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnvPrefix(t *testing.T) {
+	for name, prefix := range map[string]string{
+		"users":      "USERS",
+		"user-api":   "USER_API",
+		"billing.v2": "BILLING_V2",
+		"2fa":        "_2FA",
+		"café":       "CAF_",
+	} {
+		assert.Equal(t, prefix, Service{Name: name}.EnvPrefix(), name)
+	}
+}
@@ -1,6 +1,10 @@
 package service
 
 import (
+	"fmt"
+	"gs/config"
+	"gs/template"
+	"regexp"
 	"strings"
 
 	"github.com/go-services/code"
@@ -18,9 +22,11 @@ var log = logrus.WithFields(logrus.Fields{
 })
 
 const (
-	URL   paramType = "URL"
-	QUERY paramType = "QUERY"
-	BODY  paramType = "BODY"
+	URL    paramType = "URL"
+	QUERY  paramType = "QUERY"
+	HEADER paramType = "HEADER"
+	COOKIE paramType = "COOKIE"
+	BODY   paramType = "BODY"
 )
 
 const (
@@ -69,7 +75,7 @@ var typeFuncMap = map[string]*ParamParser{
 }
 
 type HttpRequestParam struct {
-	// this is the field Name
+	// this is the field selector, for promoted fields it includes the embedded fields (e.x `Pagination.Limit`)
 	Field string
 	// this is the Name given in the url param or query param
 	Name string
@@ -77,10 +83,12 @@ type HttpRequestParam struct {
 	Type code.Type
 	// is this parameter optional
 	Required bool
-	// this tells us of it is a URL param or a Query param
+	// this tells us where the param is read from (url, query, header, cookie or body)
 	ParamType paramType
 	// parameter parse function
 	Parser *ParamParser
+	// Convert is the named type the parsed value needs to be converted to (e.x `service.ID` for `type ID string`)
+	Convert string
 }
 
 type HttpRequest struct {
@@ -92,6 +100,34 @@ type HttpRequest struct {
 	HasBody bool
 	// all the extra params
 	Params []HttpRequestParam
+	// the imports of the named types the params are converted to
+	Imports []*code.Import
+	// the embedded pointers that need to be initialized before setting the params
+	Embeds []Embed
+}
+
+// Source is the expression the decoder uses to read the value of the param from the request.
+func (p HttpRequestParam) Source() string {
+	switch p.ParamType {
+	case URL:
+		return fmt.Sprintf("vars[%q]", p.Name)
+	case HEADER:
+		return fmt.Sprintf("r.Header.Get(%q)", p.Name)
+	case COOKIE:
+		return fmt.Sprintf("utils.Cookie(r, %q)", p.Name)
+	default:
+		return fmt.Sprintf("r.URL.Query().Get(%q)", p.Name)
+	}
+}
+
+// Location is where the param is read from, it is used in the errors of the decoder.
+func (p HttpRequestParam) Location() string {
+	return strings.ToLower(string(p.ParamType))
+}
+
+// ValueName is the name of the variable the raw value of the param is read to.
+func (p HttpRequestParam) ValueName() string {
+	return template.ToLowerFirst(strings.Replace(p.Field, ".", "", -1)) + "Value"
 }
 
 type HttpMethodRoute struct {
@@ -108,14 +144,18 @@ type HttpTransport struct {
 
 func parseHttpTransport(endpoint Endpoint) (*HttpTransport, error) {
 	httpAnnotations := findAnnotations("http", endpoint.Annotations)
-	if len(httpAnnotations) == 0 {
+	if len(httpAnnotations) == 0 || !endpoint.Config.HasTransport(config.HTTP) {
 		return nil, nil
 	}
 
+	responseFormat := httpAnnotations[0].Get("response").String()
+	if responseFormat == "" {
+		responseFormat = endpoint.Config.ResponseFormat
+	}
 	return &HttpTransport{
-		MethodRoutes:   parseMethodRoutes(httpAnnotations[0]),
+		MethodRoutes:   parseMethodRoutes(httpAnnotations[0], endpoint.Config.KeepTrailingSlash),
 		Request:        parseHttpRequest(endpoint),
-		ResponseFormat: string(httpResponseFormat(httpAnnotations[0].Get("response").String())),
+		ResponseFormat: string(httpResponseFormat(responseFormat)),
 	}, nil
 }
 
@@ -138,6 +178,9 @@ func parseHttpRequest(endpoint Endpoint) *HttpRequest {
 	httpAnnotations := findAnnotations("http", endpoint.Annotations)
 	httpEncode := httpAnnotations[0]
 	annotationFormat := httpEncode.Get("request").String()
+	if annotationFormat == "" {
+		annotationFormat = endpoint.Config.RequestFormat
+	}
 	format := JSON
 	if annotationFormat != "" {
 		switch requestFormat(strings.ToUpper(annotationFormat)) {
@@ -159,16 +202,19 @@ func parseHttpRequest(endpoint Endpoint) *HttpRequest {
 }
 
 func parseHttpRequestParams(req *code.Struct, request *HttpRequest) {
-	for _, field := range req.Fields {
+	for _, field := range promotedFields(req) {
 		if !isExported(field.Name) || field.Tags == nil {
 			continue
 		}
 
 		gsUrl := getTag("url", *field.Tags)
-		gsQuery := getTag("query", *field.Tags)
 		gsBody := getTag("body", *field.Tags)
 
-		tp := field.Type.String()
+		// named basic types are parsed as their underlying type and converted
+		tp, convert := field.Type.String(), ""
+		if underlying, ok := underlyingType(field.Type); ok {
+			tp, convert = underlying.String(), strings.TrimPrefix(field.Type.String(), "*")
+		}
 
 		if gsUrl != "" {
 			if !isUrlTypeSupported(tp) {
@@ -181,18 +227,35 @@ func parseHttpRequestParams(req *code.Struct, request *HttpRequest) {
 			}
 			name, required := getParameter(gsUrl)
 			request.Params = append(request.Params, HttpRequestParam{
-				Field:     field.Name,
+				Field:     field.Path,
 				Name:      name,
 				Type:      field.Type,
 				Required:  required,
 				ParamType: URL,
 				Parser:    parser,
+				Convert:   convert,
 			})
 			request.HasUrl = true
+			request.addEmbeds(field.Embeds)
+			if convert != "" {
+				request.addImport(field.Type.Import)
+			}
 		}
-		if gsQuery != "" {
+		// query params, headers and cookies are all strings and use the same parsers
+		for _, param := range []struct {
+			tag       string
+			paramType paramType
+		}{
+			{"query", QUERY},
+			{"header", HEADER},
+			{"cookie", COOKIE},
+		} {
+			tag := getTag(param.tag, *field.Tags)
+			if tag == "" {
+				continue
+			}
 			if !isQueryTypeSupported(tp) {
-				log.WithField("field", field.Name).WithField("type", field.Type.String()).Warn("Field type not supported for query")
+				log.WithField("field", field.Name).WithField("type", field.Type.String()).Warn("Field type not supported for " + param.tag)
 				continue
 			}
 			var parser *ParamParser = nil
@@ -200,15 +263,20 @@ func parseHttpRequestParams(req *code.Struct, request *HttpRequest) {
 				parser = typeFuncMap[tp]
 			}
 
-			name, required := getParameter(gsQuery)
+			name, required := getParameter(tag)
 			request.Params = append(request.Params, HttpRequestParam{
-				Field:     field.Name,
+				Field:     field.Path,
 				Name:      name,
 				Type:      field.Type,
 				Required:  required,
-				ParamType: QUERY,
+				ParamType: param.paramType,
 				Parser:    parser,
+				Convert:   convert,
 			})
+			request.addEmbeds(field.Embeds)
+			if convert != "" {
+				request.addImport(field.Type.Import)
+			}
 		}
 		if gsBody != "" {
 			name, required := getParameter(gsBody)
@@ -224,19 +292,56 @@ func parseHttpRequestParams(req *code.Struct, request *HttpRequest) {
 				log.WithField("endpoint", field.Name).Info("The request format is not supported `json` will be used as default")
 			}
 			request.Params = append(request.Params, HttpRequestParam{
-				Field:     field.Name,
+				Field:     field.Path,
 				Name:      string(format),
 				Required:  required,
 				ParamType: BODY,
 			})
 			request.HasBody = true
+			request.addEmbeds(field.Embeds)
 		}
 	}
 	return
 }
 
-func parseMethodRoutes(httpAnnotation annotation.Annotation) (routes []HttpMethodRoute) {
-	keepTrailingSlash := httpAnnotation.Get("keepTrailingSlash").Bool()
+// addImport adds the import of a type used by the decoder.
+func (r *HttpRequest) addImport(imp *code.Import) {
+	if imp == nil {
+		return
+	}
+	for _, v := range r.Imports {
+		if v.Path == imp.Path {
+			return
+		}
+	}
+	r.Imports = append(r.Imports, imp)
+}
+
+// addEmbeds adds the embedded pointers the param is promoted through so they are initialized.
+func (r *HttpRequest) addEmbeds(embeds []Embed) {
+	for _, embed := range embeds {
+		if !embed.Pointer || r.hasEmbed(embed.Path) {
+			continue
+		}
+		r.Embeds = append(r.Embeds, embed)
+		r.addImport(embed.Type.Import)
+	}
+}
+
+func (r *HttpRequest) hasEmbed(path string) bool {
+	for _, embed := range r.Embeds {
+		if embed.Path == path {
+			return true
+		}
+	}
+	return false
+}
+
+func parseMethodRoutes(httpAnnotation annotation.Annotation, keepTrailingSlash bool) (routes []HttpMethodRoute) {
+	// the annotation overrides the default of the service
+	if v := httpAnnotation.Get("keepTrailingSlash"); v.Type() == annotation.BOOL {
+		keepTrailingSlash = v.Bool()
+	}
 	var methodsPrepared []string
 	// for now we won't support multiple methods for the same route, if I find that it is used it can be enabled.
 	//for _, method := range strings.Split(httpAnnotation.Get("method").String(), ",") {
@@ -306,3 +411,64 @@ func isUrlTypeSupported(tp string) bool {
 	}
 	return false
 }
+
+var routeVarRegex = regexp.MustCompile(`{([^}:]+)(:[^}]+)?}`)
+
+// routeKey returns a key for the method and route that is the same for
+// routes that only differ in the names of the url parameters.
+func routeKey(method, route string) string {
+	return method + " " + routeVarRegex.ReplaceAllString(route, "{}")
+}
+
+// RouteConflict is returned when two endpoints of a service use the same method and route.
+type RouteConflict struct {
+	Endpoint      string
+	OtherEndpoint string
+	Method        string
+	Route         string
+	OtherRoute    string
+}
+
+func (c RouteConflict) Error() string {
+	return fmt.Sprintf(
+		"route `%s %s` of endpoint `%s` conflicts with route `%s %s` of endpoint `%s`",
+		c.Method,
+		c.Route,
+		c.Endpoint,
+		c.Method,
+		c.OtherRoute,
+		c.OtherEndpoint,
+	)
+}
+
+func findRouteConflicts(endpoints []Endpoint) (conflicts []RouteConflict) {
+	type usedRoute struct {
+		endpoint string
+		route    string
+	}
+	used := map[string]usedRoute{}
+	for _, ep := range endpoints {
+		if ep.HttpTransport == nil {
+			continue
+		}
+		for _, route := range ep.HttpTransport.MethodRoutes {
+			for _, method := range route.Methods {
+				key := routeKey(method, route.Route)
+				if other, ok := used[key]; ok {
+					if other.endpoint != ep.Name {
+						conflicts = append(conflicts, RouteConflict{
+							Endpoint:      ep.Name,
+							OtherEndpoint: other.endpoint,
+							Method:        method,
+							Route:         route.Route,
+							OtherRoute:    other.route,
+						})
+					}
+					continue
+				}
+				used[key] = usedRoute{endpoint: ep.Name, route: route.Route}
+			}
+		}
+	}
+	return conflicts
+}
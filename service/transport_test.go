@@ -0,0 +1,53 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/go-services/code"
+	"github.com/stretchr/testify/assert"
+)
+
+func httpEndpoint(name, method, route string) Endpoint {
+	return Endpoint{
+		Name: name,
+		HttpTransport: &HttpTransport{
+			MethodRoutes: []HttpMethodRoute{
+				{Methods: []string{method}, Route: route},
+			},
+		},
+	}
+}
+
+func TestFindRouteConflicts(t *testing.T) {
+	conflicts := findRouteConflicts([]Endpoint{
+		httpEndpoint("Get", "GET", "/users/{id}"),
+		httpEndpoint("Update", "PUT", "/users/{id}"),
+		httpEndpoint("Find", "GET", "/users/{name}"),
+		httpEndpoint("List", "GET", "/users"),
+	})
+	assert.Len(t, conflicts, 1)
+	assert.Equal(t, "Find", conflicts[0].Endpoint)
+	assert.Equal(t, "Get", conflicts[0].OtherEndpoint)
+}
+
+func TestParseHttpRequestParamsHeaderAndCookie(t *testing.T) {
+	strc := code.NewStruct("Request")
+	strc.Fields = []code.StructField{
+		*code.NewStructFieldWithTag("Tenant", code.NewType("string"), &code.FieldTags{"header": "X-Tenant-ID,required"}),
+		*code.NewStructFieldWithTag("Retries", code.NewType("int"), &code.FieldTags{"header": "X-Retries"}),
+		*code.NewStructFieldWithTag("Session", code.NewType("string"), &code.FieldTags{"cookie": "session"}),
+	}
+	request := &HttpRequest{}
+	parseHttpRequestParams(strc, request)
+	assert.Len(t, request.Params, 3)
+
+	assert.Equal(t, HEADER, request.Params[0].ParamType)
+	assert.True(t, request.Params[0].Required)
+	assert.Equal(t, `r.Header.Get("X-Tenant-ID")`, request.Params[0].Source())
+	assert.Equal(t, "header", request.Params[0].Location())
+
+	assert.Equal(t, "StringToInt", request.Params[1].Parser.Fn)
+
+	assert.Equal(t, COOKIE, request.Params[2].ParamType)
+	assert.Equal(t, `utils.Cookie(r, "session")`, request.Params[2].Source())
+}
@@ -0,0 +1,388 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/importer"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"github.com/go-services/code"
+	"golang.org/x/tools/go/packages"
+)
+
+// typedPackage is a package loaded with go/packages and type checked with go/types.
+type typedPackage struct {
+	Dir   string
+	Files []string
+	Types *types.Package
+	// Errors are the type checking errors, they are only reported if a type we need is invalid
+	Errors []error
+}
+
+// this is used from findStruct() so every package is loaded and type checked only once while parsing
+// the service, the imports of the packages are type checked from source so nothing needs to be compiled.
+var packageCache map[string]*typedPackage
+
+var typesFileSet = token.NewFileSet()
+var typesImporter = importer.ForCompiler(typesFileSet, "source", nil)
+
+// resetPackageCache needs to be called before parsing a service so the changed files are loaded again.
+func resetPackageCache() {
+	packageCache = map[string]*typedPackage{}
+	typesFileSet = token.NewFileSet()
+	typesImporter = importer.ForCompiler(typesFileSet, "source", nil)
+}
+
+// loadPackage finds the package with go/packages, this way packages from other modules, the vendor folder
+// or the workspace are found like the go tool finds them, and type checks the package with go/types.
+func loadPackage(importPath string) (*typedPackage, error) {
+	if pkg, ok := packageCache[importPath]; ok {
+		return pkg, nil
+	}
+	if packageCache == nil {
+		resetPackageCache()
+	}
+	pkgs, err := packages.Load(&packages.Config{Mode: packages.LoadFiles}, importPath)
+	if err != nil {
+		return nil, err
+	}
+	if len(pkgs) != 1 {
+		return nil, fmt.Errorf("could not load package `%s`", importPath)
+	}
+	if len(pkgs[0].Errors) > 0 {
+		return nil, fmt.Errorf("could not load package `%s`: %s", importPath, pkgs[0].Errors[0])
+	}
+	var files []*ast.File
+	for _, file := range pkgs[0].GoFiles {
+		f, err := parser.ParseFile(typesFileSet, file, nil, parser.ParseComments)
+		if err != nil {
+			return nil, err
+		}
+		files = append(files, f)
+	}
+	if len(files) == 0 {
+		return nil, fmt.Errorf("package `%s` has no go files", importPath)
+	}
+	pkg := &typedPackage{
+		Dir:   filepath.Dir(pkgs[0].GoFiles[0]),
+		Files: pkgs[0].GoFiles,
+	}
+	cfg := types.Config{
+		Importer: typesImporter,
+		// the package can use the generated code of the service that is not generated yet,
+		// the errors are ignored as long as the types we need can be resolved.
+		Error: func(err error) {
+			log.Debugf("type checking `%s`: %s", importPath, err)
+			pkg.Errors = append(pkg.Errors, err)
+		},
+	}
+	pkg.Types, _ = cfg.Check(importPath, typesFileSet, files, nil)
+	packageCache[importPath] = pkg
+	return pkg, nil
+}
+
+// lookupType finds the named type in the package of the import.
+func lookupType(tp code.Type) (types.Type, *typedPackage, error) {
+	if tp.Import == nil || tp.Import.Path == "" {
+		return nil, nil, fmt.Errorf("type `%s` does not have an import", tp.Qualifier)
+	}
+	pkg, err := loadPackage(tp.Import.Path)
+	if err != nil {
+		return nil, nil, err
+	}
+	obj, ok := pkg.Types.Scope().Lookup(tp.Qualifier).(*types.TypeName)
+	if !ok {
+		return nil, nil, fmt.Errorf("type `%s` does not exist in `%s`", tp.Qualifier, tp.Import.Path)
+	}
+	return obj.Type(), pkg, nil
+}
+
+// findStruct resolves the structure of the type, the type can be a structure declared in any importable
+// package or an alias of a structure.
+func findStruct(tp code.Type) (*code.Struct, error) {
+	notFoundErr := errors.New(
+		"could not find structure, make sure that you are using a structure as request/response parameters",
+	)
+	named, pkg, err := lookupType(tp)
+	if err != nil {
+		log.Debug(err)
+		return nil, notFoundErr
+	}
+	structure, ok := named.Underlying().(*types.Struct)
+	if !ok {
+		return nil, notFoundErr
+	}
+	strc := code.NewStruct(tp.Qualifier)
+	for i := 0; i < structure.NumFields(); i++ {
+		v := structure.Field(i)
+		name := v.Name()
+		if isInvalidType(v.Type()) {
+			err := fmt.Errorf("the type of field `%s` of `%s` can not be resolved", name, tp.Qualifier)
+			if len(pkg.Errors) > 0 {
+				err = fmt.Errorf("%s: %s", err, pkg.Errors[0])
+			}
+			return nil, err
+		}
+		if v.Embedded() && isEmbeddedStruct(v.Type()) {
+			// embedded structures have no name like in the go code, use promotedFields() to get their fields
+			name = ""
+		}
+		field := code.NewStructField(name, convertType(v.Type(), *tp.Import))
+		if tag := structure.Tag(i); tag != "" {
+			field.Tags = parseTags(tag)
+		}
+		strc.Fields = append(strc.Fields, *field)
+	}
+	return strc, nil
+}
+
+// isInvalidType checks if the type or the element type could not be type checked, e.x because the import
+// of the type can not be found.
+func isInvalidType(t types.Type) bool {
+	switch t := t.(type) {
+	case *types.Basic:
+		return t.Kind() == types.Invalid
+	case *types.Pointer:
+		return isInvalidType(t.Elem())
+	case *types.Slice:
+		return isInvalidType(t.Elem())
+	case *types.Array:
+		return isInvalidType(t.Elem())
+	case *types.Map:
+		return isInvalidType(t.Key()) || isInvalidType(t.Elem())
+	case *types.Chan:
+		return isInvalidType(t.Elem())
+	}
+	return false
+}
+
+// isEmbeddedStruct checks if the embedded type is a structure or a pointer to a structure.
+func isEmbeddedStruct(t types.Type) bool {
+	if p, ok := t.(*types.Pointer); ok {
+		t = p.Elem()
+	}
+	_, ok := t.Underlying().(*types.Struct)
+	return ok
+}
+
+// Embed is an embedded structure, the fields of the structure are promoted to the outer structure.
+type Embed struct {
+	// Path is the selector of the embedded field from the outer structure (e.x `Auth` or `Auth.Base`)
+	Path string
+	// Type is the type of the embedded structure without the pointer
+	Type    code.Type
+	Pointer bool
+}
+
+// promotedField is a field declared in the structure or promoted from an embedded structure.
+type promotedField struct {
+	code.StructField
+	// Path is the selector of the field from the outer structure (e.x `Pagination.Limit`)
+	Path string
+	// Embeds are the embedded structures the field is promoted through, the outer one first.
+	Embeds []Embed
+}
+
+// promotedFields returns the fields of the structure with the fields of the embedded structures flattened,
+// the same rules as in go apply, a field is shadowed by a field with the same name at a shallower depth and
+// fields with the same name at the same depth are ambiguous and ignored.
+func promotedFields(strc *code.Struct) (fields []promotedField) {
+	type level struct {
+		strc   *code.Struct
+		embeds []Embed
+	}
+	current := []level{{strc: strc}}
+	shadowed := map[string]bool{}
+	for len(current) > 0 {
+		var next []level
+		var candidates []promotedField
+		names := map[string]int{}
+		for _, lvl := range current {
+			prefix := ""
+			if len(lvl.embeds) > 0 {
+				prefix = lvl.embeds[len(lvl.embeds)-1].Path + "."
+			}
+			for _, field := range lvl.strc.Fields {
+				if field.Name != "" {
+					names[field.Name]++
+					candidates = append(candidates, promotedField{
+						StructField: field,
+						Path:        prefix + field.Name,
+						Embeds:      lvl.embeds,
+					})
+					continue
+				}
+				names[field.Type.Qualifier]++
+				tp := field.Type
+				tp.Pointer = false
+				if tp.Import == nil || isEmbedCycle(lvl.embeds, tp) {
+					continue
+				}
+				embedded, err := findStruct(tp)
+				if err != nil {
+					log.WithField("field", field.Type.String()).Warn(err)
+					continue
+				}
+				embeds := append(append([]Embed{}, lvl.embeds...), Embed{
+					Path:    prefix + tp.Qualifier,
+					Type:    tp,
+					Pointer: field.Type.Pointer,
+				})
+				next = append(next, level{strc: embedded, embeds: embeds})
+			}
+		}
+		for _, field := range candidates {
+			if shadowed[field.Name] {
+				continue
+			}
+			if names[field.Name] > 1 {
+				log.WithField("field", field.Name).Debug("field is ambiguous in the embedded structures and is ignored")
+				continue
+			}
+			fields = append(fields, field)
+		}
+		for name := range names {
+			shadowed[name] = true
+		}
+		current = next
+	}
+	return fields
+}
+
+// isEmbedCycle checks if the structure is already embedded in the chain, pointers allow structures to embed themselves.
+func isEmbedCycle(embeds []Embed, tp code.Type) bool {
+	for _, embed := range embeds {
+		if embed.Type.Qualifier == tp.Qualifier && embed.Type.Import.Path == tp.Import.Path {
+			return true
+		}
+	}
+	return false
+}
+
+// structDir returns the folder of the package that declares the type.
+func structDir(tp code.Type) string {
+	if _, pkg, err := lookupType(tp); err == nil {
+		return pkg.Dir
+	}
+	if tp.Import != nil {
+		return tp.Import.FilePath
+	}
+	return ""
+}
+
+// convertType converts the go/types type to the code type used by the templates,
+// local is the import of the package the type is used in.
+func convertType(t types.Type, local code.Import) code.Type {
+	switch t := t.(type) {
+	case *types.Basic:
+		return code.Type{Qualifier: t.Name()}
+	case *types.Named:
+		obj := t.Obj()
+		if obj.Pkg() == nil {
+			// the predeclared `error` type does not have a package
+			return code.Type{Qualifier: obj.Name()}
+		}
+		imp := local
+		if obj.Pkg().Path() != local.Path {
+			imp = code.Import{Alias: obj.Pkg().Name(), Path: obj.Pkg().Path()}
+		}
+		return code.Type{Qualifier: obj.Name(), Import: &imp}
+	case *types.Pointer:
+		tp := convertType(t.Elem(), local)
+		if !tp.ArrayType && !tp.Pointer {
+			tp.Pointer = true
+			return tp
+		}
+	case *types.Slice:
+		tp := convertType(t.Elem(), local)
+		if !tp.ArrayType {
+			tp.PointerArrayType = tp.Pointer
+			tp.Pointer = false
+			tp.ArrayType = true
+			return tp
+		}
+	}
+	// the types that the templates do not need to understand are only printed
+	return code.Type{
+		Qualifier: types.TypeString(t, func(pkg *types.Package) string {
+			if pkg.Path() == local.Path {
+				return local.Alias
+			}
+			return pkg.Name()
+		}),
+	}
+}
+
+// underlyingType resolves named basic types and slices of basic types to their underlying type,
+// e.x for `type ID string` it returns `string`. The second result is false if the type is not
+// a named type that can be resolved.
+func underlyingType(tp code.Type) (code.Type, bool) {
+	if tp.Import == nil || tp.MapType != nil || tp.Function != nil {
+		return tp, false
+	}
+	named, _, err := lookupType(tp)
+	if err != nil {
+		return tp, false
+	}
+	switch u := named.Underlying().(type) {
+	case *types.Basic:
+		if tp.ArrayType {
+			// []ID can not be converted to []string
+			return tp, false
+		}
+		return code.Type{Qualifier: u.Name(), Pointer: tp.Pointer}, true
+	case *types.Slice:
+		elem, ok := u.Elem().(*types.Basic)
+		if !ok || tp.ArrayType {
+			return tp, false
+		}
+		return code.Type{Qualifier: elem.Name(), ArrayType: true, Pointer: tp.Pointer}, true
+	}
+	return tp, false
+}
+
+// parseTags parses the field tag in the format used by reflect.StructTag.
+func parseTags(tag string) *code.FieldTags {
+	tags := code.FieldTags{}
+	for tag != "" {
+		tag = strings.TrimLeft(tag, " ")
+		i := strings.Index(tag, ":\"")
+		if i <= 0 {
+			break
+		}
+		name := tag[:i]
+		tag = tag[i+1:]
+		// find the closing quote, skipping the escaped ones
+		j := 1
+		for j < len(tag) && tag[j] != '"' {
+			if tag[j] == '\\' {
+				j++
+			}
+			j++
+		}
+		if j >= len(tag) {
+			break
+		}
+		value, err := strconv.Unquote(tag[:j+1])
+		if err != nil {
+			break
+		}
+		tags[name] = value
+		tag = tag[j+1:]
+	}
+	return &tags
+}
+
+// loadedFiles returns the files of all the packages loaded while parsing the service.
+func loadedFiles() (files []string) {
+	for _, pkg := range packageCache {
+		files = append(files, pkg.Files...)
+	}
+	return files
+}
@@ -0,0 +1,83 @@
+package service
+
+import (
+	"os"
+	"testing"
+
+	"github.com/go-services/code"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseTags(t *testing.T) {
+	tags := parseTags(`json:"id,omitempty" query:"id" description:"the \"id\""`)
+	assert.Equal(t, code.FieldTags{
+		"json":        "id,omitempty",
+		"query":       "id",
+		"description": `the "id"`,
+	}, *tags)
+}
+
+func TestFindStructFromOtherPackage(t *testing.T) {
+	strc, err := findStruct(code.Type{
+		Qualifier: "AddressConfig",
+		Import:    &code.Import{Alias: "config", Path: "gs/config"},
+	})
+	assert.NoError(t, err)
+	assert.Len(t, strc.Fields, 2)
+	assert.Equal(t, "Port", strc.Fields[1].Name)
+	assert.Equal(t, "int", strc.Fields[1].Type.Qualifier)
+	assert.Equal(t, "port", (*strc.Fields[1].Tags)["toml"])
+}
+
+func TestUnderlyingType(t *testing.T) {
+	tp, ok := underlyingType(code.Type{
+		Qualifier: "Duration",
+		Import:    &code.Import{Alias: "time", Path: "time"},
+	})
+	assert.True(t, ok)
+	assert.Equal(t, "int64", tp.Qualifier)
+
+	_, ok = underlyingType(code.Type{
+		Qualifier: "Time",
+		Import:    &code.Import{Alias: "time", Path: "time"},
+	})
+	assert.False(t, ok, "structures do not have a basic underlying type")
+}
+
+func TestPromotedFields(t *testing.T) {
+	strc := code.NewStruct("Request")
+	strc.Fields = []code.StructField{
+		*code.NewStructField("Port", code.Type{Qualifier: "string"}),
+		*code.NewStructField("", code.Type{
+			Qualifier: "AddressConfig",
+			Pointer:   true,
+			Import:    &code.Import{Alias: "config", Path: "gs/config"},
+		}),
+	}
+	fields := promotedFields(strc)
+	assert.Len(t, fields, 2)
+	assert.Equal(t, "Port", fields[0].Path, "the declared field should shadow the promoted one")
+	assert.Equal(t, "string", fields[0].Type.Qualifier)
+	assert.Equal(t, "AddressConfig.Url", fields[1].Path)
+	assert.Equal(t, []Embed{{
+		Path:    "AddressConfig",
+		Type:    code.Type{Qualifier: "AddressConfig", Import: &code.Import{Alias: "config", Path: "gs/config"}},
+		Pointer: true,
+	}}, fields[1].Embeds)
+}
+
+func TestFindStructInvalidFieldType(t *testing.T) {
+	dir := writePackage(t, map[string]string{
+		"go.mod": "module broken\n",
+		"request.go": "package broken\n\nimport \"broken/missing\"\n\n" +
+			"type Request struct {\n\tName string\n\tItems []*missing.Item\n}\n",
+	})
+	defer os.RemoveAll(dir)
+
+	inFolder(t, dir, func() {
+		resetPackageCache()
+		_, err := findStruct(code.Type{Qualifier: "Request", Import: &code.Import{Alias: "broken", Path: "broken"}})
+		assert.Error(t, err)
+		assert.Contains(t, err.Error(), "field `Items` of `Request`")
+	})
+}
@@ -0,0 +1,375 @@
+package service
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/go-services/code"
+)
+
+// FieldValidation are the validation rules of a request field, the rules come from the
+// `valid` (govalidator) or `validate` (go-playground/validator) tags of the field.
+type FieldValidation struct {
+	// Field is the field selector, for promoted fields it includes the embedded fields
+	Field string
+	// Name and Location are the parameter name and location reported in the errors
+	Name     string
+	Location string
+	// Guard is the condition that needs to be true before reading the field (e.x the embedded pointers are set)
+	Guard string
+	// Optional fields are only validated when they are not empty, the rules of the `valid` tag are always
+	// optional like in govalidator, the rules of the `validate` tag are optional with `omitempty`
+	Optional bool
+	Rules    []ValidationRule
+}
+
+// ValidationRule is a single rule of a field validation.
+type ValidationRule struct {
+	Name string
+	Args []string
+	// Check is the go condition that is true when the rule fails.
+	Check string
+	// Reason is the quoted reason reported in the field error.
+	Reason string
+}
+
+func (r ValidationRule) String() string {
+	if len(r.Args) == 0 {
+		return r.Name
+	}
+	return r.Name + "=" + strings.Join(r.Args, " ")
+}
+
+var validatorFuncs = map[string]struct {
+	fn     string
+	reason string
+}{
+	"email":    {"IsEmail", "must be a valid email"},
+	"url":      {"IsURL", "must be a valid url"},
+	"uuid":     {"IsUUID", "must be a valid uuid"},
+	"alpha":    {"IsAlpha", "must contain only letters"},
+	"alphanum": {"IsAlphanumeric", "must contain only letters and numbers"},
+	"numeric":  {"IsNumeric", "must contain only numbers"},
+	"ip":       {"IsIP", "must be a valid ip address"},
+}
+
+var govalidatorRuleRegex = regexp.MustCompile(`^(\w+)(\((.*)\))?$`)
+
+// parseValidationTag parses the rules of the tag into the rule names and arguments, the `valid` tag uses
+// the govalidator syntax (e.x `required,length(1|10)`) and the `validate` tag the go-playground/validator
+// syntax (e.x `required,min=1,max=10`). The rules of both syntaxes are converted to the same names.
+func parseValidationTag(tags code.FieldTags) (rules []ValidationRule, optional bool, err error) {
+	valid, validate := getTag("valid", tags), getTag("validate", tags)
+	if valid == "-" {
+		valid = ""
+	}
+	if validate == "-" {
+		validate = ""
+	}
+	if valid != "" && validate != "" {
+		return nil, false, errors.New("use either the `valid` or the `validate` tag, not both")
+	}
+	if valid != "" {
+		// govalidator only checks the empty values with `required`
+		optional = true
+		for _, v := range strings.Split(valid, ",") {
+			match := govalidatorRuleRegex.FindStringSubmatch(strings.TrimSpace(v))
+			if match == nil {
+				return nil, false, fmt.Errorf("could not parse the validation rule `%s`", v)
+			}
+			name, args := strings.ToLower(match[1]), []string(nil)
+			if match[2] != "" {
+				args = strings.Split(match[3], "|")
+			}
+			switch name {
+			case "optional":
+				continue
+			case "alphanumeric":
+				name = "alphanum"
+			case "in":
+				name = "oneof"
+			case "length", "stringlength", "range":
+				if len(args) != 2 {
+					return nil, false, fmt.Errorf("validation rule `%s` needs a min and a max", v)
+				}
+				rules = append(rules, ValidationRule{Name: "min", Args: args[:1]}, ValidationRule{Name: "max", Args: args[1:]})
+				continue
+			}
+			rules = append(rules, ValidationRule{Name: name, Args: args})
+		}
+	}
+	if validate != "" {
+		for i, v := range strings.Split(validate, ",") {
+			v = strings.TrimSpace(v)
+			name, args := v, []string(nil)
+			if i := strings.Index(v, "="); i > 0 {
+				name, args = v[:i], strings.Fields(v[i+1:])
+			}
+			switch name {
+			case "omitempty":
+				optional = true
+				continue
+			case "gte":
+				name = "min"
+			case "lte":
+				name = "max"
+			case "dive", "keys":
+				// the rules after `dive` apply to the items of the slice or map, they are kept
+				// as the arguments of the rule so they are not applied to the field itself
+				rest := strings.Split(validate, ",")
+				rules = append(rules, ValidationRule{Name: name, Args: rest[i+1:]})
+				return rules, optional, nil
+			}
+			rules = append(rules, ValidationRule{Name: name, Args: args})
+		}
+	}
+	return rules, optional, nil
+}
+
+// parseFieldValidation returns the validation of the request field or nil if the field has no rules,
+// the rules that can not be applied to the field type are returned as errors and the rules that
+// are skipped on purpose (e.x the item rules after `dive`) as warnings.
+func parseFieldValidation(field promotedField) (validation *FieldValidation, errs []error, warnings []string) {
+	if field.Tags == nil {
+		return nil, nil, nil
+	}
+	rules, optional, err := parseValidationTag(*field.Tags)
+	if err != nil {
+		return nil, []error{fmt.Errorf("field `%s`: %s", field.Name, err)}, nil
+	}
+	if n := len(rules); n > 0 && (rules[n-1].Name == "dive" || rules[n-1].Name == "keys") {
+		warnings = append(warnings, fmt.Sprintf(
+			"field `%s`: the rules after `%s` validate the items and are not generated",
+			field.Name,
+			rules[n-1].Name,
+		))
+		rules = rules[:n-1]
+	}
+	if len(rules) == 0 {
+		return nil, nil, warnings
+	}
+	validation = &FieldValidation{Field: field.Path, Optional: optional}
+	validation.Name, validation.Location = fieldParameter(field)
+	var guards []string
+	for _, embed := range field.Embeds {
+		if embed.Pointer {
+			guards = append(guards, fmt.Sprintf("request.%s != nil", embed.Path))
+		}
+	}
+	validation.Guard = strings.Join(guards, " && ")
+
+	tp := field.Type
+	if underlying, ok := underlyingType(field.Type); ok {
+		tp = underlying
+	}
+	value := "request." + field.Path
+	if tp.Pointer {
+		value = "*" + value
+	}
+	kind := validationKind(tp)
+	switch {
+	case kind == "len":
+		value = "len(" + value + ")"
+	case kind == "string" && tp.String() != field.Type.String():
+		// named string types are converted so they can be passed to the validator functions
+		value = "string(" + value + ")"
+	}
+
+	for _, rule := range rules {
+		var check, reason string
+		if rule.Name == "required" && tp.Pointer {
+			check, reason = "request."+field.Path+" == nil", "required"
+		} else {
+			check, reason, err = ruleCheck(rule, kind, tp, value)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("field `%s`: %s", field.Name, err))
+				continue
+			}
+			// the format rules only check the values that are set, empty values are only checked by `required`
+			if rule.Name != "required" && (optional || rule.Name == "matches" || validatorFuncs[rule.Name].fn != "") {
+				check = notZero(kind, value) + " && " + check
+			}
+			if tp.Pointer {
+				check = "request." + field.Path + " != nil && " + check
+			}
+		}
+		rule.Check, rule.Reason = check, strconv.Quote(reason)
+		validation.Rules = append(validation.Rules, rule)
+	}
+	if len(validation.Rules) == 0 {
+		return nil, errs, warnings
+	}
+	return validation, errs, warnings
+}
+
+// fieldParameter returns the name and the location of the parameter the field is read from.
+func fieldParameter(field promotedField) (name, location string) {
+	for _, kind := range []string{"url", "query", "header", "cookie"} {
+		if tag := getTag(kind, *field.Tags); tag != "" {
+			name, _ = getParameter(tag)
+			return name, kind
+		}
+	}
+	if tag := getTag("json", *field.Tags); tag != "" && tag != "-" {
+		if name = strings.Split(tag, ",")[0]; name != "" {
+			return name, "body"
+		}
+	}
+	return field.Name, "body"
+}
+
+// validationKind returns how the rules are applied to the type, `len` is used for slices and maps
+// where the rules apply to the number of items.
+func validationKind(tp code.Type) string {
+	if tp.ArrayType || tp.MapType != nil {
+		return "len"
+	}
+	switch {
+	case tp.Import != nil:
+		return ""
+	case tp.Qualifier == "string":
+		return "string"
+	case tp.Qualifier == "bool":
+		return "bool"
+	case strings.HasPrefix(tp.Qualifier, "int"), strings.HasPrefix(tp.Qualifier, "uint"), strings.HasPrefix(tp.Qualifier, "float"):
+		return "number"
+	}
+	return ""
+}
+
+func isZero(kind, value string) string {
+	switch kind {
+	case "string":
+		return value + ` == ""`
+	case "bool":
+		return "!" + value
+	default:
+		return value + " == 0"
+	}
+}
+
+func notZero(kind, value string) string {
+	switch kind {
+	case "string":
+		return value + ` != ""`
+	case "bool":
+		return value
+	default:
+		return value + " != 0"
+	}
+}
+
+// ruleCheck returns the condition that is true when the value does not pass the rule.
+func ruleCheck(rule ValidationRule, kind string, tp code.Type, value string) (check, reason string, err error) {
+	if kind == "" {
+		return "", "", fmt.Errorf("type `%s` can not be validated", tp)
+	}
+	switch rule.Name {
+	case "required":
+		return isZero(kind, value), "required", nil
+	case "min", "max", "len", "gt", "lt":
+		if len(rule.Args) != 1 || kind == "bool" || (kind == "number" && rule.Name == "len") {
+			return "", "", fmt.Errorf("validation rule `%s` is not supported for `%s`", rule, tp)
+		}
+		limit := rule.Args[0]
+		if err := checkNumber(limit, kind != "number" || !strings.HasPrefix(tp.Qualifier, "float")); err != nil {
+			return "", "", fmt.Errorf("validation rule `%s`: %s", rule, err)
+		}
+		op, text := map[string]string{"min": "<", "max": ">", "len": "!=", "gt": "<=", "lt": ">="}[rule.Name],
+			map[string]string{"min": "at least", "max": "at most", "len": "exactly", "gt": "more than", "lt": "less than"}[rule.Name]
+		switch kind {
+		case "string":
+			return fmt.Sprintf("utf8.RuneCountInString(%s) %s %s", value, op, limit), fmt.Sprintf("must be %s %s characters long", text, limit), nil
+		case "len":
+			return fmt.Sprintf("%s %s %s", value, op, limit), fmt.Sprintf("must have %s %s items", text, limit), nil
+		default:
+			return fmt.Sprintf("%s %s %s", value, op, limit), fmt.Sprintf("must be %s %s", text, limit), nil
+		}
+	case "eq", "ne":
+		if len(rule.Args) != 1 || (kind != "string" && kind != "number") {
+			return "", "", fmt.Errorf("validation rule `%s` is not supported for `%s`", rule, tp)
+		}
+		arg := rule.Args[0]
+		if kind == "string" {
+			arg = strconv.Quote(arg)
+		} else if err := checkNumber(arg, !strings.HasPrefix(tp.Qualifier, "float")); err != nil {
+			return "", "", fmt.Errorf("validation rule `%s`: %s", rule, err)
+		}
+		if rule.Name == "eq" {
+			return value + " != " + arg, "must be " + rule.Args[0], nil
+		}
+		return value + " == " + arg, "must not be " + rule.Args[0], nil
+	case "oneof":
+		if len(rule.Args) == 0 || (kind != "string" && kind != "number") {
+			return "", "", fmt.Errorf("validation rule `%s` is not supported for `%s`", rule, tp)
+		}
+		var values []string
+		for _, arg := range rule.Args {
+			if kind == "string" {
+				arg = strconv.Quote(arg)
+			} else if err := checkNumber(arg, !strings.HasPrefix(tp.Qualifier, "float")); err != nil {
+				return "", "", fmt.Errorf("validation rule `%s`: %s", rule, err)
+			}
+			values = append(values, value+" == "+arg)
+		}
+		return "!(" + strings.Join(values, " || ") + ")", "must be one of " + strings.Join(rule.Args, ", "), nil
+	case "matches":
+		if len(rule.Args) != 1 || kind != "string" {
+			return "", "", fmt.Errorf("validation rule `%s` is not supported for `%s`", rule, tp)
+		}
+		if _, err := regexp.Compile(rule.Args[0]); err != nil {
+			return "", "", fmt.Errorf("validation rule `%s`: %s", rule, err)
+		}
+		return fmt.Sprintf(`!govalidator.Matches(%s, %q)`, value, rule.Args[0]),
+			fmt.Sprintf("must match `%s`", rule.Args[0]), nil
+	}
+	if fn, ok := validatorFuncs[rule.Name]; ok {
+		if kind != "string" {
+			return "", "", fmt.Errorf("validation rule `%s` is not supported for `%s`", rule, tp)
+		}
+		return fmt.Sprintf(`!govalidator.%s(%s)`, fn.fn, value), fn.reason, nil
+	}
+	return "", "", fmt.Errorf("unknown validation rule `%s`", rule.Name)
+}
+
+func checkNumber(s string, integer bool) error {
+	if integer {
+		if _, err := strconv.ParseInt(s, 10, 64); err != nil {
+			return fmt.Errorf("`%s` is not an integer", s)
+		}
+		return nil
+	}
+	if _, err := strconv.ParseFloat(s, 64); err != nil {
+		return fmt.Errorf("`%s` is not a number", s)
+	}
+	return nil
+}
+
+// parseValidations returns the validations of the request fields, the error lists the rules that can
+// not be generated, the request is not generated with such rules because it would not be checked for them.
+func parseValidations(request *code.Struct) (validations []FieldValidation, warnings []string, err error) {
+	if request == nil {
+		return nil, nil, nil
+	}
+	var problems []string
+	for _, field := range promotedFields(request) {
+		if !isExported(field.Name) {
+			continue
+		}
+		validation, errs, fieldWarnings := parseFieldValidation(field)
+		for _, err := range errs {
+			problems = append(problems, err.Error())
+		}
+		warnings = append(warnings, fieldWarnings...)
+		if validation != nil {
+			validations = append(validations, *validation)
+		}
+	}
+	if len(problems) > 0 {
+		err = fmt.Errorf("invalid validation rules:\n  %s", strings.Join(problems, "\n  "))
+	}
+	return validations, warnings, err
+}
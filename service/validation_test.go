@@ -0,0 +1,130 @@
+package service
+
+import (
+	"testing"
+
+	"github.com/go-services/code"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseValidationTag(t *testing.T) {
+	rules, optional, err := parseValidationTag(code.FieldTags{"valid": "required,email,length(1|10),in(a|b),optional"})
+	assert.NoError(t, err)
+	assert.True(t, optional)
+	assert.Equal(t, []ValidationRule{
+		{Name: "required"},
+		{Name: "email"},
+		{Name: "min", Args: []string{"1"}},
+		{Name: "max", Args: []string{"10"}},
+		{Name: "oneof", Args: []string{"a", "b"}},
+	}, rules)
+
+	rules, optional, err = parseValidationTag(code.FieldTags{"validate": "required,min=1,max=100,oneof=a b"})
+	assert.NoError(t, err)
+	assert.False(t, optional)
+	assert.Equal(t, []ValidationRule{
+		{Name: "required"},
+		{Name: "min", Args: []string{"1"}},
+		{Name: "max", Args: []string{"100"}},
+		{Name: "oneof", Args: []string{"a", "b"}},
+	}, rules)
+
+	rules, _, err = parseValidationTag(code.FieldTags{"validate": "gte=1,lte=10,ne=5"})
+	assert.NoError(t, err)
+	assert.Equal(t, []ValidationRule{
+		{Name: "min", Args: []string{"1"}},
+		{Name: "max", Args: []string{"10"}},
+		{Name: "ne", Args: []string{"5"}},
+	}, rules)
+
+	rules, _, err = parseValidationTag(code.FieldTags{"validate": "max=3,dive,required,min=2"})
+	assert.NoError(t, err)
+	assert.Equal(t, []ValidationRule{
+		{Name: "max", Args: []string{"3"}},
+		{Name: "dive", Args: []string{"required", "min=2"}},
+	}, rules)
+
+	_, _, err = parseValidationTag(code.FieldTags{"valid": "required", "validate": "required"})
+	assert.Error(t, err)
+}
+
+func TestParseFieldValidation(t *testing.T) {
+	field := promotedField{
+		StructField: *code.NewStructFieldWithTag("Limit", code.NewType("int"), &code.FieldTags{
+			"query":    "limit",
+			"validate": "omitempty,max=100",
+		}),
+		Path: "Pagination.Limit",
+	}
+	validation, errs, _ := parseFieldValidation(field)
+	assert.Empty(t, errs)
+	assert.Equal(t, "limit", validation.Name)
+	assert.Equal(t, "query", validation.Location)
+	assert.Equal(t, "request.Pagination.Limit != 0 && request.Pagination.Limit > 100", validation.Rules[0].Check)
+	assert.Equal(t, `"must be at most 100"`, validation.Rules[0].Reason)
+
+	field = promotedField{
+		StructField: *code.NewStructFieldWithTag("Email", code.NewType("string"), &code.FieldTags{
+			"json":  "email",
+			"valid": "email,frobnicate",
+		}),
+		Path: "Email",
+	}
+	validation, errs, _ = parseFieldValidation(field)
+	assert.Len(t, errs, 1, "unknown rules should be reported")
+	assert.Equal(t, "email", validation.Name)
+	assert.Equal(t, "body", validation.Location)
+	assert.Equal(t, `request.Email != "" && !govalidator.IsEmail(request.Email)`, validation.Rules[0].Check)
+
+	field = promotedField{
+		StructField: *code.NewStructFieldWithTag("Name", code.NewType("string"), &code.FieldTags{
+			"valid": "required,length(1|10)",
+		}),
+		Path: "Name",
+	}
+	validation, errs, _ = parseFieldValidation(field)
+	assert.Empty(t, errs)
+	assert.Equal(t, `request.Name == ""`, validation.Rules[0].Check)
+	assert.Equal(t, `request.Name != "" && utf8.RuneCountInString(request.Name) < 1`, validation.Rules[1].Check,
+		"govalidator only checks empty values with required")
+}
+
+func TestParseValidations(t *testing.T) {
+	request := code.NewStruct("Request")
+	request.Fields = []code.StructField{
+		*code.NewStructFieldWithTag("Count", code.NewType("int"), &code.FieldTags{"validate": "gt=0,lt=10"}),
+	}
+	validations, _, err := parseValidations(request)
+	assert.NoError(t, err)
+	assert.Equal(t, "request.Count <= 0", validations[0].Rules[0].Check)
+	assert.Equal(t, `"must be more than 0"`, validations[0].Rules[0].Reason)
+	assert.Equal(t, "request.Count >= 10", validations[0].Rules[1].Check)
+
+	request.Fields = append(request.Fields, *code.NewStructFieldWithTag("Tags", code.NewType("string"), &code.FieldTags{
+		"validate": "unique",
+	}))
+	validations, _, err = parseValidations(request)
+	assert.Error(t, err, "the rules that can not be generated should fail the generation")
+	assert.Len(t, validations, 1, "the other rules should still be parsed")
+}
+
+func TestParseValidationsDive(t *testing.T) {
+	request := code.NewStruct("Request")
+	request.Fields = []code.StructField{
+		*code.NewStructFieldWithTag("Tags", code.NewType("string", code.ArrayTypeOption()), &code.FieldTags{"validate": "min=1,dive,required"}),
+		*code.NewStructFieldWithTag("Ids", code.NewType("string", code.ArrayTypeOption()), &code.FieldTags{"validate": "dive,required"}),
+	}
+	validations, warnings, err := parseValidations(request)
+	assert.NoError(t, err)
+	assert.Len(t, validations, 1, "the field without rules before `dive` should not be validated")
+	assert.Equal(t, []ValidationRule{{
+		Name:   "min",
+		Args:   []string{"1"},
+		Check:  "len(request.Tags) < 1",
+		Reason: `"must have at least 1 items"`,
+	}}, validations[0].Rules, "`required` after `dive` should not be applied to the slice")
+	assert.Equal(t, []string{
+		"field `Tags`: the rules after `dive` validate the items and are not generated",
+		"field `Ids`: the rules after `dive` validate the items and are not generated",
+	}, warnings)
+}
@@ -0,0 +1,142 @@
+package service
+
+import (
+	"gs/fs"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+// the folder inside gen where the files are staged before they are moved in place
+const stagingFolder = ".gs-tmp"
+
+type move struct {
+	from string
+	to   string
+}
+
+// writeFiles writes only the files that changed and deletes the files that are not generated anymore,
+// it returns the paths of the files that were written.
+// All the files are first written to a staging folder and only moved in place once all of them
+// were written, if anything fails the files that were already moved are restored.
+func (s *Service) writeFiles(files map[string]string, previous *manifest) (written []string, err error) {
+	staging := s.GetPath("gen", stagingFolder)
+	// a previous run could have crashed before cleaning up
+	if err := fs.DeleteFolder(staging); err != nil {
+		return nil, err
+	}
+	defer func() {
+		if cleanErr := fs.DeleteFolder(staging); cleanErr != nil && err == nil {
+			err = cleanErr
+		}
+	}()
+
+	var changed []string
+	for pth, src := range files {
+		if current, err := fs.ReadFile(pth); err == nil && current == src {
+			continue
+		}
+		changed = append(changed, pth)
+	}
+	sort.Strings(changed)
+	stale, err := s.staleFiles(files, previous)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, pth := range changed {
+		if err := fs.WriteFile(path.Join(staging, "new", pth), files[pth]); err != nil {
+			return nil, err
+		}
+	}
+
+	var moves []move
+	rollback := func() {
+		for i := len(moves) - 1; i >= 0; i-- {
+			if err := fs.Rename(moves[i].to, moves[i].from); err != nil {
+				log.Errorf("Could not restore `%s`: %s", moves[i].from, err)
+			}
+		}
+	}
+	apply := func(from, to string) error {
+		if err := fs.Rename(from, to); err != nil {
+			rollback()
+			return err
+		}
+		moves = append(moves, move{from: from, to: to})
+		return nil
+	}
+	// move the files that are replaced or deleted out of the way so they can be restored
+	for _, pth := range append(append([]string{}, changed...), stale...) {
+		if b, _ := fs.Exists(pth); !b {
+			continue
+		}
+		if err := apply(pth, path.Join(staging, "old", pth)); err != nil {
+			return nil, err
+		}
+	}
+	for _, pth := range changed {
+		if err := apply(path.Join(staging, "new", pth), pth); err != nil {
+			return nil, err
+		}
+	}
+	for _, pth := range stale {
+		if err := s.deleteEmptyFolders(path.Dir(pth)); err != nil {
+			return changed, err
+		}
+	}
+	return changed, nil
+}
+
+// deleteEmptyFolders deletes the folder and its parents inside the service folder if they have no files.
+func (s *Service) deleteEmptyFolders(dir string) error {
+	for dir != s.Path && strings.HasPrefix(dir, s.Path+"/") {
+		if b, _ := fs.Exists(dir); b {
+			files, err := fs.ListFiles(dir)
+			if err != nil {
+				return err
+			}
+			if len(files) > 0 {
+				return nil
+			}
+			if err := fs.DeleteFolder(dir); err != nil {
+				return err
+			}
+		}
+		dir = path.Dir(dir)
+	}
+	return nil
+}
+
+// staleFiles returns the files that were generated before but are not generated anymore.
+func (s *Service) staleFiles(files map[string]string, previous *manifest) (stale []string, err error) {
+	existing, err := fs.ListFiles(s.GetPath("gen"))
+	if err != nil {
+		return nil, err
+	}
+	if previous != nil {
+		// plugins can generate files outside of the gen folder
+		for pth := range previous.Files {
+			existing = append(existing, pth)
+		}
+	}
+	seen := map[string]bool{}
+	for _, pth := range existing {
+		pth = filepath.ToSlash(pth)
+		if _, ok := files[pth]; ok || seen[pth] || filepath.Base(pth) == manifestFile {
+			continue
+		}
+		// the files generated by protoc are kept as long as the service has a grpc transport
+		if s.GRPCTransport != nil && strings.HasSuffix(pth, ".pb.go") {
+			continue
+		}
+		if b, _ := fs.Exists(pth); !b {
+			continue
+		}
+		seen[pth] = true
+		stale = append(stale, pth)
+	}
+	sort.Strings(stale)
+	return stale, nil
+}
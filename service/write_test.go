@@ -0,0 +1,77 @@
+package service
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriteFiles(t *testing.T) {
+	dir := writeModule(t, map[string]string{
+		"svc/gen/same.go":    "same",
+		"svc/gen/changed.go": "old",
+		"svc/gen/stale.go":   "stale",
+	})
+	defer os.RemoveAll(dir)
+
+	inFolder(t, dir, func() {
+		s := &Service{Path: "svc"}
+		written, err := s.writeFiles(map[string]string{
+			"svc/gen/same.go":       "same",
+			"svc/gen/changed.go":    "new",
+			"svc/gen/new/new.go":    "new",
+			"svc/docs/endpoints.md": "# endpoints",
+		}, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"svc/docs/endpoints.md", "svc/gen/changed.go", "svc/gen/new/new.go"}, written)
+
+		for pth, data := range map[string]string{
+			"svc/gen/same.go":       "same",
+			"svc/gen/changed.go":    "new",
+			"svc/gen/new/new.go":    "new",
+			"svc/docs/endpoints.md": "# endpoints",
+		} {
+			current, err := ioutil.ReadFile(pth)
+			assert.NoError(t, err)
+			assert.Equal(t, data, string(current), pth)
+		}
+		for _, pth := range []string{"svc/gen/stale.go", "svc/gen/" + stagingFolder} {
+			_, err := os.Stat(pth)
+			assert.True(t, os.IsNotExist(err), pth)
+		}
+	})
+}
+
+func TestWriteFilesRollback(t *testing.T) {
+	files := map[string]string{
+		"svc/gen/a.go":     "old a",
+		"svc/gen/stale.go": "stale",
+		// the folder of the last file is a file so the file can not be moved in place
+		"svc/z": "not a folder",
+	}
+	dir := writeModule(t, files)
+	defer os.RemoveAll(dir)
+
+	inFolder(t, dir, func() {
+		s := &Service{Path: "svc"}
+		_, err := s.writeFiles(map[string]string{
+			"svc/gen/a.go": "new a",
+			"svc/gen/b.go": "new b",
+			"svc/z/c.go":   "new c",
+		}, nil)
+		assert.Error(t, err)
+
+		for pth, data := range files {
+			current, err := ioutil.ReadFile(pth)
+			assert.NoError(t, err, pth)
+			assert.Equal(t, data, string(current), "the file should be restored")
+		}
+		for _, pth := range []string{"svc/gen/b.go", filepath.Join("svc", "gen", stagingFolder)} {
+			_, err := os.Stat(pth)
+			assert.True(t, os.IsNotExist(err), pth)
+		}
+	})
+}
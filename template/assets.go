@@ -155,39 +155,161 @@ func init() {
 				},
 			}, "/assets/middleware/endpoint_middleware.jet": {
 				data: []byte{
-					0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x7b, 0x7b, 0x2e, 0x4e, 0x61, 0x6d,
-					0x65, 0x7d, 0x7d, 0x28, 0x6e, 0x65, 0x78, 0x74, 0x20, 0x64, 0x65, 0x66,
-					0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x7b, 0x7b, 0x2e,
-					0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x4e, 0x61, 0x6d,
-					0x65, 0x7d, 0x7d, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x46,
-					0x75, 0x6e, 0x63, 0x29, 0x20, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74,
-					0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x7b, 0x7b, 0x2e, 0x45, 0x6e, 0x64, 0x70,
-					0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x45,
-					0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x46, 0x75, 0x6e, 0x63, 0x20,
-					0x7b, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x66, 0x75,
-					0x6e, 0x63, 0x28, 0x63, 0x74, 0x78, 0x20, 0x63, 0x6f, 0x6e, 0x74, 0x65,
-					0x78, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x2c, 0x20,
-					0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x73, 0x65, 0x72, 0x76,
-					0x69, 0x63, 0x65, 0x2e, 0x7b, 0x7b, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f,
-					0x69, 0x6e, 0x74, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x52, 0x65,
-					0x71, 0x75, 0x65, 0x73, 0x74, 0x29, 0x20, 0x28, 0x72, 0x65, 0x73, 0x70,
-					0x6f, 0x6e, 0x73, 0x65, 0x20, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74,
-					0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x7b, 0x7b, 0x2e, 0x45, 0x6e, 0x64, 0x70,
-					0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x52,
-					0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2c, 0x20, 0x65, 0x72, 0x72,
-					0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x29, 0x20, 0x7b, 0x0a, 0x09, 0x09,
+					0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x20, 0x6d, 0x69, 0x64, 0x64,
+					0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x0a, 0x0a, 0x69, 0x6d, 0x70, 0x6f,
+					0x72, 0x74, 0x20, 0x28, 0x0a, 0x09, 0x22, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+					0x78, 0x74, 0x22, 0x0a, 0x09, 0x22, 0x7b, 0x7b, 0x20, 0x2e, 0x53, 0x65,
+					0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+					0x20, 0x7d, 0x7d, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x65, 0x6e, 0x64, 0x70,
+					0x6f, 0x69, 0x6e, 0x74, 0x2f, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74,
+					0x69, 0x6f, 0x6e, 0x73, 0x22, 0x0a, 0x7b, 0x7b, 0x69, 0x66, 0x20, 0x2e,
+					0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x71,
+					0x75, 0x65, 0x73, 0x74, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x7d, 0x7d,
+					0x7b, 0x7b, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e,
+					0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+					0x74, 0x2e, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x7d, 0x7d, 0x20, 0x22, 0x7b,
+					0x7b, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x52,
+					0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+					0x2e, 0x50, 0x61, 0x74, 0x68, 0x7d, 0x7d, 0x22, 0x20, 0x7b, 0x7b, 0x65,
+					0x6e, 0x64, 0x7d, 0x7d, 0x0a, 0x29, 0x0a, 0x0a, 0x66, 0x75, 0x6e, 0x63,
+					0x20, 0x7b, 0x7b, 0x20, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x20, 0x7d, 0x7d,
+					0x28, 0x29, 0x20, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f,
+					0x6e, 0x73, 0x2e, 0x7b, 0x7b, 0x20, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f,
+					0x69, 0x6e, 0x74, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x20, 0x7d, 0x7d, 0x45,
+					0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x4d, 0x69, 0x64, 0x64, 0x6c,
+					0x65, 0x77, 0x61, 0x72, 0x65, 0x20, 0x7b, 0x0a, 0x09, 0x72, 0x65, 0x74,
+					0x75, 0x72, 0x6e, 0x20, 0x66, 0x75, 0x6e, 0x63, 0x28, 0x6e, 0x65, 0x78,
+					0x74, 0x20, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e,
+					0x73, 0x2e, 0x7b, 0x7b, 0x20, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69,
+					0x6e, 0x74, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x20, 0x7d, 0x7d, 0x45, 0x6e,
+					0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x46, 0x75, 0x6e, 0x63, 0x29, 0x20,
+					0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
+					0x7b, 0x7b, 0x20, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+					0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x20, 0x7d, 0x7d, 0x45, 0x6e, 0x64, 0x70,
+					0x6f, 0x69, 0x6e, 0x74, 0x46, 0x75, 0x6e, 0x63, 0x20, 0x7b, 0x0a, 0x09,
+					0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x66, 0x75, 0x6e, 0x63,
+					0x28, 0x63, 0x74, 0x78, 0x20, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74,
+					0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x7b, 0x7b, 0x20, 0x69,
+					0x66, 0x20, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e,
+					0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x7d, 0x7d, 0x2c, 0x20,
+					0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x7b, 0x7b, 0x20, 0x72,
+					0x65, 0x71, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x20, 0x3a, 0x3d, 0x20, 0x2e,
+					0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x50, 0x61, 0x72,
+					0x61, 0x6d, 0x73, 0x5b, 0x31, 0x5d, 0x20, 0x7d, 0x7d, 0x7b, 0x7b, 0x20,
+					0x72, 0x65, 0x71, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x2e, 0x54, 0x79, 0x70,
+					0x65, 0x20, 0x7d, 0x7d, 0x7b, 0x7b, 0x20, 0x65, 0x6e, 0x64, 0x20, 0x7d,
+					0x7d, 0x29, 0x20, 0x28, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+					0x20, 0x64, 0x65, 0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+					0x2e, 0x7b, 0x7b, 0x20, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e,
+					0x74, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x20, 0x7d, 0x7d, 0x52, 0x65, 0x73,
+					0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x20, 0x65,
+					0x72, 0x72, 0x6f, 0x72, 0x29, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x09, 0x2f,
+					0x2f, 0x20, 0x54, 0x4f, 0x44, 0x4f, 0x3a, 0x20, 0x49, 0x6d, 0x70, 0x6c,
+					0x65, 0x6d, 0x65, 0x6e, 0x74, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6d, 0x69,
+					0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x20, 0x6c, 0x6f, 0x67,
+					0x69, 0x63, 0x20, 0x68, 0x65, 0x72, 0x65, 0x0a, 0x09, 0x09, 0x09, 0x72,
+					0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x6e, 0x65, 0x78, 0x74, 0x28, 0x63,
+					0x74, 0x78, 0x7b, 0x7b, 0x20, 0x69, 0x66, 0x20, 0x2e, 0x45, 0x6e, 0x64,
+					0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+					0x74, 0x20, 0x7d, 0x7d, 0x2c, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+					0x74, 0x7b, 0x7b, 0x20, 0x65, 0x6e, 0x64, 0x20, 0x7d, 0x7d, 0x29, 0x0a,
+					0x09, 0x09, 0x7d, 0x0a, 0x09, 0x7d, 0x0a, 0x7d, 0x0a,
+				},
+				fi: FileInfo{
+					name:    "endpoint_middleware.jet",
+					size:    705,
+					modTime: time.Unix(0, 1792307429669509695),
+					isDir:   false,
+				},
+			}, "/assets/middleware/service_middleware.jet": {
+				data: []byte{
+					0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x20, 0x6d, 0x69, 0x64, 0x64,
+					0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x0a, 0x0a, 0x69, 0x6d, 0x70, 0x6f,
+					0x72, 0x74, 0x20, 0x28, 0x0a, 0x09, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+					0x65, 0x20, 0x22, 0x7b, 0x7b, 0x20, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69,
+					0x63, 0x65, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x20, 0x7d, 0x7d,
+					0x22, 0x0a, 0x09, 0x67, 0x65, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+					0x65, 0x20, 0x22, 0x7b, 0x7b, 0x20, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69,
+					0x63, 0x65, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x20, 0x7d, 0x7d,
+					0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+					0x22, 0x0a, 0x7b, 0x7b, 0x20, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x20, 0x2e,
+					0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x20, 0x7d, 0x7d, 0x09, 0x7b,
+					0x7b, 0x20, 0x2e, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x20, 0x7d, 0x7d, 0x20,
+					0x22, 0x7b, 0x7b, 0x20, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x20, 0x7d, 0x7d,
+					0x22, 0x0a, 0x7b, 0x7b, 0x20, 0x65, 0x6e, 0x64, 0x20, 0x7d, 0x7d, 0x29,
+					0x0a, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x7b, 0x7b, 0x20, 0x2e, 0x4e,
+					0x61, 0x6d, 0x65, 0x20, 0x7d, 0x7d, 0x28, 0x29, 0x20, 0x67, 0x65, 0x6e,
+					0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4d, 0x69, 0x64, 0x64,
+					0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x20, 0x7b, 0x0a, 0x09, 0x72, 0x65,
+					0x74, 0x75, 0x72, 0x6e, 0x20, 0x66, 0x75, 0x6e, 0x63, 0x28, 0x6e, 0x65,
+					0x78, 0x74, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x7b,
+					0x7b, 0x20, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x49,
+					0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x20, 0x7d, 0x7d, 0x29,
+					0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x7b, 0x7b, 0x20,
+					0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x49, 0x6e, 0x74,
+					0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x20, 0x7d, 0x7d, 0x20, 0x7b, 0x0a,
+					0x09, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x7b, 0x7b, 0x20,
+					0x6c, 0x6f, 0x77, 0x65, 0x72, 0x46, 0x69, 0x72, 0x73, 0x74, 0x28, 0x20,
+					0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x20, 0x29, 0x20, 0x7d, 0x7d, 0x7b, 0x6e,
+					0x65, 0x78, 0x74, 0x3a, 0x20, 0x6e, 0x65, 0x78, 0x74, 0x7d, 0x0a, 0x09,
+					0x7d, 0x0a, 0x7d, 0x0a, 0x0a, 0x74, 0x79, 0x70, 0x65, 0x20, 0x7b, 0x7b,
+					0x20, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x46, 0x69, 0x72, 0x73, 0x74, 0x28,
+					0x20, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x20, 0x29, 0x20, 0x7d, 0x7d, 0x20,
+					0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x20, 0x7b, 0x0a, 0x09, 0x6e, 0x65,
+					0x78, 0x74, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x7b,
+					0x7b, 0x20, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x49,
+					0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x20, 0x7d, 0x7d, 0x0a,
+					0x7d, 0x0a,
+				},
+				fi: FileInfo{
+					name:    "service_middleware.jet",
+					size:    434,
+					modTime: time.Unix(0, 1792307446836657519),
+					isDir:   false,
+				},
+			}, "/assets/middleware/service_middleware_method.jet": {
+				data: []byte{
+					0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x28, 0x6d, 0x77, 0x20, 0x7b, 0x7b,
+					0x20, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x46, 0x69, 0x72, 0x73, 0x74, 0x28,
+					0x20, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x20, 0x29, 0x20, 0x7d, 0x7d, 0x29,
+					0x20, 0x7b, 0x7b, 0x20, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e,
+					0x74, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x20, 0x7d, 0x7d, 0x28, 0x63, 0x74,
+					0x78, 0x20, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x2e, 0x43, 0x6f,
+					0x6e, 0x74, 0x65, 0x78, 0x74, 0x7b, 0x7b, 0x20, 0x69, 0x66, 0x20, 0x2e,
+					0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x71,
+					0x75, 0x65, 0x73, 0x74, 0x20, 0x7d, 0x7d, 0x2c, 0x20, 0x72, 0x65, 0x71,
+					0x75, 0x65, 0x73, 0x74, 0x20, 0x7b, 0x7b, 0x20, 0x72, 0x65, 0x71, 0x50,
+					0x61, 0x72, 0x61, 0x6d, 0x20, 0x3a, 0x3d, 0x20, 0x2e, 0x45, 0x6e, 0x64,
+					0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+					0x5b, 0x31, 0x5d, 0x20, 0x7d, 0x7d, 0x7b, 0x7b, 0x20, 0x72, 0x65, 0x71,
+					0x50, 0x61, 0x72, 0x61, 0x6d, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x20, 0x7d,
+					0x7d, 0x7b, 0x7b, 0x20, 0x65, 0x6e, 0x64, 0x20, 0x7d, 0x7d, 0x29, 0x20,
+					0x28, 0x7b, 0x7b, 0x20, 0x69, 0x66, 0x20, 0x2e, 0x45, 0x6e, 0x64, 0x70,
+					0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+					0x65, 0x20, 0x7d, 0x7d, 0x7b, 0x7b, 0x20, 0x72, 0x65, 0x73, 0x70, 0x50,
+					0x61, 0x72, 0x61, 0x6d, 0x20, 0x3a, 0x3d, 0x20, 0x2e, 0x45, 0x6e, 0x64,
+					0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+					0x73, 0x5b, 0x30, 0x5d, 0x20, 0x7d, 0x7d, 0x7b, 0x7b, 0x20, 0x72, 0x65,
+					0x73, 0x70, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x2e, 0x54, 0x79, 0x70, 0x65,
+					0x20, 0x7d, 0x7d, 0x2c, 0x20, 0x7b, 0x7b, 0x20, 0x65, 0x6e, 0x64, 0x20,
+					0x7d, 0x7d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x29, 0x20, 0x7b, 0x0a, 0x09,
 					0x2f, 0x2f, 0x20, 0x54, 0x4f, 0x44, 0x4f, 0x3a, 0x20, 0x49, 0x6d, 0x70,
 					0x6c, 0x65, 0x6d, 0x65, 0x6e, 0x74, 0x20, 0x74, 0x68, 0x65, 0x20, 0x6d,
 					0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x20, 0x6c, 0x6f,
-					0x67, 0x69, 0x63, 0x20, 0x68, 0x65, 0x72, 0x65, 0x0a, 0x09, 0x09, 0x72,
-					0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x6e, 0x65, 0x78, 0x74, 0x28, 0x63,
-					0x74, 0x78, 0x2c, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x29,
-					0x0a, 0x09, 0x7d, 0x0a, 0x7d, 0x0a,
+					0x67, 0x69, 0x63, 0x20, 0x68, 0x65, 0x72, 0x65, 0x0a, 0x09, 0x72, 0x65,
+					0x74, 0x75, 0x72, 0x6e, 0x20, 0x6d, 0x77, 0x2e, 0x6e, 0x65, 0x78, 0x74,
+					0x2e, 0x7b, 0x7b, 0x20, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e,
+					0x74, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x20, 0x7d, 0x7d, 0x28, 0x63, 0x74,
+					0x78, 0x7b, 0x7b, 0x20, 0x69, 0x66, 0x20, 0x2e, 0x45, 0x6e, 0x64, 0x70,
+					0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+					0x20, 0x7d, 0x7d, 0x2c, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+					0x7b, 0x7b, 0x20, 0x65, 0x6e, 0x64, 0x20, 0x7d, 0x7d, 0x29, 0x0a, 0x7d,
+					0x0a,
 				},
 				fi: FileInfo{
-					name:    "endpoint_middleware.jet",
-					size:    330,
-					modTime: time.Unix(0, 1586906969367502925),
+					name:    "service_middleware_method.jet",
+					size:    421,
+					modTime: time.Unix(0, 1792307429672440725),
 					isDir:   false,
 				},
 			}, "/assets/partials": {
@@ -246,12 +368,15 @@ func init() {
 					0x76, 0x65, 0x6e, 0x64, 0x6f, 0x72, 0x2f, 0x0a, 0x0a, 0x23, 0x20, 0x44,
 					0x6f, 0x6e, 0x27, 0x74, 0x20, 0x70, 0x75, 0x73, 0x68, 0x20, 0x67, 0x65,
 					0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x20, 0x63, 0x6f, 0x64, 0x65,
-					0x0a, 0x67, 0x65, 0x6e, 0x2f,
+					0x0a, 0x67, 0x65, 0x6e, 0x2f, 0x0a, 0x0a, 0x23, 0x20, 0x42, 0x69, 0x6e,
+					0x61, 0x72, 0x69, 0x65, 0x73, 0x20, 0x62, 0x75, 0x69, 0x6c, 0x74, 0x20,
+					0x77, 0x69, 0x74, 0x68, 0x20, 0x60, 0x67, 0x73, 0x20, 0x62, 0x75, 0x69,
+					0x6c, 0x64, 0x60, 0x0a, 0x64, 0x69, 0x73, 0x74, 0x2f,
 				},
 				fi: FileInfo{
 					name:    "gitignore",
-					size:    365,
-					modTime: time.Unix(0, 1586906969367140982),
+					size:    405,
+					modTime: time.Unix(0, 1792307780807162074),
 					isDir:   false,
 				},
 			}, "/assets/project/go.mod.jet": {
@@ -279,20 +404,20 @@ func init() {
 				fi: FileInfo{
 					name:    "go.mod.jet",
 					size:    226,
-					modTime: time.Unix(0, 1587908509077292107),
+					modTime: time.Unix(0, 1792313076585935672),
 					isDir:   false,
 				},
 			}, "/assets/project/gs.jet": {
 				data: []byte{
-					0x7b, 0x0a, 0x09, 0x22, 0x6d, 0x6f, 0x64, 0x75, 0x6c, 0x65, 0x22, 0x3a,
-					0x20, 0x22, 0x7b, 0x7b, 0x20, 0x2e, 0x4d, 0x6f, 0x64, 0x75, 0x6c, 0x65,
-					0x20, 0x7d, 0x7d, 0x22, 0x2c, 0x0a, 0x09, 0x22, 0x73, 0x65, 0x72, 0x76,
-					0x69, 0x63, 0x65, 0x73, 0x22, 0x3a, 0x20, 0x5b, 0x5d, 0x0a, 0x7d,
+					0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x20, 0x3d, 0x20, 0x31, 0x0a,
+					0x77, 0x61, 0x74, 0x63, 0x68, 0x5f, 0x65, 0x78, 0x74, 0x65, 0x6e, 0x73,
+					0x69, 0x6f, 0x6e, 0x73, 0x20, 0x3d, 0x20, 0x5b, 0x5d, 0x0a, 0x0a, 0x5b,
+					0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x5d, 0x0a,
 				},
 				fi: FileInfo{
 					name:    "gs.jet",
-					size:    47,
-					modTime: time.Unix(0, 1587908587928347568),
+					size:    46,
+					modTime: time.Unix(0, 1792308664413159089),
 					isDir:   false,
 				},
 			}, "/assets/service": {
@@ -318,16 +443,23 @@ func init() {
 					0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x22, 0x7b, 0x7b, 0x20,
 					0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x20, 0x7d, 0x7d, 0x22, 0x0a,
 					0x09, 0x22, 0x7b, 0x7b, 0x20, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
-					0x20, 0x7d, 0x7d, 0x2f, 0x67, 0x65, 0x6e, 0x22, 0x0a, 0x29, 0x0a, 0x0a,
-					0x66, 0x75, 0x6e, 0x63, 0x20, 0x6d, 0x61, 0x69, 0x6e, 0x28, 0x29, 0x20,
-					0x7b, 0x0a, 0x09, 0x67, 0x65, 0x6e, 0x2e, 0x4e, 0x65, 0x77, 0x28, 0x73,
-					0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4e, 0x65, 0x77, 0x28, 0x29,
-					0x29, 0x2e, 0x52, 0x75, 0x6e, 0x28, 0x29, 0x0a, 0x7d, 0x0a,
+					0x20, 0x7d, 0x7d, 0x2f, 0x67, 0x65, 0x6e, 0x22, 0x0a, 0x09, 0x22, 0x66,
+					0x6c, 0x61, 0x67, 0x22, 0x0a, 0x29, 0x0a, 0x0a, 0x2f, 0x2f, 0x20, 0x74,
+					0x68, 0x65, 0x20, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x20, 0x66,
+					0x6c, 0x61, 0x67, 0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x72, 0x65, 0x67,
+					0x69, 0x73, 0x74, 0x65, 0x72, 0x65, 0x64, 0x20, 0x62, 0x79, 0x20, 0x74,
+					0x68, 0x65, 0x20, 0x67, 0x65, 0x6e, 0x20, 0x70, 0x61, 0x63, 0x6b, 0x61,
+					0x67, 0x65, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x6d, 0x61, 0x69, 0x6e,
+					0x28, 0x29, 0x20, 0x7b, 0x0a, 0x09, 0x66, 0x6c, 0x61, 0x67, 0x2e, 0x50,
+					0x61, 0x72, 0x73, 0x65, 0x28, 0x29, 0x0a, 0x0a, 0x09, 0x67, 0x65, 0x6e,
+					0x2e, 0x4e, 0x65, 0x77, 0x28, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+					0x2e, 0x4e, 0x65, 0x77, 0x28, 0x29, 0x29, 0x2e, 0x52, 0x75, 0x6e, 0x28,
+					0x29, 0x0a, 0x7d, 0x0a,
 				},
 				fi: FileInfo{
 					name:    "main.jet",
-					size:    118,
-					modTime: time.Unix(0, 1587860644762042611),
+					size:    196,
+					modTime: time.Unix(0, 1792312517641663366),
 					isDir:   false,
 				},
 			}, "/assets/service/gen": {
@@ -338,6 +470,181 @@ func init() {
 					modTime: time.Unix(0, 1587862114481776454),
 					isDir:   true,
 				},
+			}, "/assets/service/gen/address.jet": {
+				data: []byte{
+					0x2f, 0x2f, 0x20, 0x43, 0x6f, 0x64, 0x65, 0x20, 0x67, 0x65, 0x6e, 0x65,
+					0x72, 0x61, 0x74, 0x65, 0x64, 0x20, 0x62, 0x79, 0x20, 0x67, 0x73, 0x2e,
+					0x20, 0x44, 0x4f, 0x20, 0x4e, 0x4f, 0x54, 0x20, 0x45, 0x44, 0x49, 0x54,
+					0x0a, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x20, 0x67, 0x65, 0x6e,
+					0x0a, 0x0a, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x20, 0x28, 0x0a, 0x09,
+					0x22, 0x66, 0x6c, 0x61, 0x67, 0x22, 0x0a, 0x09, 0x22, 0x6f, 0x73, 0x22,
+					0x0a, 0x29, 0x0a, 0x0a, 0x2f, 0x2f, 0x20, 0x54, 0x68, 0x65, 0x20, 0x61,
+					0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x20, 0x61, 0x72, 0x65,
+					0x20, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64, 0x20, 0x69, 0x6e,
+					0x20, 0x74, 0x68, 0x69, 0x73, 0x20, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x3a,
+					0x20, 0x74, 0x68, 0x65, 0x20, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+					0x20, 0x67, 0x69, 0x76, 0x65, 0x6e, 0x20, 0x74, 0x6f, 0x20, 0x4e, 0x65,
+					0x77, 0x2c, 0x20, 0x74, 0x68, 0x65, 0x20, 0x65, 0x6e, 0x76, 0x69, 0x72,
+					0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x0a, 0x2f, 0x2f, 0x20, 0x76, 0x61,
+					0x72, 0x69, 0x61, 0x62, 0x6c, 0x65, 0x73, 0x2c, 0x20, 0x74, 0x68, 0x65,
+					0x20, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x20, 0x6c, 0x69, 0x6e,
+					0x65, 0x20, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x20, 0x61, 0x6e, 0x64, 0x20,
+					0x6c, 0x61, 0x73, 0x74, 0x20, 0x74, 0x68, 0x65, 0x20, 0x61, 0x64, 0x64,
+					0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x20, 0x69, 0x6e, 0x20, 0x67, 0x73,
+					0x2e, 0x74, 0x6f, 0x6d, 0x6c, 0x2e, 0x0a, 0x63, 0x6f, 0x6e, 0x73, 0x74,
+					0x20, 0x28, 0x0a, 0x09, 0x44, 0x65, 0x62, 0x75, 0x67, 0x41, 0x64, 0x64,
+					0x72, 0x65, 0x73, 0x73, 0x45, 0x6e, 0x76, 0x20, 0x3d, 0x20, 0x22, 0x7b,
+					0x7b, 0x20, 0x2e, 0x45, 0x6e, 0x76, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78,
+					0x28, 0x29, 0x20, 0x7d, 0x7d, 0x5f, 0x44, 0x45, 0x42, 0x55, 0x47, 0x5f,
+					0x41, 0x44, 0x44, 0x52, 0x22, 0x7b, 0x7b, 0x69, 0x66, 0x20, 0x2e, 0x48,
+					0x61, 0x73, 0x48, 0x74, 0x74, 0x70, 0x28, 0x29, 0x7d, 0x7d, 0x0a, 0x09,
+					0x48, 0x74, 0x74, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x45,
+					0x6e, 0x76, 0x20, 0x20, 0x3d, 0x20, 0x22, 0x7b, 0x7b, 0x20, 0x2e, 0x45,
+					0x6e, 0x76, 0x50, 0x72, 0x65, 0x66, 0x69, 0x78, 0x28, 0x29, 0x20, 0x7d,
+					0x7d, 0x5f, 0x48, 0x54, 0x54, 0x50, 0x5f, 0x41, 0x44, 0x44, 0x52, 0x22,
+					0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x7b, 0x7b, 0x69, 0x66, 0x20,
+					0x2e, 0x47, 0x52, 0x50, 0x43, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f,
+					0x72, 0x74, 0x7d, 0x7d, 0x0a, 0x09, 0x47, 0x72, 0x70, 0x63, 0x41, 0x64,
+					0x64, 0x72, 0x65, 0x73, 0x73, 0x45, 0x6e, 0x76, 0x20, 0x20, 0x3d, 0x20,
+					0x22, 0x7b, 0x7b, 0x20, 0x2e, 0x45, 0x6e, 0x76, 0x50, 0x72, 0x65, 0x66,
+					0x69, 0x78, 0x28, 0x29, 0x20, 0x7d, 0x7d, 0x5f, 0x47, 0x52, 0x50, 0x43,
+					0x5f, 0x41, 0x44, 0x44, 0x52, 0x22, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d,
+					0x7d, 0x0a, 0x29, 0x0a, 0x0a, 0x63, 0x6f, 0x6e, 0x73, 0x74, 0x20, 0x28,
+					0x0a, 0x09, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x44, 0x65, 0x62,
+					0x75, 0x67, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x20, 0x3d, 0x20,
+					0x22, 0x7b, 0x7b, 0x20, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
+					0x44, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x55, 0x72, 0x6c, 0x20, 0x7d, 0x7d,
+					0x3a, 0x7b, 0x7b, 0x20, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e,
+					0x44, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x20, 0x7d,
+					0x7d, 0x22, 0x7b, 0x7b, 0x69, 0x66, 0x20, 0x2e, 0x48, 0x61, 0x73, 0x48,
+					0x74, 0x74, 0x70, 0x28, 0x29, 0x7d, 0x7d, 0x0a, 0x09, 0x64, 0x65, 0x66,
+					0x61, 0x75, 0x6c, 0x74, 0x48, 0x74, 0x74, 0x70, 0x41, 0x64, 0x64, 0x72,
+					0x65, 0x73, 0x73, 0x20, 0x20, 0x3d, 0x20, 0x22, 0x7b, 0x7b, 0x20, 0x2e,
+					0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x2e,
+					0x55, 0x72, 0x6c, 0x20, 0x7d, 0x7d, 0x3a, 0x7b, 0x7b, 0x20, 0x2e, 0x43,
+					0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x2e, 0x50,
+					0x6f, 0x72, 0x74, 0x20, 0x7d, 0x7d, 0x22, 0x7b, 0x7b, 0x65, 0x6e, 0x64,
+					0x7d, 0x7d, 0x7b, 0x7b, 0x69, 0x66, 0x20, 0x2e, 0x47, 0x52, 0x50, 0x43,
+					0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x7d, 0x7d, 0x0a,
+					0x09, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x47, 0x72, 0x70, 0x63,
+					0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x20, 0x20, 0x3d, 0x20, 0x22,
+					0x7b, 0x7b, 0x20, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x47,
+					0x72, 0x70, 0x63, 0x2e, 0x55, 0x72, 0x6c, 0x20, 0x7d, 0x7d, 0x3a, 0x7b,
+					0x7b, 0x20, 0x2e, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x67, 0x2e, 0x47, 0x72,
+					0x70, 0x63, 0x2e, 0x50, 0x6f, 0x72, 0x74, 0x20, 0x7d, 0x7d, 0x22, 0x7b,
+					0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x0a, 0x29, 0x0a, 0x0a, 0x2f, 0x2f,
+					0x20, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x46, 0x6c, 0x61, 0x67,
+					0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x74, 0x68, 0x65, 0x20, 0x61, 0x64,
+					0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x20, 0x67, 0x69, 0x76, 0x65,
+					0x6e, 0x20, 0x6f, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x6f, 0x6d,
+					0x6d, 0x61, 0x6e, 0x64, 0x20, 0x6c, 0x69, 0x6e, 0x65, 0x2e, 0x0a, 0x74,
+					0x79, 0x70, 0x65, 0x20, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x46,
+					0x6c, 0x61, 0x67, 0x73, 0x20, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x20,
+					0x7b, 0x0a, 0x09, 0x44, 0x65, 0x62, 0x75, 0x67, 0x20, 0x73, 0x74, 0x72,
+					0x69, 0x6e, 0x67, 0x7b, 0x7b, 0x69, 0x66, 0x20, 0x2e, 0x48, 0x61, 0x73,
+					0x48, 0x74, 0x74, 0x70, 0x28, 0x29, 0x7d, 0x7d, 0x0a, 0x09, 0x48, 0x74,
+					0x74, 0x70, 0x20, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x7b, 0x7b,
+					0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x7b, 0x7b, 0x69, 0x66, 0x20, 0x2e, 0x47,
+					0x52, 0x50, 0x43, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74,
+					0x7d, 0x7d, 0x0a, 0x09, 0x47, 0x72, 0x70, 0x63, 0x20, 0x20, 0x73, 0x74,
+					0x72, 0x69, 0x6e, 0x67, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x0a,
+					0x7d, 0x0a, 0x0a, 0x2f, 0x2f, 0x20, 0x74, 0x68, 0x65, 0x20, 0x61, 0x64,
+					0x64, 0x72, 0x65, 0x73, 0x73, 0x20, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x20,
+					0x61, 0x72, 0x65, 0x20, 0x72, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72,
+					0x65, 0x64, 0x20, 0x6f, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x6f,
+					0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x20, 0x6c, 0x69, 0x6e, 0x65, 0x20, 0x62,
+					0x79, 0x20, 0x74, 0x68, 0x65, 0x20, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61,
+					0x74, 0x65, 0x64, 0x20, 0x63, 0x6f, 0x64, 0x65, 0x20, 0x73, 0x6f, 0x20,
+					0x74, 0x68, 0x65, 0x0a, 0x2f, 0x2f, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69,
+					0x63, 0x65, 0x73, 0x20, 0x6b, 0x65, 0x65, 0x70, 0x20, 0x67, 0x65, 0x74,
+					0x74, 0x69, 0x6e, 0x67, 0x20, 0x74, 0x68, 0x65, 0x6d, 0x20, 0x77, 0x68,
+					0x65, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x66, 0x6c, 0x61, 0x67, 0x73,
+					0x20, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x2c, 0x20, 0x63, 0x6d, 0x64,
+					0x2f, 0x6d, 0x61, 0x69, 0x6e, 0x2e, 0x67, 0x6f, 0x20, 0x69, 0x73, 0x20,
+					0x6f, 0x6e, 0x6c, 0x79, 0x20, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74,
+					0x65, 0x64, 0x20, 0x6f, 0x6e, 0x63, 0x65, 0x2e, 0x0a, 0x76, 0x61, 0x72,
+					0x20, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x4c, 0x69, 0x6e, 0x65,
+					0x46, 0x6c, 0x61, 0x67, 0x73, 0x20, 0x3d, 0x20, 0x52, 0x65, 0x67, 0x69,
+					0x73, 0x74, 0x65, 0x72, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x28, 0x66, 0x6c,
+					0x61, 0x67, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x4c, 0x69,
+					0x6e, 0x65, 0x29, 0x0a, 0x0a, 0x2f, 0x2f, 0x20, 0x52, 0x65, 0x67, 0x69,
+					0x73, 0x74, 0x65, 0x72, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x20, 0x72, 0x65,
+					0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20,
+					0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x20, 0x66, 0x6c, 0x61, 0x67,
+					0x73, 0x20, 0x6f, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x66, 0x6c, 0x61,
+					0x67, 0x20, 0x73, 0x65, 0x74, 0x2e, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20,
+					0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x46, 0x6c, 0x61, 0x67,
+					0x73, 0x28, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x20, 0x2a, 0x66, 0x6c, 0x61,
+					0x67, 0x2e, 0x46, 0x6c, 0x61, 0x67, 0x53, 0x65, 0x74, 0x29, 0x20, 0x2a,
+					0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x46, 0x6c, 0x61, 0x67, 0x73,
+					0x20, 0x7b, 0x0a, 0x09, 0x76, 0x61, 0x72, 0x20, 0x61, 0x64, 0x64, 0x72,
+					0x65, 0x73, 0x73, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x20, 0x41, 0x64, 0x64,
+					0x72, 0x65, 0x73, 0x73, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x0a, 0x09, 0x66,
+					0x6c, 0x61, 0x67, 0x73, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56,
+					0x61, 0x72, 0x28, 0x26, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x46,
+					0x6c, 0x61, 0x67, 0x73, 0x2e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x2c, 0x20,
+					0x22, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2d, 0x61, 0x64, 0x64, 0x72, 0x22,
+					0x2c, 0x20, 0x22, 0x22, 0x2c, 0x20, 0x22, 0x74, 0x68, 0x65, 0x20, 0x61,
+					0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68,
+					0x65, 0x20, 0x64, 0x65, 0x62, 0x75, 0x67, 0x20, 0x6c, 0x69, 0x73, 0x74,
+					0x65, 0x6e, 0x65, 0x72, 0x2c, 0x20, 0x75, 0x73, 0x65, 0x64, 0x20, 0x69,
+					0x66, 0x20, 0x24, 0x22, 0x2b, 0x44, 0x65, 0x62, 0x75, 0x67, 0x41, 0x64,
+					0x64, 0x72, 0x65, 0x73, 0x73, 0x45, 0x6e, 0x76, 0x2b, 0x22, 0x20, 0x69,
+					0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x73, 0x65, 0x74, 0x22, 0x29, 0x7b,
+					0x7b, 0x69, 0x66, 0x20, 0x2e, 0x48, 0x61, 0x73, 0x48, 0x74, 0x74, 0x70,
+					0x28, 0x29, 0x7d, 0x7d, 0x0a, 0x09, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x2e,
+					0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x72, 0x28, 0x26, 0x61,
+					0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x2e,
+					0x48, 0x74, 0x74, 0x70, 0x2c, 0x20, 0x22, 0x68, 0x74, 0x74, 0x70, 0x2d,
+					0x61, 0x64, 0x64, 0x72, 0x22, 0x2c, 0x20, 0x22, 0x22, 0x2c, 0x20, 0x22,
+					0x74, 0x68, 0x65, 0x20, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x20,
+					0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x68, 0x74, 0x74, 0x70, 0x20,
+					0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2c, 0x20, 0x75,
+					0x73, 0x65, 0x64, 0x20, 0x69, 0x66, 0x20, 0x24, 0x22, 0x2b, 0x48, 0x74,
+					0x74, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x45, 0x6e, 0x76,
+					0x2b, 0x22, 0x20, 0x69, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x73, 0x65,
+					0x74, 0x22, 0x29, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x7b, 0x7b,
+					0x69, 0x66, 0x20, 0x2e, 0x47, 0x52, 0x50, 0x43, 0x54, 0x72, 0x61, 0x6e,
+					0x73, 0x70, 0x6f, 0x72, 0x74, 0x7d, 0x7d, 0x0a, 0x09, 0x66, 0x6c, 0x61,
+					0x67, 0x73, 0x2e, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x56, 0x61, 0x72,
+					0x28, 0x26, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x46, 0x6c, 0x61,
+					0x67, 0x73, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x2c, 0x20, 0x22, 0x67, 0x72,
+					0x70, 0x63, 0x2d, 0x61, 0x64, 0x64, 0x72, 0x22, 0x2c, 0x20, 0x22, 0x22,
+					0x2c, 0x20, 0x22, 0x74, 0x68, 0x65, 0x20, 0x61, 0x64, 0x64, 0x72, 0x65,
+					0x73, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x67, 0x72,
+					0x70, 0x63, 0x20, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74,
+					0x2c, 0x20, 0x75, 0x73, 0x65, 0x64, 0x20, 0x69, 0x66, 0x20, 0x24, 0x22,
+					0x2b, 0x47, 0x72, 0x70, 0x63, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+					0x45, 0x6e, 0x76, 0x2b, 0x22, 0x20, 0x69, 0x73, 0x20, 0x6e, 0x6f, 0x74,
+					0x20, 0x73, 0x65, 0x74, 0x22, 0x29, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d,
+					0x7d, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x26, 0x61,
+					0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x0a,
+					0x7d, 0x0a, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x72, 0x65, 0x73, 0x6f,
+					0x6c, 0x76, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x28, 0x65,
+					0x6e, 0x76, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x2c, 0x20, 0x66,
+					0x6c, 0x61, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x73, 0x74, 0x72,
+					0x69, 0x6e, 0x67, 0x2c, 0x20, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
+					0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x20, 0x73, 0x74, 0x72, 0x69,
+					0x6e, 0x67, 0x29, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x20, 0x7b,
+					0x0a, 0x09, 0x69, 0x66, 0x20, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+					0x20, 0x3a, 0x3d, 0x20, 0x6f, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x65, 0x6e,
+					0x76, 0x28, 0x65, 0x6e, 0x76, 0x29, 0x3b, 0x20, 0x61, 0x64, 0x64, 0x72,
+					0x65, 0x73, 0x73, 0x20, 0x21, 0x3d, 0x20, 0x22, 0x22, 0x20, 0x7b, 0x0a,
+					0x09, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x61, 0x64, 0x64,
+					0x72, 0x65, 0x73, 0x73, 0x0a, 0x09, 0x7d, 0x0a, 0x09, 0x69, 0x66, 0x20,
+					0x66, 0x6c, 0x61, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x21, 0x3d,
+					0x20, 0x22, 0x22, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x72, 0x65, 0x74, 0x75,
+					0x72, 0x6e, 0x20, 0x66, 0x6c, 0x61, 0x67, 0x56, 0x61, 0x6c, 0x75, 0x65,
+					0x0a, 0x09, 0x7d, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20,
+					0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65,
+					0x73, 0x73, 0x0a, 0x7d, 0x0a,
+				},
+				fi: FileInfo{
+					name:    "address.jet",
+					size:    1985,
+					modTime: time.Unix(0, 1792312517640064128),
+					isDir:   false,
+				},
 			}, "/assets/service/gen/endpoint": {
 				data: []byte{},
 				fi: FileInfo{
@@ -797,56 +1104,139 @@ func init() {
 					0x0a, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x20, 0x65, 0x72, 0x72,
 					0x6f, 0x72, 0x73, 0x0a, 0x0a, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x20,
 					0x28, 0x0a, 0x09, 0x22, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67,
-					0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x22, 0x0a, 0x29, 0x0a, 0x0a, 0x74, 0x79,
-					0x70, 0x65, 0x20, 0x4d, 0x61, 0x72, 0x73, 0x68, 0x61, 0x6c, 0x65, 0x72,
-					0x20, 0x66, 0x75, 0x6e, 0x63, 0x28, 0x65, 0x72, 0x72, 0x20, 0x65, 0x72,
-					0x72, 0x6f, 0x72, 0x29, 0x20, 0x4a, 0x73, 0x6f, 0x6e, 0x45, 0x72, 0x72,
-					0x6f, 0x72, 0x0a, 0x0a, 0x74, 0x79, 0x70, 0x65, 0x20, 0x4a, 0x73, 0x6f,
-					0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x69, 0x6e, 0x74, 0x65, 0x72,
-					0x66, 0x61, 0x63, 0x65, 0x20, 0x7b, 0x0a, 0x09, 0x65, 0x72, 0x72, 0x6f,
-					0x72, 0x0a, 0x09, 0x6a, 0x73, 0x6f, 0x6e, 0x2e, 0x4d, 0x61, 0x72, 0x73,
-					0x68, 0x61, 0x6c, 0x65, 0x72, 0x0a, 0x7d, 0x0a, 0x0a, 0x74, 0x79, 0x70,
-					0x65, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4a, 0x73, 0x6f, 0x6e, 0x20,
-					0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x20, 0x7b, 0x0a, 0x09, 0x65, 0x72,
-					0x72, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x0a, 0x7d, 0x0a, 0x0a, 0x66,
-					0x75, 0x6e, 0x63, 0x20, 0x28, 0x65, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72,
-					0x4a, 0x73, 0x6f, 0x6e, 0x29, 0x20, 0x4d, 0x61, 0x72, 0x73, 0x68, 0x61,
-					0x6c, 0x4a, 0x53, 0x4f, 0x4e, 0x28, 0x29, 0x20, 0x28, 0x5b, 0x5d, 0x62,
-					0x79, 0x74, 0x65, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x29, 0x20,
-					0x7b, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x6a, 0x73,
-					0x6f, 0x6e, 0x2e, 0x4d, 0x61, 0x72, 0x73, 0x68, 0x61, 0x6c, 0x28, 0x73,
-					0x74, 0x72, 0x75, 0x63, 0x74, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x4d, 0x65,
-					0x73, 0x73, 0x61, 0x67, 0x65, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
+					0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x22, 0x0a, 0x09, 0x22, 0x65, 0x72, 0x72,
+					0x6f, 0x72, 0x73, 0x22, 0x0a, 0x09, 0x22, 0x66, 0x6d, 0x74, 0x22, 0x0a,
+					0x09, 0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x0a, 0x29,
+					0x0a, 0x0a, 0x74, 0x79, 0x70, 0x65, 0x20, 0x4d, 0x61, 0x72, 0x73, 0x68,
+					0x61, 0x6c, 0x65, 0x72, 0x20, 0x66, 0x75, 0x6e, 0x63, 0x28, 0x65, 0x72,
+					0x72, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x29, 0x20, 0x4a, 0x73, 0x6f,
+					0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x0a, 0x0a, 0x74, 0x79, 0x70, 0x65,
+					0x20, 0x4a, 0x73, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x69,
+					0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x20, 0x7b, 0x0a, 0x09,
+					0x65, 0x72, 0x72, 0x6f, 0x72, 0x0a, 0x09, 0x6a, 0x73, 0x6f, 0x6e, 0x2e,
+					0x4d, 0x61, 0x72, 0x73, 0x68, 0x61, 0x6c, 0x65, 0x72, 0x0a, 0x7d, 0x0a,
+					0x0a, 0x74, 0x79, 0x70, 0x65, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4a,
+					0x73, 0x6f, 0x6e, 0x20, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x20, 0x7b,
+					0x0a, 0x09, 0x65, 0x72, 0x72, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x0a,
+					0x7d, 0x0a, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x28, 0x65, 0x20, 0x65,
+					0x72, 0x72, 0x6f, 0x72, 0x4a, 0x73, 0x6f, 0x6e, 0x29, 0x20, 0x4d, 0x61,
+					0x72, 0x73, 0x68, 0x61, 0x6c, 0x4a, 0x53, 0x4f, 0x4e, 0x28, 0x29, 0x20,
+					0x28, 0x5b, 0x5d, 0x62, 0x79, 0x74, 0x65, 0x2c, 0x20, 0x65, 0x72, 0x72,
+					0x6f, 0x72, 0x29, 0x20, 0x7b, 0x0a, 0x09, 0x76, 0x61, 0x72, 0x20, 0x66,
+					0x69, 0x65, 0x6c, 0x64, 0x73, 0x20, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45,
+					0x72, 0x72, 0x6f, 0x72, 0x73, 0x0a, 0x09, 0x65, 0x72, 0x72, 0x6f, 0x72,
+					0x73, 0x2e, 0x41, 0x73, 0x28, 0x65, 0x2e, 0x65, 0x72, 0x72, 0x2c, 0x20,
+					0x26, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x29, 0x0a, 0x09, 0x72, 0x65,
+					0x74, 0x75, 0x72, 0x6e, 0x20, 0x6a, 0x73, 0x6f, 0x6e, 0x2e, 0x4d, 0x61,
+					0x72, 0x73, 0x68, 0x61, 0x6c, 0x28, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74,
+					0x20, 0x7b, 0x0a, 0x09, 0x09, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+					0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x20, 0x20, 0x20, 0x20, 0x20,
 					0x20, 0x60, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6d, 0x65, 0x73, 0x73,
-					0x61, 0x67, 0x65, 0x22, 0x60, 0x0a, 0x09, 0x7d, 0x7b, 0x0a, 0x09, 0x09,
+					0x61, 0x67, 0x65, 0x22, 0x60, 0x0a, 0x09, 0x09, 0x46, 0x69, 0x65, 0x6c,
+					0x64, 0x73, 0x20, 0x20, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x72, 0x72,
+					0x6f, 0x72, 0x73, 0x20, 0x60, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x66,
+					0x69, 0x65, 0x6c, 0x64, 0x73, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65, 0x6d,
+					0x70, 0x74, 0x79, 0x22, 0x60, 0x0a, 0x09, 0x7d, 0x7b, 0x0a, 0x09, 0x09,
 					0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x3a, 0x20, 0x65, 0x2e, 0x45,
-					0x72, 0x72, 0x6f, 0x72, 0x28, 0x29, 0x2c, 0x0a, 0x09, 0x7d, 0x29, 0x0a,
-					0x7d, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x28, 0x65, 0x20, 0x65, 0x72,
-					0x72, 0x6f, 0x72, 0x4a, 0x73, 0x6f, 0x6e, 0x29, 0x20, 0x45, 0x72, 0x72,
-					0x6f, 0x72, 0x28, 0x29, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x20,
-					0x7b, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x65, 0x2e,
+					0x72, 0x72, 0x6f, 0x72, 0x28, 0x29, 0x2c, 0x0a, 0x09, 0x09, 0x46, 0x69,
+					0x65, 0x6c, 0x64, 0x73, 0x3a, 0x20, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64,
+					0x73, 0x2c, 0x0a, 0x09, 0x7d, 0x29, 0x0a, 0x7d, 0x0a, 0x66, 0x75, 0x6e,
+					0x63, 0x20, 0x28, 0x65, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x4a, 0x73,
+					0x6f, 0x6e, 0x29, 0x20, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x28, 0x29, 0x20,
+					0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x20, 0x7b, 0x0a, 0x09, 0x72, 0x65,
+					0x74, 0x75, 0x72, 0x6e, 0x20, 0x65, 0x2e, 0x65, 0x72, 0x72, 0x2e, 0x45,
+					0x72, 0x72, 0x6f, 0x72, 0x28, 0x29, 0x0a, 0x7d, 0x0a, 0x0a, 0x2f, 0x2f,
+					0x20, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x20,
+					0x69, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72,
+					0x20, 0x6f, 0x66, 0x20, 0x61, 0x20, 0x73, 0x69, 0x6e, 0x67, 0x6c, 0x65,
+					0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x70, 0x61, 0x72,
+					0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x2e, 0x0a, 0x74, 0x79, 0x70, 0x65,
+					0x20, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x20,
+					0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x20, 0x7b, 0x0a, 0x09, 0x2f, 0x2f,
+					0x20, 0x4e, 0x61, 0x6d, 0x65, 0x20, 0x69, 0x73, 0x20, 0x74, 0x68, 0x65,
+					0x20, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65,
+					0x20, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x2c, 0x20,
+					0x69, 0x74, 0x20, 0x69, 0x73, 0x20, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x20,
+					0x66, 0x6f, 0x72, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x20, 0x6f,
+					0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x77, 0x68, 0x6f, 0x6c, 0x65, 0x20,
+					0x62, 0x6f, 0x64, 0x79, 0x2e, 0x0a, 0x09, 0x4e, 0x61, 0x6d, 0x65, 0x20,
+					0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x20, 0x60, 0x6a, 0x73, 0x6f, 0x6e,
+					0x3a, 0x22, 0x6e, 0x61, 0x6d, 0x65, 0x2c, 0x6f, 0x6d, 0x69, 0x74, 0x65,
+					0x6d, 0x70, 0x74, 0x79, 0x22, 0x60, 0x0a, 0x09, 0x2f, 0x2f, 0x20, 0x4c,
+					0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x69, 0x73, 0x20, 0x77,
+					0x68, 0x65, 0x72, 0x65, 0x20, 0x74, 0x68, 0x65, 0x20, 0x70, 0x61, 0x72,
+					0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x20, 0x69, 0x73, 0x20, 0x72, 0x65,
+					0x61, 0x64, 0x20, 0x66, 0x72, 0x6f, 0x6d, 0x20, 0x28, 0x75, 0x72, 0x6c,
+					0x2c, 0x20, 0x71, 0x75, 0x65, 0x72, 0x79, 0x2c, 0x20, 0x68, 0x65, 0x61,
+					0x64, 0x65, 0x72, 0x2c, 0x20, 0x63, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x20,
+					0x6f, 0x72, 0x20, 0x62, 0x6f, 0x64, 0x79, 0x29, 0x2e, 0x0a, 0x09, 0x4c,
+					0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x73, 0x74, 0x72, 0x69,
+					0x6e, 0x67, 0x20, 0x60, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x6c, 0x6f,
+					0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x60, 0x0a, 0x09, 0x52, 0x65,
+					0x61, 0x73, 0x6f, 0x6e, 0x20, 0x20, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e,
+					0x67, 0x20, 0x60, 0x6a, 0x73, 0x6f, 0x6e, 0x3a, 0x22, 0x72, 0x65, 0x61,
+					0x73, 0x6f, 0x6e, 0x22, 0x60, 0x0a, 0x7d, 0x0a, 0x0a, 0x66, 0x75, 0x6e,
+					0x63, 0x20, 0x28, 0x65, 0x20, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x72,
+					0x72, 0x6f, 0x72, 0x29, 0x20, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x28, 0x29,
+					0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x20, 0x7b, 0x0a, 0x09, 0x69,
+					0x66, 0x20, 0x65, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x20, 0x3d, 0x3d, 0x20,
+					0x22, 0x22, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72,
+					0x6e, 0x20, 0x66, 0x6d, 0x74, 0x2e, 0x53, 0x70, 0x72, 0x69, 0x6e, 0x74,
+					0x66, 0x28, 0x22, 0x25, 0x73, 0x3a, 0x20, 0x25, 0x73, 0x22, 0x2c, 0x20,
+					0x65, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2c, 0x20,
+					0x65, 0x2e, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x29, 0x0a, 0x09, 0x7d,
+					0x0a, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x66, 0x6d, 0x74,
+					0x2e, 0x53, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x66, 0x28, 0x22, 0x25, 0x73,
+					0x20, 0x60, 0x25, 0x73, 0x60, 0x3a, 0x20, 0x25, 0x73, 0x22, 0x2c, 0x20,
+					0x65, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2c, 0x20,
+					0x65, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x2c, 0x20, 0x65, 0x2e, 0x52, 0x65,
+					0x61, 0x73, 0x6f, 0x6e, 0x29, 0x0a, 0x7d, 0x0a, 0x0a, 0x2f, 0x2f, 0x20,
+					0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x20,
+					0x61, 0x72, 0x65, 0x20, 0x74, 0x68, 0x65, 0x20, 0x65, 0x72, 0x72, 0x6f,
+					0x72, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x61, 0x6c, 0x6c, 0x20, 0x74, 0x68,
+					0x65, 0x20, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6e, 0x67, 0x20, 0x6f, 0x72,
+					0x20, 0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x20, 0x70, 0x61, 0x72,
+					0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x61,
+					0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x0a, 0x74, 0x79,
+					0x70, 0x65, 0x20, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x72, 0x72, 0x6f,
+					0x72, 0x73, 0x20, 0x5b, 0x5d, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x72,
+					0x72, 0x6f, 0x72, 0x0a, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x28, 0x65,
+					0x20, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73,
+					0x29, 0x20, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x28, 0x29, 0x20, 0x73, 0x74,
+					0x72, 0x69, 0x6e, 0x67, 0x20, 0x7b, 0x0a, 0x09, 0x6d, 0x65, 0x73, 0x73,
+					0x61, 0x67, 0x65, 0x73, 0x20, 0x3a, 0x3d, 0x20, 0x6d, 0x61, 0x6b, 0x65,
+					0x28, 0x5b, 0x5d, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x2c, 0x20, 0x6c,
+					0x65, 0x6e, 0x28, 0x65, 0x29, 0x29, 0x0a, 0x09, 0x66, 0x6f, 0x72, 0x20,
+					0x69, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x20, 0x3a, 0x3d, 0x20, 0x72, 0x61,
+					0x6e, 0x67, 0x65, 0x20, 0x65, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x6d, 0x65,
+					0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x5b, 0x69, 0x5d, 0x20, 0x3d, 0x20,
 					0x65, 0x72, 0x72, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x28, 0x29, 0x0a,
-					0x7d, 0x0a, 0x0a, 0x76, 0x61, 0x72, 0x20, 0x64, 0x65, 0x66, 0x61, 0x75,
-					0x6c, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x61, 0x72, 0x73, 0x68,
-					0x61, 0x6c, 0x65, 0x72, 0x20, 0x3d, 0x20, 0x66, 0x75, 0x6e, 0x63, 0x28,
-					0x65, 0x72, 0x72, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x29, 0x20, 0x4a,
-					0x73, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x7b, 0x0a, 0x09,
-					0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x26, 0x65, 0x72, 0x72, 0x6f,
-					0x72, 0x4a, 0x73, 0x6f, 0x6e, 0x7b, 0x0a, 0x09, 0x09, 0x65, 0x72, 0x72,
-					0x3a, 0x20, 0x65, 0x72, 0x72, 0x2c, 0x0a, 0x09, 0x7d, 0x0a, 0x7d, 0x0a,
-					0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x53, 0x65, 0x74, 0x44, 0x65, 0x66,
+					0x09, 0x7d, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x22,
+					0x69, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x20, 0x72, 0x65, 0x71, 0x75,
+					0x65, 0x73, 0x74, 0x3a, 0x20, 0x22, 0x20, 0x2b, 0x20, 0x73, 0x74, 0x72,
+					0x69, 0x6e, 0x67, 0x73, 0x2e, 0x4a, 0x6f, 0x69, 0x6e, 0x28, 0x6d, 0x65,
+					0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x2c, 0x20, 0x22, 0x2c, 0x20, 0x22,
+					0x29, 0x0a, 0x7d, 0x0a, 0x0a, 0x76, 0x61, 0x72, 0x20, 0x64, 0x65, 0x66,
 					0x61, 0x75, 0x6c, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x61, 0x72,
-					0x73, 0x68, 0x61, 0x6c, 0x65, 0x72, 0x28, 0x6d, 0x61, 0x72, 0x73, 0x68,
-					0x61, 0x6c, 0x65, 0x72, 0x20, 0x4d, 0x61, 0x72, 0x73, 0x68, 0x61, 0x6c,
-					0x65, 0x72, 0x29, 0x20, 0x7b, 0x0a, 0x09, 0x64, 0x65, 0x66, 0x61, 0x75,
-					0x6c, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x61, 0x72, 0x73, 0x68,
-					0x61, 0x6c, 0x65, 0x72, 0x20, 0x3d, 0x20, 0x6d, 0x61, 0x72, 0x73, 0x68,
-					0x61, 0x6c, 0x65, 0x72, 0x0a, 0x7d, 0x0a,
+					0x73, 0x68, 0x61, 0x6c, 0x65, 0x72, 0x20, 0x3d, 0x20, 0x66, 0x75, 0x6e,
+					0x63, 0x28, 0x65, 0x72, 0x72, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x29,
+					0x20, 0x4a, 0x73, 0x6f, 0x6e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x7b,
+					0x0a, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x26, 0x65, 0x72,
+					0x72, 0x6f, 0x72, 0x4a, 0x73, 0x6f, 0x6e, 0x7b, 0x0a, 0x09, 0x09, 0x65,
+					0x72, 0x72, 0x3a, 0x20, 0x65, 0x72, 0x72, 0x2c, 0x0a, 0x09, 0x7d, 0x0a,
+					0x7d, 0x0a, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x53, 0x65, 0x74, 0x44,
+					0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4d,
+					0x61, 0x72, 0x73, 0x68, 0x61, 0x6c, 0x65, 0x72, 0x28, 0x6d, 0x61, 0x72,
+					0x73, 0x68, 0x61, 0x6c, 0x65, 0x72, 0x20, 0x4d, 0x61, 0x72, 0x73, 0x68,
+					0x61, 0x6c, 0x65, 0x72, 0x29, 0x20, 0x7b, 0x0a, 0x09, 0x64, 0x65, 0x66,
+					0x61, 0x75, 0x6c, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4d, 0x61, 0x72,
+					0x73, 0x68, 0x61, 0x6c, 0x65, 0x72, 0x20, 0x3d, 0x20, 0x6d, 0x61, 0x72,
+					0x73, 0x68, 0x61, 0x6c, 0x65, 0x72, 0x0a, 0x7d, 0x0a,
 				},
 				fi: FileInfo{
 					name:    "errors.jet",
-					size:    607,
-					modTime: time.Unix(0, 1586906971923340132),
+					size:    1605,
+					modTime: time.Unix(0, 1792309978086411720),
 					isDir:   false,
 				},
 			}, "/assets/service/gen/errors/http.jet": {
@@ -929,36 +1319,57 @@ func init() {
 					0x72, 0x73, 0x68, 0x61, 0x6c, 0x65, 0x72, 0x28, 0x65, 0x72, 0x72, 0x6f,
 					0x72, 0x73, 0x2e, 0x4e, 0x65, 0x77, 0x28, 0x65, 0x72, 0x72, 0x29, 0x29,
 					0x2c, 0x0a, 0x09, 0x09, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x3a, 0x20,
-					0x34, 0x30, 0x30, 0x2c, 0x0a, 0x09, 0x7d, 0x0a, 0x7d, 0x0a, 0x0a, 0x66,
-					0x75, 0x6e, 0x63, 0x20, 0x28, 0x65, 0x20, 0x68, 0x74, 0x74, 0x70, 0x45,
-					0x72, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x29, 0x20,
-					0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f, 0x64, 0x65, 0x28, 0x29,
-					0x20, 0x69, 0x6e, 0x74, 0x20, 0x7b, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x75,
-					0x72, 0x6e, 0x20, 0x65, 0x2e, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x0a,
+					0x34, 0x30, 0x30, 0x2c, 0x0a, 0x09, 0x7d, 0x0a, 0x7d, 0x0a, 0x0a, 0x2f,
+					0x2f, 0x20, 0x48, 0x54, 0x54, 0x50, 0x42, 0x61, 0x64, 0x52, 0x65, 0x71,
+					0x75, 0x65, 0x73, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x20, 0x72,
+					0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x61, 0x20, 0x62, 0x61, 0x64,
+					0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x65, 0x72, 0x72,
+					0x6f, 0x72, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x74, 0x68, 0x65, 0x20,
+					0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68,
+					0x65, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x70, 0x61,
+					0x72, 0x61, 0x6d, 0x65, 0x74, 0x65, 0x72, 0x73, 0x2e, 0x0a, 0x66, 0x75,
+					0x6e, 0x63, 0x20, 0x48, 0x54, 0x54, 0x50, 0x42, 0x61, 0x64, 0x52, 0x65,
+					0x71, 0x75, 0x65, 0x73, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x28,
+					0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x20, 0x46, 0x69, 0x65, 0x6c, 0x64,
+					0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x29, 0x20, 0x48, 0x54, 0x54, 0x50,
+					0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x20, 0x7b, 0x0a, 0x09,
+					0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x26, 0x68, 0x74, 0x74, 0x70,
+					0x45, 0x72, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x7b,
+					0x0a, 0x09, 0x09, 0x65, 0x72, 0x72, 0x3a, 0x20, 0x20, 0x20, 0x20, 0x64,
+					0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x4d,
+					0x61, 0x72, 0x73, 0x68, 0x61, 0x6c, 0x65, 0x72, 0x28, 0x66, 0x69, 0x65,
+					0x6c, 0x64, 0x73, 0x29, 0x2c, 0x0a, 0x09, 0x09, 0x73, 0x74, 0x61, 0x74,
+					0x75, 0x73, 0x3a, 0x20, 0x34, 0x30, 0x30, 0x2c, 0x0a, 0x09, 0x7d, 0x0a,
 					0x7d, 0x0a, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x28, 0x65, 0x20, 0x68,
 					0x74, 0x74, 0x70, 0x45, 0x72, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-					0x73, 0x65, 0x29, 0x20, 0x48, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x28,
-					0x29, 0x20, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x48, 0x65, 0x61, 0x64, 0x65,
-					0x72, 0x20, 0x7b, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20,
-					0x65, 0x2e, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72, 0x73, 0x0a, 0x7d, 0x0a,
-					0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x28, 0x65, 0x20, 0x68, 0x74, 0x74,
-					0x70, 0x45, 0x72, 0x72, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-					0x29, 0x20, 0x4d, 0x61, 0x72, 0x73, 0x68, 0x61, 0x6c, 0x4a, 0x53, 0x4f,
-					0x4e, 0x28, 0x29, 0x20, 0x28, 0x5b, 0x5d, 0x62, 0x79, 0x74, 0x65, 0x2c,
-					0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x29, 0x20, 0x7b, 0x0a, 0x09, 0x72,
-					0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x65, 0x2e, 0x65, 0x72, 0x72, 0x2e,
-					0x4d, 0x61, 0x72, 0x73, 0x68, 0x61, 0x6c, 0x4a, 0x53, 0x4f, 0x4e, 0x28,
-					0x29, 0x0a, 0x7d, 0x0a, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x28, 0x65,
+					0x73, 0x65, 0x29, 0x20, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x43, 0x6f,
+					0x64, 0x65, 0x28, 0x29, 0x20, 0x69, 0x6e, 0x74, 0x20, 0x7b, 0x0a, 0x09,
+					0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x65, 0x2e, 0x73, 0x74, 0x61,
+					0x74, 0x75, 0x73, 0x0a, 0x7d, 0x0a, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20,
+					0x28, 0x65, 0x20, 0x68, 0x74, 0x74, 0x70, 0x45, 0x72, 0x72, 0x52, 0x65,
+					0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x29, 0x20, 0x48, 0x65, 0x61, 0x64,
+					0x65, 0x72, 0x73, 0x28, 0x29, 0x20, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x48,
+					0x65, 0x61, 0x64, 0x65, 0x72, 0x20, 0x7b, 0x0a, 0x09, 0x72, 0x65, 0x74,
+					0x75, 0x72, 0x6e, 0x20, 0x65, 0x2e, 0x68, 0x65, 0x61, 0x64, 0x65, 0x72,
+					0x73, 0x0a, 0x7d, 0x0a, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x28, 0x65,
 					0x20, 0x68, 0x74, 0x74, 0x70, 0x45, 0x72, 0x72, 0x52, 0x65, 0x73, 0x70,
-					0x6f, 0x6e, 0x73, 0x65, 0x29, 0x20, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x28,
-					0x29, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x20, 0x7b, 0x0a, 0x09,
-					0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x65, 0x2e, 0x65, 0x72, 0x72,
-					0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x28, 0x29, 0x0a, 0x7d, 0x0a,
+					0x6f, 0x6e, 0x73, 0x65, 0x29, 0x20, 0x4d, 0x61, 0x72, 0x73, 0x68, 0x61,
+					0x6c, 0x4a, 0x53, 0x4f, 0x4e, 0x28, 0x29, 0x20, 0x28, 0x5b, 0x5d, 0x62,
+					0x79, 0x74, 0x65, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x29, 0x20,
+					0x7b, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x65, 0x2e,
+					0x65, 0x72, 0x72, 0x2e, 0x4d, 0x61, 0x72, 0x73, 0x68, 0x61, 0x6c, 0x4a,
+					0x53, 0x4f, 0x4e, 0x28, 0x29, 0x0a, 0x7d, 0x0a, 0x0a, 0x66, 0x75, 0x6e,
+					0x63, 0x20, 0x28, 0x65, 0x20, 0x68, 0x74, 0x74, 0x70, 0x45, 0x72, 0x72,
+					0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x29, 0x20, 0x45, 0x72,
+					0x72, 0x6f, 0x72, 0x28, 0x29, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
+					0x20, 0x7b, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x65,
+					0x2e, 0x65, 0x72, 0x72, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x28, 0x29,
+					0x0a, 0x7d, 0x0a,
 				},
 				fi: FileInfo{
 					name:    "http.jet",
-					size:    1235,
-					modTime: time.Unix(0, 1586906971923620100),
+					size:    1479,
+					modTime: time.Unix(0, 1792309978086673376),
 					isDir:   false,
 				},
 			}, "/assets/service/gen/options.jet": {
@@ -980,131 +1391,158 @@ func init() {
 					0x70, 0x63, 0x20, 0x22, 0x7b, 0x7b, 0x20, 0x2e, 0x49, 0x6d, 0x70, 0x6f,
 					0x72, 0x74, 0x20, 0x7d, 0x7d, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x74, 0x72,
 					0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2f, 0x67, 0x72, 0x70, 0x63,
-					0x22, 0x7b, 0x7b, 0x20, 0x65, 0x6e, 0x64, 0x20, 0x7d, 0x7d, 0x0a, 0x09,
-					0x67, 0x65, 0x6e, 0x48, 0x74, 0x74, 0x70, 0x20, 0x22, 0x7b, 0x7b, 0x20,
-					0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x20, 0x7d, 0x7d, 0x2f, 0x67,
-					0x65, 0x6e, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74,
-					0x2f, 0x68, 0x74, 0x74, 0x70, 0x22, 0x0a, 0x0a, 0x09, 0x22, 0x67, 0x69,
-					0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x6f, 0x2d,
-					0x6b, 0x69, 0x74, 0x2f, 0x6b, 0x69, 0x74, 0x2f, 0x6c, 0x6f, 0x67, 0x22,
-					0x0a, 0x29, 0x0a, 0x0a, 0x74, 0x79, 0x70, 0x65, 0x20, 0x4d, 0x6f, 0x64,
-					0x65, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x0a, 0x0a, 0x63, 0x6f,
-					0x6e, 0x73, 0x74, 0x20, 0x28, 0x0a, 0x09, 0x6e, 0x6f, 0x6e, 0x65, 0x20,
-					0x20, 0x4d, 0x6f, 0x64, 0x65, 0x20, 0x3d, 0x20, 0x22, 0x22, 0x0a, 0x09,
-					0x44, 0x45, 0x42, 0x55, 0x47, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x3d,
-					0x20, 0x22, 0x64, 0x65, 0x62, 0x75, 0x67, 0x22, 0x0a, 0x09, 0x50, 0x52,
-					0x4f, 0x44, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x3d, 0x20, 0x22,
-					0x70, 0x72, 0x6f, 0x64, 0x22, 0x0a, 0x29, 0x0a, 0x0a, 0x74, 0x79, 0x70,
-					0x65, 0x20, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x73, 0x74,
-					0x72, 0x75, 0x63, 0x74, 0x20, 0x7b, 0x0a, 0x09, 0x73, 0x65, 0x72, 0x76,
-					0x69, 0x63, 0x65, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72,
-					0x65, 0x20, 0x5b, 0x5d, 0x67, 0x65, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69,
-					0x63, 0x65, 0x2e, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72,
-					0x65, 0x0a, 0x09, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x6f,
-					0x67, 0x67, 0x65, 0x72, 0x20, 0x20, 0x20, 0x20, 0x20, 0x6c, 0x6f, 0x67,
-					0x2e, 0x4c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x0a, 0x09, 0x64, 0x65, 0x62,
-					0x75, 0x67, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x20, 0x20, 0x20,
-					0x20, 0x20, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x0a, 0x09, 0x73,
-					0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x20, 0x20,
-					0x20, 0x20, 0x20, 0x20, 0x20, 0x4d, 0x6f, 0x64, 0x65, 0x0a, 0x0a, 0x09,
-					0x68, 0x74, 0x74, 0x70, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20,
-					0x5b, 0x5d, 0x67, 0x65, 0x6e, 0x48, 0x74, 0x74, 0x70, 0x2e, 0x4f, 0x70,
-					0x74, 0x69, 0x6f, 0x6e, 0x7b, 0x7b, 0x69, 0x66, 0x20, 0x2e, 0x47, 0x52,
-					0x50, 0x43, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x7d,
-					0x7d, 0x0a, 0x09, 0x67, 0x72, 0x70, 0x63, 0x4f, 0x70, 0x74, 0x69, 0x6f,
-					0x6e, 0x73, 0x20, 0x5b, 0x5d, 0x67, 0x65, 0x6e, 0x47, 0x72, 0x70, 0x63,
-					0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x7b, 0x7b, 0x20, 0x65, 0x6e,
-					0x64, 0x20, 0x7d, 0x7d, 0x0a, 0x0a, 0x09, 0x65, 0x6e, 0x64, 0x70, 0x6f,
-					0x69, 0x6e, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x5b,
-					0x5d, 0x67, 0x65, 0x6e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
-					0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x0a, 0x7d, 0x0a, 0x0a, 0x74,
-					0x79, 0x70, 0x65, 0x20, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x66,
-					0x75, 0x6e, 0x63, 0x28, 0x2a, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-					0x29, 0x0a, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x53, 0x65, 0x72, 0x76,
-					0x69, 0x63, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x28, 0x6d, 0x6f, 0x64, 0x65,
-					0x20, 0x4d, 0x6f, 0x64, 0x65, 0x29, 0x20, 0x4f, 0x70, 0x74, 0x69, 0x6f,
-					0x6e, 0x20, 0x7b, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20,
-					0x66, 0x75, 0x6e, 0x63, 0x28, 0x6f, 0x20, 0x2a, 0x6f, 0x70, 0x74, 0x69,
-					0x6f, 0x6e, 0x73, 0x29, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x6f, 0x2e, 0x73,
-					0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x20, 0x3d,
-					0x20, 0x6d, 0x6f, 0x64, 0x65, 0x0a, 0x09, 0x7d, 0x0a, 0x7d, 0x0a, 0x66,
-					0x75, 0x6e, 0x63, 0x20, 0x44, 0x65, 0x62, 0x75, 0x67, 0x41, 0x64, 0x64,
-					0x72, 0x65, 0x73, 0x73, 0x28, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-					0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x29, 0x20, 0x4f, 0x70, 0x74,
-					0x69, 0x6f, 0x6e, 0x20, 0x7b, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72,
-					0x6e, 0x20, 0x66, 0x75, 0x6e, 0x63, 0x28, 0x6f, 0x20, 0x2a, 0x6f, 0x70,
-					0x74, 0x69, 0x6f, 0x6e, 0x73, 0x29, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x6f,
-					0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-					0x73, 0x20, 0x3d, 0x20, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x0a,
-					0x09, 0x7d, 0x0a, 0x7d, 0x0a, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x53,
-					0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65,
-					0x77, 0x61, 0x72, 0x65, 0x28, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77,
-					0x61, 0x72, 0x65, 0x20, 0x2e, 0x2e, 0x2e, 0x67, 0x65, 0x6e, 0x53, 0x65,
-					0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65,
-					0x77, 0x61, 0x72, 0x65, 0x29, 0x20, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-					0x20, 0x7b, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x66,
-					0x75, 0x6e, 0x63, 0x28, 0x6f, 0x20, 0x2a, 0x6f, 0x70, 0x74, 0x69, 0x6f,
-					0x6e, 0x73, 0x29, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x6f, 0x2e, 0x73, 0x65,
+					0x22, 0x7b, 0x7b, 0x20, 0x65, 0x6e, 0x64, 0x20, 0x7d, 0x7d, 0x7b, 0x7b,
+					0x69, 0x66, 0x20, 0x2e, 0x48, 0x61, 0x73, 0x48, 0x74, 0x74, 0x70, 0x28,
+					0x29, 0x7d, 0x7d, 0x0a, 0x09, 0x67, 0x65, 0x6e, 0x48, 0x74, 0x74, 0x70,
+					0x20, 0x22, 0x7b, 0x7b, 0x20, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+					0x20, 0x7d, 0x7d, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x74, 0x72, 0x61, 0x6e,
+					0x73, 0x70, 0x6f, 0x72, 0x74, 0x2f, 0x68, 0x74, 0x74, 0x70, 0x22, 0x7b,
+					0x7b, 0x20, 0x65, 0x6e, 0x64, 0x20, 0x7d, 0x7d, 0x0a, 0x0a, 0x09, 0x22,
+					0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67,
+					0x6f, 0x2d, 0x6b, 0x69, 0x74, 0x2f, 0x6b, 0x69, 0x74, 0x2f, 0x6c, 0x6f,
+					0x67, 0x22, 0x0a, 0x29, 0x0a, 0x0a, 0x74, 0x79, 0x70, 0x65, 0x20, 0x4d,
+					0x6f, 0x64, 0x65, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x0a, 0x0a,
+					0x63, 0x6f, 0x6e, 0x73, 0x74, 0x20, 0x28, 0x0a, 0x09, 0x6e, 0x6f, 0x6e,
+					0x65, 0x20, 0x20, 0x4d, 0x6f, 0x64, 0x65, 0x20, 0x3d, 0x20, 0x22, 0x22,
+					0x0a, 0x09, 0x44, 0x45, 0x42, 0x55, 0x47, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x3d, 0x20, 0x22, 0x64, 0x65, 0x62, 0x75, 0x67, 0x22, 0x0a, 0x09,
+					0x50, 0x52, 0x4f, 0x44, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x3d,
+					0x20, 0x22, 0x70, 0x72, 0x6f, 0x64, 0x22, 0x0a, 0x29, 0x0a, 0x0a, 0x74,
+					0x79, 0x70, 0x65, 0x20, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20,
+					0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x20, 0x7b, 0x0a, 0x09, 0x73, 0x65,
 					0x72, 0x76, 0x69, 0x63, 0x65, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77,
-					0x61, 0x72, 0x65, 0x20, 0x3d, 0x20, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x64,
-					0x28, 0x6f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4d, 0x69,
-					0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x2c, 0x20, 0x6d, 0x69,
-					0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x2e, 0x2e, 0x29,
-					0x0a, 0x09, 0x7d, 0x0a, 0x7d, 0x0a, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20,
-					0x48, 0x74, 0x74, 0x70, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x28,
-					0x68, 0x74, 0x74, 0x70, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20,
-					0x2e, 0x2e, 0x2e, 0x67, 0x65, 0x6e, 0x48, 0x74, 0x74, 0x70, 0x2e, 0x4f,
+					0x61, 0x72, 0x65, 0x20, 0x5b, 0x5d, 0x67, 0x65, 0x6e, 0x53, 0x65, 0x72,
+					0x76, 0x69, 0x63, 0x65, 0x2e, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77,
+					0x61, 0x72, 0x65, 0x0a, 0x09, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+					0x4c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x20, 0x20, 0x20, 0x20, 0x20, 0x6c,
+					0x6f, 0x67, 0x2e, 0x4c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x0a, 0x09, 0x64,
+					0x65, 0x62, 0x75, 0x67, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x0a,
+					0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x46, 0x6c, 0x61, 0x67,
+					0x73, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x41, 0x64, 0x64, 0x72, 0x65,
+					0x73, 0x73, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x0a, 0x09, 0x73, 0x65, 0x72,
+					0x76, 0x69, 0x63, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x4d, 0x6f, 0x64, 0x65, 0x0a, 0x0a, 0x7b, 0x7b, 0x69,
+					0x66, 0x20, 0x2e, 0x48, 0x61, 0x73, 0x48, 0x74, 0x74, 0x70, 0x28, 0x29,
+					0x7d, 0x7d, 0x0a, 0x09, 0x68, 0x74, 0x74, 0x70, 0x4f, 0x70, 0x74, 0x69,
+					0x6f, 0x6e, 0x73, 0x20, 0x5b, 0x5d, 0x67, 0x65, 0x6e, 0x48, 0x74, 0x74,
+					0x70, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x7b, 0x7b, 0x20, 0x65,
+					0x6e, 0x64, 0x20, 0x7d, 0x7d, 0x7b, 0x7b, 0x69, 0x66, 0x20, 0x2e, 0x47,
+					0x52, 0x50, 0x43, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74,
+					0x7d, 0x7d, 0x0a, 0x09, 0x67, 0x72, 0x70, 0x63, 0x4f, 0x70, 0x74, 0x69,
+					0x6f, 0x6e, 0x73, 0x20, 0x5b, 0x5d, 0x67, 0x65, 0x6e, 0x47, 0x72, 0x70,
+					0x63, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x7b, 0x7b, 0x20, 0x65,
+					0x6e, 0x64, 0x20, 0x7d, 0x7d, 0x0a, 0x0a, 0x09, 0x65, 0x6e, 0x64, 0x70,
+					0x6f, 0x69, 0x6e, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20,
+					0x5b, 0x5d, 0x67, 0x65, 0x6e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e,
+					0x74, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x0a, 0x7d, 0x0a, 0x0a,
+					0x74, 0x79, 0x70, 0x65, 0x20, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x20,
+					0x66, 0x75, 0x6e, 0x63, 0x28, 0x2a, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+					0x73, 0x29, 0x0a, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x53, 0x65, 0x72,
+					0x76, 0x69, 0x63, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x28, 0x6d, 0x6f, 0x64,
+					0x65, 0x20, 0x4d, 0x6f, 0x64, 0x65, 0x29, 0x20, 0x4f, 0x70, 0x74, 0x69,
+					0x6f, 0x6e, 0x20, 0x7b, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e,
+					0x20, 0x66, 0x75, 0x6e, 0x63, 0x28, 0x6f, 0x20, 0x2a, 0x6f, 0x70, 0x74,
+					0x69, 0x6f, 0x6e, 0x73, 0x29, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x6f, 0x2e,
+					0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x20,
+					0x3d, 0x20, 0x6d, 0x6f, 0x64, 0x65, 0x0a, 0x09, 0x7d, 0x0a, 0x7d, 0x0a,
+					0x66, 0x75, 0x6e, 0x63, 0x20, 0x44, 0x65, 0x62, 0x75, 0x67, 0x41, 0x64,
+					0x64, 0x72, 0x65, 0x73, 0x73, 0x28, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+					0x73, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x29, 0x20, 0x4f, 0x70,
+					0x74, 0x69, 0x6f, 0x6e, 0x20, 0x7b, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x75,
+					0x72, 0x6e, 0x20, 0x66, 0x75, 0x6e, 0x63, 0x28, 0x6f, 0x20, 0x2a, 0x6f,
+					0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x29, 0x20, 0x7b, 0x0a, 0x09, 0x09,
+					0x6f, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x41, 0x64, 0x64, 0x72, 0x65,
+					0x73, 0x73, 0x20, 0x3d, 0x20, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+					0x0a, 0x09, 0x7d, 0x0a, 0x7d, 0x0a, 0x0a, 0x2f, 0x2f, 0x20, 0x46, 0x6c,
+					0x61, 0x67, 0x73, 0x20, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65,
+					0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+					0x73, 0x65, 0x73, 0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63,
+					0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x20, 0x6c, 0x69, 0x6e, 0x65, 0x20,
+					0x66, 0x6c, 0x61, 0x67, 0x73, 0x2c, 0x20, 0x74, 0x68, 0x65, 0x79, 0x20,
+					0x61, 0x72, 0x65, 0x20, 0x75, 0x73, 0x65, 0x64, 0x20, 0x69, 0x66, 0x20,
+					0x74, 0x68, 0x65, 0x20, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d,
+					0x65, 0x6e, 0x74, 0x20, 0x76, 0x61, 0x72, 0x69, 0x61, 0x62, 0x6c, 0x65,
+					0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x73, 0x65,
+					0x74, 0x2e, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x46, 0x6c, 0x61, 0x67,
+					0x73, 0x28, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x20, 0x41, 0x64, 0x64, 0x72,
+					0x65, 0x73, 0x73, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x29, 0x20, 0x4f, 0x70,
+					0x74, 0x69, 0x6f, 0x6e, 0x20, 0x7b, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x75,
+					0x72, 0x6e, 0x20, 0x66, 0x75, 0x6e, 0x63, 0x28, 0x6f, 0x20, 0x2a, 0x6f,
+					0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x29, 0x20, 0x7b, 0x0a, 0x09, 0x09,
+					0x6f, 0x2e, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x46, 0x6c, 0x61,
+					0x67, 0x73, 0x20, 0x3d, 0x20, 0x66, 0x6c, 0x61, 0x67, 0x73, 0x0a, 0x09,
+					0x7d, 0x0a, 0x7d, 0x0a, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x53, 0x65,
+					0x72, 0x76, 0x69, 0x63, 0x65, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77,
+					0x61, 0x72, 0x65, 0x28, 0x6d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61,
+					0x72, 0x65, 0x20, 0x2e, 0x2e, 0x2e, 0x67, 0x65, 0x6e, 0x53, 0x65, 0x72,
+					0x76, 0x69, 0x63, 0x65, 0x2e, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77,
+					0x61, 0x72, 0x65, 0x29, 0x20, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x20,
+					0x7b, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x66, 0x75,
+					0x6e, 0x63, 0x28, 0x6f, 0x20, 0x2a, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+					0x73, 0x29, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x6f, 0x2e, 0x73, 0x65, 0x72,
+					0x76, 0x69, 0x63, 0x65, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61,
+					0x72, 0x65, 0x20, 0x3d, 0x20, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x28,
+					0x6f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4d, 0x69, 0x64,
+					0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x2c, 0x20, 0x6d, 0x69, 0x64,
+					0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x2e, 0x2e, 0x29, 0x0a,
+					0x09, 0x7d, 0x0a, 0x7d, 0x0a, 0x7b, 0x7b, 0x69, 0x66, 0x20, 0x2e, 0x48,
+					0x61, 0x73, 0x48, 0x74, 0x74, 0x70, 0x28, 0x29, 0x7d, 0x7d, 0x0a, 0x66,
+					0x75, 0x6e, 0x63, 0x20, 0x48, 0x74, 0x74, 0x70, 0x4f, 0x70, 0x74, 0x69,
+					0x6f, 0x6e, 0x73, 0x28, 0x68, 0x74, 0x74, 0x70, 0x4f, 0x70, 0x74, 0x69,
+					0x6f, 0x6e, 0x73, 0x20, 0x2e, 0x2e, 0x2e, 0x67, 0x65, 0x6e, 0x48, 0x74,
+					0x74, 0x70, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x29, 0x20, 0x4f,
+					0x70, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x7b, 0x0a, 0x09, 0x72, 0x65, 0x74,
+					0x75, 0x72, 0x6e, 0x20, 0x66, 0x75, 0x6e, 0x63, 0x28, 0x6f, 0x20, 0x2a,
+					0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x29, 0x20, 0x7b, 0x0a, 0x09,
+					0x09, 0x6f, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+					0x6e, 0x73, 0x20, 0x3d, 0x20, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x28,
+					0x6f, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+					0x73, 0x2c, 0x20, 0x68, 0x74, 0x74, 0x70, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+					0x6e, 0x73, 0x2e, 0x2e, 0x2e, 0x29, 0x0a, 0x09, 0x7d, 0x0a, 0x7d, 0x0a,
+					0x7b, 0x7b, 0x20, 0x65, 0x6e, 0x64, 0x20, 0x7d, 0x7d, 0x7b, 0x7b, 0x69,
+					0x66, 0x20, 0x2e, 0x47, 0x52, 0x50, 0x43, 0x54, 0x72, 0x61, 0x6e, 0x73,
+					0x70, 0x6f, 0x72, 0x74, 0x7d, 0x7d, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20,
+					0x47, 0x72, 0x70, 0x63, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x28,
+					0x67, 0x72, 0x70, 0x63, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20,
+					0x2e, 0x2e, 0x2e, 0x67, 0x65, 0x6e, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x4f,
 					0x70, 0x74, 0x69, 0x6f, 0x6e, 0x29, 0x20, 0x4f, 0x70, 0x74, 0x69, 0x6f,
 					0x6e, 0x20, 0x7b, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20,
 					0x66, 0x75, 0x6e, 0x63, 0x28, 0x6f, 0x20, 0x2a, 0x6f, 0x70, 0x74, 0x69,
-					0x6f, 0x6e, 0x73, 0x29, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x6f, 0x2e, 0x68,
-					0x74, 0x74, 0x70, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x3d,
-					0x20, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x28, 0x6f, 0x2e, 0x68, 0x74,
-					0x74, 0x70, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2c, 0x20, 0x68,
-					0x74, 0x74, 0x70, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x2e,
-					0x2e, 0x29, 0x0a, 0x09, 0x7d, 0x0a, 0x7d, 0x0a, 0x7b, 0x7b, 0x69, 0x66,
-					0x20, 0x2e, 0x47, 0x52, 0x50, 0x43, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70,
-					0x6f, 0x72, 0x74, 0x7d, 0x7d, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x47,
-					0x72, 0x70, 0x63, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x28, 0x67,
-					0x72, 0x70, 0x63, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x2e,
-					0x2e, 0x2e, 0x67, 0x65, 0x6e, 0x47, 0x72, 0x70, 0x63, 0x2e, 0x4f, 0x70,
+					0x6f, 0x6e, 0x73, 0x29, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x6f, 0x2e, 0x67,
+					0x72, 0x70, 0x63, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x3d,
+					0x20, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x28, 0x6f, 0x2e, 0x67, 0x72,
+					0x70, 0x63, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2c, 0x20, 0x67,
+					0x72, 0x70, 0x63, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x2e,
+					0x2e, 0x29, 0x0a, 0x09, 0x7d, 0x0a, 0x7d, 0x0a, 0x7b, 0x7b, 0x20, 0x65,
+					0x6e, 0x64, 0x20, 0x7d, 0x7d, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x45,
+					0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+					0x6e, 0x73, 0x28, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x4f,
+					0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x2e, 0x2e, 0x2e, 0x67, 0x65,
+					0x6e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x4f, 0x70,
 					0x74, 0x69, 0x6f, 0x6e, 0x29, 0x20, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
 					0x20, 0x7b, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x66,
 					0x75, 0x6e, 0x63, 0x28, 0x6f, 0x20, 0x2a, 0x6f, 0x70, 0x74, 0x69, 0x6f,
-					0x6e, 0x73, 0x29, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x6f, 0x2e, 0x67, 0x72,
-					0x70, 0x63, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x3d, 0x20,
-					0x61, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x28, 0x6f, 0x2e, 0x67, 0x72, 0x70,
-					0x63, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2c, 0x20, 0x67, 0x72,
-					0x70, 0x63, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x2e, 0x2e,
-					0x29, 0x0a, 0x09, 0x7d, 0x0a, 0x7d, 0x0a, 0x7b, 0x7b, 0x20, 0x65, 0x6e,
-					0x64, 0x20, 0x7d, 0x7d, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x45, 0x6e,
+					0x6e, 0x73, 0x29, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x6f, 0x2e, 0x65, 0x6e,
 					0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-					0x73, 0x28, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x4f, 0x70,
-					0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x2e, 0x2e, 0x2e, 0x67, 0x65, 0x6e,
-					0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x4f, 0x70, 0x74,
-					0x69, 0x6f, 0x6e, 0x29, 0x20, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x20,
-					0x7b, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x66, 0x75,
-					0x6e, 0x63, 0x28, 0x6f, 0x20, 0x2a, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-					0x73, 0x29, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x6f, 0x2e, 0x65, 0x6e, 0x64,
-					0x70, 0x6f, 0x69, 0x6e, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-					0x20, 0x3d, 0x20, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x28, 0x6f, 0x2e,
-					0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x4f, 0x70, 0x74, 0x69,
-					0x6f, 0x6e, 0x73, 0x2c, 0x20, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e,
-					0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x2e, 0x2e, 0x29,
-					0x0a, 0x09, 0x7d, 0x0a, 0x7d, 0x0a, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20,
-					0x4c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x28, 0x6c, 0x6f, 0x67, 0x67, 0x65,
-					0x72, 0x20, 0x6c, 0x6f, 0x67, 0x2e, 0x4c, 0x6f, 0x67, 0x67, 0x65, 0x72,
-					0x29, 0x20, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x7b, 0x0a, 0x09,
-					0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x66, 0x75, 0x6e, 0x63, 0x28,
-					0x6f, 0x20, 0x2a, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x29, 0x20,
-					0x7b, 0x0a, 0x09, 0x09, 0x6f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-					0x65, 0x4c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x20, 0x3d, 0x20, 0x6c, 0x6f,
-					0x67, 0x67, 0x65, 0x72, 0x0a, 0x09, 0x7d, 0x0a, 0x7d, 0x0a,
+					0x73, 0x20, 0x3d, 0x20, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x28, 0x6f,
+					0x2e, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x4f, 0x70, 0x74,
+					0x69, 0x6f, 0x6e, 0x73, 0x2c, 0x20, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69,
+					0x6e, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x2e, 0x2e,
+					0x29, 0x0a, 0x09, 0x7d, 0x0a, 0x7d, 0x0a, 0x0a, 0x66, 0x75, 0x6e, 0x63,
+					0x20, 0x4c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x28, 0x6c, 0x6f, 0x67, 0x67,
+					0x65, 0x72, 0x20, 0x6c, 0x6f, 0x67, 0x2e, 0x4c, 0x6f, 0x67, 0x67, 0x65,
+					0x72, 0x29, 0x20, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x7b, 0x0a,
+					0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x66, 0x75, 0x6e, 0x63,
+					0x28, 0x6f, 0x20, 0x2a, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x29,
+					0x20, 0x7b, 0x0a, 0x09, 0x09, 0x6f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+					0x63, 0x65, 0x4c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x20, 0x3d, 0x20, 0x6c,
+					0x6f, 0x67, 0x67, 0x65, 0x72, 0x0a, 0x09, 0x7d, 0x0a, 0x7d, 0x0a,
 				},
 				fi: FileInfo{
 					name:    "options.jet",
-					size:    1642,
-					modTime: time.Unix(0, 1587860784678667908),
+					size:    1967,
+					modTime: time.Unix(0, 1792312517641438059),
 					isDir:   false,
 				},
 			}, "/assets/service/gen/service": {
@@ -1127,375 +1565,456 @@ func init() {
 					0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x22, 0x7b, 0x7b, 0x20, 0x2e, 0x49,
 					0x6d, 0x70, 0x6f, 0x72, 0x74, 0x20, 0x7d, 0x7d, 0x2f, 0x67, 0x65, 0x6e,
 					0x2f, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x22, 0x0a, 0x20,
-					0x20, 0x20, 0x20, 0x67, 0x65, 0x6e, 0x48, 0x74, 0x74, 0x70, 0x54, 0x72,
-					0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x20, 0x22, 0x7b, 0x7b, 0x20,
-					0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x20, 0x7d, 0x7d, 0x2f, 0x67,
-					0x65, 0x6e, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74,
-					0x2f, 0x68, 0x74, 0x74, 0x70, 0x22, 0x7b, 0x7b, 0x69, 0x66, 0x20, 0x2e,
-					0x47, 0x52, 0x50, 0x43, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72,
-					0x74, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x67, 0x65, 0x6e, 0x47,
-					0x72, 0x70, 0x63, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74,
-					0x20, 0x22, 0x7b, 0x7b, 0x20, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
-					0x20, 0x7d, 0x7d, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x74, 0x72, 0x61, 0x6e,
-					0x73, 0x70, 0x6f, 0x72, 0x74, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x22, 0x7b,
-					0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x22,
-					0x66, 0x6d, 0x74, 0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x22, 0x6e, 0x65,
-					0x74, 0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x22, 0x6e, 0x65, 0x74, 0x2f,
-					0x68, 0x74, 0x74, 0x70, 0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x22, 0x6f,
-					0x73, 0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x22, 0x6f, 0x73, 0x2f, 0x73,
-					0x69, 0x67, 0x6e, 0x61, 0x6c, 0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x22,
-					0x72, 0x75, 0x6e, 0x74, 0x69, 0x6d, 0x65, 0x2f, 0x64, 0x65, 0x62, 0x75,
-					0x67, 0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x22, 0x73, 0x79, 0x73, 0x63,
-					0x61, 0x6c, 0x6c, 0x22, 0x0a, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x22, 0x67,
-					0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x6f,
-					0x2d, 0x6b, 0x69, 0x74, 0x2f, 0x6b, 0x69, 0x74, 0x2f, 0x6c, 0x6f, 0x67,
-					0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x22, 0x67, 0x69, 0x74, 0x68, 0x75,
-					0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x6f, 0x2d, 0x6b, 0x69, 0x74,
-					0x2f, 0x6b, 0x69, 0x74, 0x2f, 0x6c, 0x6f, 0x67, 0x2f, 0x6c, 0x65, 0x76,
-					0x65, 0x6c, 0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x22, 0x67, 0x69, 0x74,
-					0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x6f, 0x6b, 0x6c, 0x6f,
-					0x67, 0x2f, 0x72, 0x75, 0x6e, 0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x22,
-					0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x67, 0x6f, 0x6c, 0x61, 0x6e,
-					0x67, 0x2e, 0x6f, 0x72, 0x67, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x22, 0x0a,
-					0x29, 0x0a, 0x0a, 0x74, 0x79, 0x70, 0x65, 0x20, 0x73, 0x65, 0x72, 0x76,
-					0x69, 0x63, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74,
-					0x20, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x20, 0x7b, 0x0a, 0x20, 0x20,
-					0x20, 0x20, 0x68, 0x74, 0x74, 0x70, 0x20, 0x67, 0x65, 0x6e, 0x48, 0x74,
-					0x74, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e,
-					0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x7b, 0x7b, 0x69,
-					0x66, 0x20, 0x2e, 0x47, 0x52, 0x50, 0x43, 0x54, 0x72, 0x61, 0x6e, 0x73,
-					0x70, 0x6f, 0x72, 0x74, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x67,
-					0x72, 0x70, 0x63, 0x20, 0x67, 0x65, 0x6e, 0x47, 0x72, 0x70, 0x63, 0x54,
-					0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x54, 0x72, 0x61,
-					0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d,
-					0x7d, 0x0a, 0x7d, 0x0a, 0x0a, 0x74, 0x79, 0x70, 0x65, 0x20, 0x47, 0x65,
+					0x20, 0x20, 0x20, 0x22, 0x7b, 0x7b, 0x20, 0x2e, 0x49, 0x6d, 0x70, 0x6f,
+					0x72, 0x74, 0x20, 0x7d, 0x7d, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x76, 0x65,
+					0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x7b, 0x7b, 0x69, 0x66, 0x20, 0x2e,
+					0x48, 0x61, 0x73, 0x48, 0x74, 0x74, 0x70, 0x28, 0x29, 0x7d, 0x7d, 0x0a,
+					0x20, 0x20, 0x20, 0x20, 0x67, 0x65, 0x6e, 0x48, 0x74, 0x74, 0x70, 0x54,
+					0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x20, 0x22, 0x7b, 0x7b,
+					0x20, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x20, 0x7d, 0x7d, 0x2f,
+					0x67, 0x65, 0x6e, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72,
+					0x74, 0x2f, 0x68, 0x74, 0x74, 0x70, 0x22, 0x7b, 0x7b, 0x65, 0x6e, 0x64,
+					0x7d, 0x7d, 0x7b, 0x7b, 0x69, 0x66, 0x20, 0x2e, 0x47, 0x52, 0x50, 0x43,
+					0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x7d, 0x7d, 0x0a,
+					0x20, 0x20, 0x20, 0x20, 0x67, 0x65, 0x6e, 0x47, 0x72, 0x70, 0x63, 0x54,
+					0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x20, 0x22, 0x7b, 0x7b,
+					0x20, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x20, 0x7d, 0x7d, 0x2f,
+					0x67, 0x65, 0x6e, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72,
+					0x74, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x22, 0x7b, 0x7b, 0x65, 0x6e, 0x64,
+					0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x22, 0x66, 0x6c, 0x61, 0x67,
+					0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x22, 0x66, 0x6d, 0x74, 0x22, 0x0a,
+					0x20, 0x20, 0x20, 0x20, 0x22, 0x6e, 0x65, 0x74, 0x22, 0x0a, 0x20, 0x20,
+					0x20, 0x20, 0x22, 0x6e, 0x65, 0x74, 0x2f, 0x68, 0x74, 0x74, 0x70, 0x22,
+					0x0a, 0x20, 0x20, 0x20, 0x20, 0x22, 0x6f, 0x73, 0x22, 0x0a, 0x20, 0x20,
+					0x20, 0x20, 0x22, 0x6f, 0x73, 0x2f, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c,
+					0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x22, 0x72, 0x75, 0x6e, 0x74, 0x69,
+					0x6d, 0x65, 0x2f, 0x64, 0x65, 0x62, 0x75, 0x67, 0x22, 0x0a, 0x20, 0x20,
+					0x20, 0x20, 0x22, 0x73, 0x79, 0x73, 0x63, 0x61, 0x6c, 0x6c, 0x22, 0x0a,
+					0x0a, 0x20, 0x20, 0x20, 0x20, 0x22, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
+					0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x6f, 0x2d, 0x6b, 0x69, 0x74, 0x2f,
+					0x6b, 0x69, 0x74, 0x2f, 0x6c, 0x6f, 0x67, 0x22, 0x0a, 0x20, 0x20, 0x20,
+					0x20, 0x22, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d,
+					0x2f, 0x67, 0x6f, 0x2d, 0x6b, 0x69, 0x74, 0x2f, 0x6b, 0x69, 0x74, 0x2f,
+					0x6c, 0x6f, 0x67, 0x2f, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x22, 0x0a, 0x20,
+					0x20, 0x20, 0x20, 0x22, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63,
+					0x6f, 0x6d, 0x2f, 0x6f, 0x6b, 0x6c, 0x6f, 0x67, 0x2f, 0x72, 0x75, 0x6e,
+					0x22, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x22, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
+					0x65, 0x2e, 0x67, 0x6f, 0x6c, 0x61, 0x6e, 0x67, 0x2e, 0x6f, 0x72, 0x67,
+					0x2f, 0x67, 0x72, 0x70, 0x63, 0x22, 0x0a, 0x29, 0x0a, 0x0a, 0x74, 0x79,
+					0x70, 0x65, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x54, 0x72,
+					0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x20, 0x73, 0x74, 0x72, 0x75,
+					0x63, 0x74, 0x20, 0x7b, 0x20, 0x7b, 0x7b, 0x69, 0x66, 0x20, 0x2e, 0x48,
+					0x61, 0x73, 0x48, 0x74, 0x74, 0x70, 0x28, 0x29, 0x7d, 0x7d, 0x0a, 0x20,
+					0x20, 0x20, 0x20, 0x68, 0x74, 0x74, 0x70, 0x20, 0x67, 0x65, 0x6e, 0x48,
+					0x74, 0x74, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74,
+					0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x7b, 0x7b,
+					0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x7b, 0x7b, 0x69, 0x66, 0x20, 0x2e, 0x47,
+					0x52, 0x50, 0x43, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74,
+					0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x67, 0x72, 0x70, 0x63, 0x20,
+					0x67, 0x65, 0x6e, 0x47, 0x72, 0x70, 0x63, 0x54, 0x72, 0x61, 0x6e, 0x73,
+					0x70, 0x6f, 0x72, 0x74, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f,
+					0x72, 0x74, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x0a, 0x7d, 0x0a,
+					0x0a, 0x74, 0x79, 0x70, 0x65, 0x20, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61,
+					0x74, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x69,
+					0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x20, 0x7b, 0x0a, 0x09,
+					0x52, 0x75, 0x6e, 0x28, 0x29, 0x0a, 0x7d, 0x0a, 0x0a, 0x74, 0x79, 0x70,
+					0x65, 0x20, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x53,
+					0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x73, 0x74, 0x72, 0x75, 0x63,
+					0x74, 0x20, 0x7b, 0x0a, 0x09, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+					0x20, 0x20, 0x20, 0x20, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x0a,
+					0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x20,
+					0x2a, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x54, 0x72, 0x61, 0x6e,
+					0x73, 0x70, 0x6f, 0x72, 0x74, 0x0a, 0x7d, 0x0a, 0x0a, 0x66, 0x75, 0x6e,
+					0x63, 0x20, 0x4e, 0x65, 0x77, 0x28, 0x73, 0x76, 0x63, 0x20, 0x73, 0x65,
+					0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63,
+					0x65, 0x2c, 0x20, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x2e,
+					0x2e, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x29, 0x20, 0x47, 0x65,
 					0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69,
-					0x63, 0x65, 0x20, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
-					0x20, 0x7b, 0x0a, 0x09, 0x52, 0x75, 0x6e, 0x28, 0x29, 0x0a, 0x7d, 0x0a,
-					0x0a, 0x74, 0x79, 0x70, 0x65, 0x20, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61,
-					0x74, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x73,
-					0x74, 0x72, 0x75, 0x63, 0x74, 0x20, 0x7b, 0x0a, 0x09, 0x6f, 0x70, 0x74,
-					0x69, 0x6f, 0x6e, 0x73, 0x20, 0x20, 0x20, 0x20, 0x6f, 0x70, 0x74, 0x69,
-					0x6f, 0x6e, 0x73, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f,
-					0x72, 0x74, 0x73, 0x20, 0x2a, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-					0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x0a, 0x7d, 0x0a,
-					0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x4e, 0x65, 0x77, 0x28, 0x73, 0x76,
-					0x63, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x53, 0x65,
-					0x72, 0x76, 0x69, 0x63, 0x65, 0x2c, 0x20, 0x6f, 0x70, 0x74, 0x69, 0x6f,
-					0x6e, 0x73, 0x20, 0x2e, 0x2e, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-					0x29, 0x20, 0x47, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x53,
-					0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x7b, 0x0a, 0x09, 0x67, 0x65,
-					0x6e, 0x53, 0x76, 0x63, 0x20, 0x3a, 0x3d, 0x20, 0x67, 0x65, 0x6e, 0x65,
-					0x72, 0x61, 0x74, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-					0x7b, 0x7d, 0x0a, 0x09, 0x66, 0x6f, 0x72, 0x20, 0x5f, 0x2c, 0x20, 0x6f,
-					0x70, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x3a, 0x3d, 0x20, 0x72, 0x61, 0x6e,
-					0x67, 0x65, 0x20, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x7b,
-					0x0a, 0x09, 0x09, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x28, 0x26, 0x67,
-					0x65, 0x6e, 0x53, 0x76, 0x63, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-					0x73, 0x29, 0x0a, 0x09, 0x7d, 0x0a, 0x09, 0x69, 0x66, 0x20, 0x67, 0x65,
-					0x6e, 0x53, 0x76, 0x63, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-					0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x6f, 0x67, 0x67,
-					0x65, 0x72, 0x20, 0x3d, 0x3d, 0x20, 0x6e, 0x69, 0x6c, 0x20, 0x7b, 0x0a,
-					0x09, 0x09, 0x67, 0x65, 0x6e, 0x53, 0x76, 0x63, 0x2e, 0x6f, 0x70, 0x74,
-					0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-					0x4c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x20, 0x3d, 0x20, 0x6c, 0x6f, 0x67,
-					0x2e, 0x4e, 0x65, 0x77, 0x4c, 0x6f, 0x67, 0x66, 0x6d, 0x74, 0x4c, 0x6f,
-					0x67, 0x67, 0x65, 0x72, 0x28, 0x6f, 0x73, 0x2e, 0x53, 0x74, 0x64, 0x6f,
-					0x75, 0x74, 0x29, 0x0a, 0x09, 0x7d, 0x0a, 0x0a, 0x09, 0x69, 0x66, 0x20,
-					0x67, 0x65, 0x6e, 0x53, 0x76, 0x63, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f,
-					0x6e, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4d, 0x6f,
-					0x64, 0x65, 0x20, 0x3d, 0x3d, 0x20, 0x6e, 0x6f, 0x6e, 0x65, 0x20, 0x7b,
-					0x0a, 0x09, 0x09, 0x67, 0x65, 0x6e, 0x53, 0x76, 0x63, 0x2e, 0x6f, 0x70,
+					0x63, 0x65, 0x20, 0x7b, 0x0a, 0x09, 0x2f, 0x2f, 0x20, 0x74, 0x68, 0x65,
+					0x20, 0x6d, 0x61, 0x69, 0x6e, 0x73, 0x20, 0x67, 0x65, 0x6e, 0x65, 0x72,
+					0x61, 0x74, 0x65, 0x64, 0x20, 0x62, 0x65, 0x66, 0x6f, 0x72, 0x65, 0x20,
+					0x74, 0x68, 0x65, 0x20, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x20,
+					0x66, 0x6c, 0x61, 0x67, 0x73, 0x20, 0x65, 0x78, 0x69, 0x73, 0x74, 0x65,
+					0x64, 0x20, 0x64, 0x6f, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x70, 0x61, 0x72,
+					0x73, 0x65, 0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x6f, 0x6d, 0x6d, 0x61,
+					0x6e, 0x64, 0x20, 0x6c, 0x69, 0x6e, 0x65, 0x0a, 0x09, 0x69, 0x66, 0x20,
+					0x21, 0x66, 0x6c, 0x61, 0x67, 0x2e, 0x50, 0x61, 0x72, 0x73, 0x65, 0x64,
+					0x28, 0x29, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x66, 0x6c, 0x61, 0x67, 0x2e,
+					0x50, 0x61, 0x72, 0x73, 0x65, 0x28, 0x29, 0x0a, 0x09, 0x7d, 0x0a, 0x09,
+					0x67, 0x65, 0x6e, 0x53, 0x76, 0x63, 0x20, 0x3a, 0x3d, 0x20, 0x67, 0x65,
+					0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69,
+					0x63, 0x65, 0x7b, 0x7d, 0x0a, 0x09, 0x67, 0x65, 0x6e, 0x53, 0x76, 0x63,
+					0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x61, 0x64, 0x64,
+					0x72, 0x65, 0x73, 0x73, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x20, 0x3d, 0x20,
+					0x2a, 0x63, 0x6f, 0x6d, 0x6d, 0x61, 0x6e, 0x64, 0x4c, 0x69, 0x6e, 0x65,
+					0x46, 0x6c, 0x61, 0x67, 0x73, 0x0a, 0x09, 0x66, 0x6f, 0x72, 0x20, 0x5f,
+					0x2c, 0x20, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x3a, 0x3d, 0x20,
+					0x72, 0x61, 0x6e, 0x67, 0x65, 0x20, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+					0x73, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+					0x28, 0x26, 0x67, 0x65, 0x6e, 0x53, 0x76, 0x63, 0x2e, 0x6f, 0x70, 0x74,
+					0x69, 0x6f, 0x6e, 0x73, 0x29, 0x0a, 0x09, 0x7d, 0x0a, 0x09, 0x69, 0x66,
+					0x20, 0x67, 0x65, 0x6e, 0x53, 0x76, 0x63, 0x2e, 0x6f, 0x70, 0x74, 0x69,
+					0x6f, 0x6e, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c,
+					0x6f, 0x67, 0x67, 0x65, 0x72, 0x20, 0x3d, 0x3d, 0x20, 0x6e, 0x69, 0x6c,
+					0x20, 0x7b, 0x0a, 0x09, 0x09, 0x67, 0x65, 0x6e, 0x53, 0x76, 0x63, 0x2e,
+					0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76,
+					0x69, 0x63, 0x65, 0x4c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x20, 0x3d, 0x20,
+					0x6c, 0x6f, 0x67, 0x2e, 0x4e, 0x65, 0x77, 0x4c, 0x6f, 0x67, 0x66, 0x6d,
+					0x74, 0x4c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x28, 0x6f, 0x73, 0x2e, 0x53,
+					0x74, 0x64, 0x6f, 0x75, 0x74, 0x29, 0x0a, 0x09, 0x7d, 0x0a, 0x0a, 0x09,
+					0x69, 0x66, 0x20, 0x67, 0x65, 0x6e, 0x53, 0x76, 0x63, 0x2e, 0x6f, 0x70,
 					0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-					0x65, 0x4d, 0x6f, 0x64, 0x65, 0x20, 0x3d, 0x20, 0x50, 0x52, 0x4f, 0x44,
-					0x0a, 0x09, 0x7d, 0x0a, 0x0a, 0x09, 0x69, 0x66, 0x20, 0x67, 0x65, 0x6e,
-					0x53, 0x76, 0x63, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
-					0x64, 0x65, 0x62, 0x75, 0x67, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-					0x20, 0x3d, 0x3d, 0x20, 0x22, 0x22, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x67,
+					0x65, 0x4d, 0x6f, 0x64, 0x65, 0x20, 0x3d, 0x3d, 0x20, 0x6e, 0x6f, 0x6e,
+					0x65, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x67, 0x65, 0x6e, 0x53, 0x76, 0x63,
+					0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x73, 0x65, 0x72,
+					0x76, 0x69, 0x63, 0x65, 0x4d, 0x6f, 0x64, 0x65, 0x20, 0x3d, 0x20, 0x50,
+					0x52, 0x4f, 0x44, 0x0a, 0x09, 0x7d, 0x0a, 0x0a, 0x09, 0x69, 0x66, 0x20,
+					0x67, 0x65, 0x6e, 0x53, 0x76, 0x63, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+					0x6e, 0x73, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x41, 0x64, 0x64, 0x72,
+					0x65, 0x73, 0x73, 0x20, 0x3d, 0x3d, 0x20, 0x22, 0x22, 0x20, 0x7b, 0x0a,
+					0x09, 0x09, 0x67, 0x65, 0x6e, 0x53, 0x76, 0x63, 0x2e, 0x6f, 0x70, 0x74,
+					0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x41, 0x64,
+					0x64, 0x72, 0x65, 0x73, 0x73, 0x20, 0x3d, 0x20, 0x72, 0x65, 0x73, 0x6f,
+					0x6c, 0x76, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x28, 0x44,
+					0x65, 0x62, 0x75, 0x67, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x45,
+					0x6e, 0x76, 0x2c, 0x20, 0x67, 0x65, 0x6e, 0x53, 0x76, 0x63, 0x2e, 0x6f,
+					0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x61, 0x64, 0x64, 0x72, 0x65,
+					0x73, 0x73, 0x46, 0x6c, 0x61, 0x67, 0x73, 0x2e, 0x44, 0x65, 0x62, 0x75,
+					0x67, 0x2c, 0x20, 0x64, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x44, 0x65,
+					0x62, 0x75, 0x67, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x29, 0x0a,
+					0x09, 0x7d, 0x0a, 0x0a, 0x09, 0x66, 0x6f, 0x72, 0x20, 0x5f, 0x2c, 0x20,
+					0x6d, 0x20, 0x3a, 0x3d, 0x20, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x20, 0x67,
 					0x65, 0x6e, 0x53, 0x76, 0x63, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-					0x73, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x41, 0x64, 0x64, 0x72, 0x65,
-					0x73, 0x73, 0x20, 0x3d, 0x20, 0x22, 0x7b, 0x7b, 0x20, 0x2e, 0x43, 0x6f,
-					0x6e, 0x66, 0x69, 0x67, 0x2e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x55,
-					0x72, 0x6c, 0x20, 0x7d, 0x7d, 0x3a, 0x7b, 0x7b, 0x20, 0x2e, 0x43, 0x6f,
-					0x6e, 0x66, 0x69, 0x67, 0x2e, 0x44, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x50,
-					0x6f, 0x72, 0x74, 0x20, 0x7d, 0x7d, 0x22, 0x0a, 0x09, 0x7d, 0x0a, 0x0a,
-					0x09, 0x66, 0x6f, 0x72, 0x20, 0x5f, 0x2c, 0x20, 0x6d, 0x20, 0x3a, 0x3d,
-					0x20, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x20, 0x67, 0x65, 0x6e, 0x53, 0x76,
-					0x63, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x73, 0x65,
-					0x72, 0x76, 0x69, 0x63, 0x65, 0x4d, 0x69, 0x64, 0x64, 0x6c, 0x65, 0x77,
-					0x61, 0x72, 0x65, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x73, 0x76, 0x63, 0x20,
-					0x3d, 0x20, 0x6d, 0x28, 0x73, 0x76, 0x63, 0x29, 0x0a, 0x09, 0x7d, 0x0a,
-					0x0a, 0x09, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x20,
-					0x3a, 0x3d, 0x20, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e,
-					0x4d, 0x61, 0x6b, 0x65, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
-					0x73, 0x28, 0x73, 0x76, 0x63, 0x2c, 0x20, 0x67, 0x65, 0x6e, 0x53, 0x76,
-					0x63, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x65, 0x6e,
-					0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-					0x73, 0x2e, 0x2e, 0x2e, 0x29, 0x0a, 0x0a, 0x09, 0x68, 0x74, 0x74, 0x70,
-					0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x20, 0x3a, 0x3d,
-					0x20, 0x67, 0x65, 0x6e, 0x48, 0x74, 0x74, 0x70, 0x54, 0x72, 0x61, 0x6e,
-					0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x4d, 0x61, 0x6b, 0x65, 0x48, 0x74,
-					0x74, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x28,
-					0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x2c, 0x20, 0x67,
+					0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4d, 0x69, 0x64,
+					0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x20, 0x7b, 0x0a, 0x09, 0x09,
+					0x73, 0x76, 0x63, 0x20, 0x3d, 0x20, 0x6d, 0x28, 0x73, 0x76, 0x63, 0x29,
+					0x0a, 0x09, 0x7d, 0x0a, 0x0a, 0x09, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69,
+					0x6e, 0x74, 0x73, 0x20, 0x3a, 0x3d, 0x20, 0x65, 0x6e, 0x64, 0x70, 0x6f,
+					0x69, 0x6e, 0x74, 0x2e, 0x4d, 0x61, 0x6b, 0x65, 0x45, 0x6e, 0x64, 0x70,
+					0x6f, 0x69, 0x6e, 0x74, 0x73, 0x28, 0x73, 0x76, 0x63, 0x2c, 0x20, 0x67,
 					0x65, 0x6e, 0x53, 0x76, 0x63, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-					0x73, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-					0x73, 0x2e, 0x2e, 0x2e, 0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x7b,
-					0x69, 0x66, 0x20, 0x2e, 0x47, 0x52, 0x50, 0x43, 0x54, 0x72, 0x61, 0x6e,
-					0x73, 0x70, 0x6f, 0x72, 0x74, 0x7d, 0x7d, 0x0a, 0x09, 0x67, 0x72, 0x70,
+					0x73, 0x2e, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x4f, 0x70,
+					0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x2e, 0x2e, 0x29, 0x0a, 0x0a, 0x20,
+					0x20, 0x20, 0x20, 0x7b, 0x7b, 0x69, 0x66, 0x20, 0x2e, 0x48, 0x61, 0x73,
+					0x48, 0x74, 0x74, 0x70, 0x28, 0x29, 0x7d, 0x7d, 0x0a, 0x09, 0x2f, 0x2f,
+					0x20, 0x74, 0x68, 0x65, 0x20, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+					0x20, 0x67, 0x69, 0x76, 0x65, 0x6e, 0x20, 0x74, 0x6f, 0x20, 0x4e, 0x65,
+					0x77, 0x20, 0x61, 0x72, 0x65, 0x20, 0x61, 0x70, 0x70, 0x6c, 0x69, 0x65,
+					0x64, 0x20, 0x6c, 0x61, 0x73, 0x74, 0x20, 0x73, 0x6f, 0x20, 0x74, 0x68,
+					0x65, 0x79, 0x20, 0x6f, 0x76, 0x65, 0x72, 0x72, 0x69, 0x64, 0x65, 0x20,
+					0x74, 0x68, 0x65, 0x20, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x64,
+					0x20, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x0a, 0x09, 0x68, 0x74,
+					0x74, 0x70, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x3a, 0x3d,
+					0x20, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x28, 0x0a, 0x09, 0x09, 0x5b,
+					0x5d, 0x67, 0x65, 0x6e, 0x48, 0x74, 0x74, 0x70, 0x54, 0x72, 0x61, 0x6e,
+					0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+					0x7b, 0x67, 0x65, 0x6e, 0x48, 0x74, 0x74, 0x70, 0x54, 0x72, 0x61, 0x6e,
+					0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+					0x73, 0x28, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x41, 0x64, 0x64,
+					0x72, 0x65, 0x73, 0x73, 0x28, 0x48, 0x74, 0x74, 0x70, 0x41, 0x64, 0x64,
+					0x72, 0x65, 0x73, 0x73, 0x45, 0x6e, 0x76, 0x2c, 0x20, 0x67, 0x65, 0x6e,
+					0x53, 0x76, 0x63, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
+					0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x46, 0x6c, 0x61, 0x67, 0x73,
+					0x2e, 0x48, 0x74, 0x74, 0x70, 0x2c, 0x20, 0x64, 0x65, 0x66, 0x61, 0x75,
+					0x6c, 0x74, 0x48, 0x74, 0x74, 0x70, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+					0x73, 0x29, 0x29, 0x7d, 0x2c, 0x0a, 0x09, 0x09, 0x67, 0x65, 0x6e, 0x53,
+					0x76, 0x63, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x68,
+					0x74, 0x74, 0x70, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x2e,
+					0x2e, 0x2c, 0x0a, 0x09, 0x29, 0x0a, 0x09, 0x68, 0x74, 0x74, 0x70, 0x54,
+					0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x20, 0x3a, 0x3d, 0x20,
+					0x67, 0x65, 0x6e, 0x48, 0x74, 0x74, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73,
+					0x70, 0x6f, 0x72, 0x74, 0x2e, 0x4d, 0x61, 0x6b, 0x65, 0x48, 0x74, 0x74,
+					0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x28, 0x65,
+					0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x2c, 0x20, 0x68, 0x74,
+					0x74, 0x70, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x2e, 0x2e,
+					0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d,
+					0x7d, 0x7b, 0x7b, 0x69, 0x66, 0x20, 0x2e, 0x47, 0x52, 0x50, 0x43, 0x54,
+					0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x7d, 0x7d, 0x0a, 0x09,
+					0x67, 0x72, 0x70, 0x63, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20,
+					0x3a, 0x3d, 0x20, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x28, 0x0a, 0x09,
+					0x09, 0x5b, 0x5d, 0x67, 0x65, 0x6e, 0x47, 0x72, 0x70, 0x63, 0x54, 0x72,
+					0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x4f, 0x70, 0x74, 0x69,
+					0x6f, 0x6e, 0x7b, 0x67, 0x65, 0x6e, 0x47, 0x72, 0x70, 0x63, 0x54, 0x72,
+					0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x41, 0x64, 0x64, 0x72,
+					0x65, 0x73, 0x73, 0x28, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x41,
+					0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x28, 0x47, 0x72, 0x70, 0x63, 0x41,
+					0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x45, 0x6e, 0x76, 0x2c, 0x20, 0x67,
+					0x65, 0x6e, 0x53, 0x76, 0x63, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+					0x73, 0x2e, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x46, 0x6c, 0x61,
+					0x67, 0x73, 0x2e, 0x47, 0x72, 0x70, 0x63, 0x2c, 0x20, 0x64, 0x65, 0x66,
+					0x61, 0x75, 0x6c, 0x74, 0x47, 0x72, 0x70, 0x63, 0x41, 0x64, 0x64, 0x72,
+					0x65, 0x73, 0x73, 0x29, 0x29, 0x7d, 0x2c, 0x0a, 0x09, 0x09, 0x67, 0x65,
+					0x6e, 0x53, 0x76, 0x63, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+					0x2e, 0x67, 0x72, 0x70, 0x63, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+					0x2e, 0x2e, 0x2e, 0x2c, 0x0a, 0x09, 0x29, 0x0a, 0x09, 0x67, 0x72, 0x70,
 					0x63, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x20, 0x3a,
 					0x3d, 0x20, 0x67, 0x65, 0x6e, 0x47, 0x72, 0x70, 0x63, 0x54, 0x72, 0x61,
 					0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x4d, 0x61, 0x6b, 0x65, 0x47,
 					0x52, 0x50, 0x43, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74,
 					0x28, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x73, 0x2c, 0x20,
-					0x67, 0x65, 0x6e, 0x53, 0x76, 0x63, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f,
-					0x6e, 0x73, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x4f, 0x70, 0x74, 0x69, 0x6f,
-					0x6e, 0x73, 0x2e, 0x2e, 0x2e, 0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b,
-					0x7b, 0x20, 0x65, 0x6e, 0x64, 0x20, 0x7d, 0x7d, 0x0a, 0x09, 0x67, 0x65,
-					0x6e, 0x53, 0x76, 0x63, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f,
-					0x72, 0x74, 0x73, 0x20, 0x3d, 0x20, 0x26, 0x73, 0x65, 0x72, 0x76, 0x69,
-					0x63, 0x65, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x7b,
-					0x0a, 0x09, 0x09, 0x68, 0x74, 0x74, 0x70, 0x3a, 0x20, 0x68, 0x74, 0x74,
-					0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2c, 0x20,
-					0x7b, 0x7b, 0x69, 0x66, 0x20, 0x2e, 0x47, 0x52, 0x50, 0x43, 0x54, 0x72,
-					0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x7d, 0x7d, 0x0a, 0x09, 0x09,
-					0x67, 0x72, 0x70, 0x63, 0x3a, 0x20, 0x67, 0x72, 0x70, 0x63, 0x54, 0x72,
-					0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2c, 0x20, 0x7b, 0x7b, 0x20,
-					0x65, 0x6e, 0x64, 0x20, 0x7d, 0x7d, 0x0a, 0x09, 0x7d, 0x0a, 0x09, 0x72,
-					0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x67, 0x65, 0x6e, 0x53, 0x76, 0x63,
-					0x0a, 0x7d, 0x0a, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x28, 0x73, 0x65,
-					0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61,
-					0x74, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x29, 0x20,
-					0x52, 0x75, 0x6e, 0x28, 0x29, 0x20, 0x7b, 0x0a, 0x09, 0x76, 0x61, 0x72,
-					0x20, 0x67, 0x20, 0x72, 0x75, 0x6e, 0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70,
-					0x0a, 0x09, 0x7b, 0x0a, 0x09, 0x09, 0x69, 0x66, 0x20, 0x73, 0x65, 0x72,
-					0x76, 0x69, 0x63, 0x65, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-					0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4d, 0x6f, 0x64, 0x65,
-					0x20, 0x3d, 0x3d, 0x20, 0x44, 0x45, 0x42, 0x55, 0x47, 0x20, 0x7b, 0x0a,
-					0x09, 0x09, 0x09, 0x2f, 0x2f, 0x20, 0x54, 0x68, 0x65, 0x20, 0x64, 0x65,
-					0x62, 0x75, 0x67, 0x20, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72,
-					0x20, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20,
-					0x68, 0x74, 0x74, 0x70, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74,
-					0x53, 0x65, 0x72, 0x76, 0x65, 0x4d, 0x75, 0x78, 0x2c, 0x20, 0x61, 0x6e,
-					0x64, 0x20, 0x73, 0x65, 0x72, 0x76, 0x65, 0x73, 0x20, 0x75, 0x70, 0x0a,
-					0x09, 0x09, 0x09, 0x2f, 0x2f, 0x20, 0x73, 0x74, 0x75, 0x66, 0x66, 0x20,
-					0x6c, 0x69, 0x6b, 0x65, 0x20, 0x74, 0x68, 0x65, 0x20, 0x50, 0x72, 0x6f,
-					0x6d, 0x65, 0x74, 0x68, 0x65, 0x75, 0x73, 0x20, 0x6d, 0x65, 0x74, 0x72,
-					0x69, 0x63, 0x73, 0x20, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x2c, 0x20, 0x74,
-					0x68, 0x65, 0x20, 0x47, 0x6f, 0x20, 0x64, 0x65, 0x62, 0x75, 0x67, 0x20,
-					0x61, 0x6e, 0x64, 0x20, 0x70, 0x72, 0x6f, 0x66, 0x69, 0x6c, 0x69, 0x6e,
-					0x67, 0x0a, 0x09, 0x09, 0x09, 0x2f, 0x2f, 0x20, 0x72, 0x6f, 0x75, 0x74,
-					0x65, 0x73, 0x2c, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x73, 0x6f, 0x20, 0x6f,
-					0x6e, 0x2e, 0x0a, 0x09, 0x09, 0x09, 0x76, 0x61, 0x72, 0x20, 0x64, 0x65,
-					0x62, 0x75, 0x67, 0x41, 0x64, 0x64, 0x72, 0x20, 0x3d, 0x20, 0x73, 0x65,
-					0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-					0x73, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x41, 0x64, 0x64, 0x72, 0x65,
-					0x73, 0x73, 0x0a, 0x09, 0x09, 0x09, 0x64, 0x65, 0x62, 0x75, 0x67, 0x4c,
-					0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x2c, 0x20, 0x65, 0x72, 0x72,
-					0x20, 0x3a, 0x3d, 0x20, 0x6e, 0x65, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74,
-					0x65, 0x6e, 0x28, 0x22, 0x74, 0x63, 0x70, 0x22, 0x2c, 0x20, 0x64, 0x65,
-					0x62, 0x75, 0x67, 0x41, 0x64, 0x64, 0x72, 0x29, 0x0a, 0x09, 0x09, 0x09,
-					0x69, 0x66, 0x20, 0x65, 0x72, 0x72, 0x20, 0x21, 0x3d, 0x20, 0x6e, 0x69,
-					0x6c, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x09, 0x09, 0x5f, 0x20, 0x3d, 0x20,
-					0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x6f, 0x70, 0x74, 0x69,
-					0x6f, 0x6e, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c,
-					0x6f, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x67, 0x28, 0x22, 0x74,
-					0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x2c, 0x20, 0x22,
-					0x64, 0x65, 0x62, 0x75, 0x67, 0x2f, 0x48, 0x54, 0x54, 0x50, 0x22, 0x2c,
-					0x20, 0x22, 0x64, 0x75, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x2c, 0x20, 0x22,
-					0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x22, 0x2c, 0x20, 0x22, 0x65, 0x72,
-					0x72, 0x22, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x29, 0x0a, 0x09, 0x09, 0x09,
-					0x09, 0x6f, 0x73, 0x2e, 0x45, 0x78, 0x69, 0x74, 0x28, 0x31, 0x29, 0x0a,
-					0x09, 0x09, 0x09, 0x7d, 0x0a, 0x09, 0x09, 0x09, 0x67, 0x2e, 0x41, 0x64,
-					0x64, 0x28, 0x66, 0x75, 0x6e, 0x63, 0x28, 0x29, 0x20, 0x65, 0x72, 0x72,
-					0x6f, 0x72, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x09, 0x09, 0x5f, 0x20, 0x3d,
+					0x67, 0x72, 0x70, 0x63, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
+					0x2e, 0x2e, 0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x7b, 0x20, 0x65,
+					0x6e, 0x64, 0x20, 0x7d, 0x7d, 0x0a, 0x09, 0x67, 0x65, 0x6e, 0x53, 0x76,
+					0x63, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73,
+					0x20, 0x3d, 0x20, 0x26, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x54,
+					0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x7b, 0x20, 0x7b, 0x7b,
+					0x69, 0x66, 0x20, 0x2e, 0x48, 0x61, 0x73, 0x48, 0x74, 0x74, 0x70, 0x28,
+					0x29, 0x7d, 0x7d, 0x0a, 0x09, 0x09, 0x68, 0x74, 0x74, 0x70, 0x3a, 0x20,
+					0x68, 0x74, 0x74, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72,
+					0x74, 0x2c, 0x20, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x7b, 0x7b,
+					0x69, 0x66, 0x20, 0x2e, 0x47, 0x52, 0x50, 0x43, 0x54, 0x72, 0x61, 0x6e,
+					0x73, 0x70, 0x6f, 0x72, 0x74, 0x7d, 0x7d, 0x0a, 0x09, 0x09, 0x67, 0x72,
+					0x70, 0x63, 0x3a, 0x20, 0x67, 0x72, 0x70, 0x63, 0x54, 0x72, 0x61, 0x6e,
+					0x73, 0x70, 0x6f, 0x72, 0x74, 0x2c, 0x20, 0x7b, 0x7b, 0x20, 0x65, 0x6e,
+					0x64, 0x20, 0x7d, 0x7d, 0x0a, 0x09, 0x7d, 0x0a, 0x09, 0x72, 0x65, 0x74,
+					0x75, 0x72, 0x6e, 0x20, 0x67, 0x65, 0x6e, 0x53, 0x76, 0x63, 0x0a, 0x7d,
+					0x0a, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x28, 0x73, 0x65, 0x72, 0x76,
+					0x69, 0x63, 0x65, 0x20, 0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65,
+					0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x29, 0x20, 0x52, 0x75,
+					0x6e, 0x28, 0x29, 0x20, 0x7b, 0x0a, 0x09, 0x5f, 0x20, 0x3d, 0x20, 0x73,
+					0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+					0x6e, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x6f,
+					0x67, 0x67, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x67, 0x28, 0x22, 0x76, 0x65,
+					0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x2c, 0x20, 0x76, 0x65, 0x72, 0x73,
+					0x69, 0x6f, 0x6e, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x2c,
+					0x20, 0x22, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x22, 0x2c, 0x20, 0x76,
+					0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x2e, 0x43, 0x6f, 0x6d, 0x6d, 0x69,
+					0x74, 0x2c, 0x20, 0x22, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x5f, 0x74, 0x69,
+					0x6d, 0x65, 0x22, 0x2c, 0x20, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+					0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x29, 0x0a,
+					0x09, 0x76, 0x61, 0x72, 0x20, 0x67, 0x20, 0x72, 0x75, 0x6e, 0x2e, 0x47,
+					0x72, 0x6f, 0x75, 0x70, 0x0a, 0x09, 0x7b, 0x0a, 0x09, 0x09, 0x69, 0x66,
 					0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x6f, 0x70, 0x74,
 					0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-					0x4c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x67, 0x28, 0x22,
-					0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x2c, 0x20,
-					0x22, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2f, 0x48, 0x54, 0x54, 0x50, 0x22,
-					0x2c, 0x20, 0x22, 0x61, 0x64, 0x64, 0x72, 0x22, 0x2c, 0x20, 0x64, 0x65,
-					0x62, 0x75, 0x67, 0x41, 0x64, 0x64, 0x72, 0x29, 0x0a, 0x09, 0x09, 0x09,
-					0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x68, 0x74, 0x74, 0x70,
-					0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x28, 0x64, 0x65, 0x62, 0x75, 0x67,
-					0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x2c, 0x20, 0x68, 0x74,
-					0x74, 0x70, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x53, 0x65,
-					0x72, 0x76, 0x65, 0x4d, 0x75, 0x78, 0x29, 0x0a, 0x09, 0x09, 0x09, 0x7d,
-					0x2c, 0x20, 0x66, 0x75, 0x6e, 0x63, 0x28, 0x65, 0x72, 0x72, 0x6f, 0x72,
-					0x29, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x09, 0x09, 0x5f, 0x20, 0x3d, 0x20,
-					0x64, 0x65, 0x62, 0x75, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65,
-					0x72, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x28, 0x29, 0x0a, 0x09, 0x09,
-					0x09, 0x7d, 0x29, 0x0a, 0x09, 0x09, 0x7d, 0x0a, 0x09, 0x7d, 0x0a, 0x09,
-					0x7b, 0x0a, 0x09, 0x09, 0x65, 0x72, 0x72, 0x20, 0x3a, 0x3d, 0x20, 0x73,
-					0x65, 0x74, 0x75, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72,
-					0x74, 0x73, 0x28, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2c, 0x20,
-					0x26, 0x67, 0x29, 0x0a, 0x09, 0x09, 0x69, 0x66, 0x20, 0x65, 0x72, 0x72,
-					0x20, 0x21, 0x3d, 0x20, 0x6e, 0x69, 0x6c, 0x20, 0x7b, 0x0a, 0x09, 0x09,
+					0x4d, 0x6f, 0x64, 0x65, 0x20, 0x3d, 0x3d, 0x20, 0x44, 0x45, 0x42, 0x55,
+					0x47, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x09, 0x2f, 0x2f, 0x20, 0x54, 0x68,
+					0x65, 0x20, 0x64, 0x65, 0x62, 0x75, 0x67, 0x20, 0x6c, 0x69, 0x73, 0x74,
+					0x65, 0x6e, 0x65, 0x72, 0x20, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x73, 0x20,
+					0x74, 0x68, 0x65, 0x20, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x44, 0x65, 0x66,
+					0x61, 0x75, 0x6c, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x4d, 0x75, 0x78,
+					0x2c, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x73, 0x65, 0x72, 0x76, 0x65, 0x73,
+					0x20, 0x75, 0x70, 0x0a, 0x09, 0x09, 0x09, 0x2f, 0x2f, 0x20, 0x73, 0x74,
+					0x75, 0x66, 0x66, 0x20, 0x6c, 0x69, 0x6b, 0x65, 0x20, 0x74, 0x68, 0x65,
+					0x20, 0x50, 0x72, 0x6f, 0x6d, 0x65, 0x74, 0x68, 0x65, 0x75, 0x73, 0x20,
+					0x6d, 0x65, 0x74, 0x72, 0x69, 0x63, 0x73, 0x20, 0x72, 0x6f, 0x75, 0x74,
+					0x65, 0x2c, 0x20, 0x74, 0x68, 0x65, 0x20, 0x47, 0x6f, 0x20, 0x64, 0x65,
+					0x62, 0x75, 0x67, 0x20, 0x61, 0x6e, 0x64, 0x20, 0x70, 0x72, 0x6f, 0x66,
+					0x69, 0x6c, 0x69, 0x6e, 0x67, 0x0a, 0x09, 0x09, 0x09, 0x2f, 0x2f, 0x20,
+					0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x2c, 0x20, 0x61, 0x6e, 0x64, 0x20,
+					0x73, 0x6f, 0x20, 0x6f, 0x6e, 0x2e, 0x0a, 0x09, 0x09, 0x09, 0x76, 0x61,
+					0x72, 0x20, 0x64, 0x65, 0x62, 0x75, 0x67, 0x41, 0x64, 0x64, 0x72, 0x20,
+					0x3d, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x6f, 0x70,
+					0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x64, 0x65, 0x62, 0x75, 0x67, 0x41,
+					0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x0a, 0x09, 0x09, 0x09, 0x64, 0x65,
+					0x62, 0x75, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x2c,
+					0x20, 0x65, 0x72, 0x72, 0x20, 0x3a, 0x3d, 0x20, 0x6e, 0x65, 0x74, 0x2e,
+					0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x28, 0x22, 0x74, 0x63, 0x70, 0x22,
+					0x2c, 0x20, 0x64, 0x65, 0x62, 0x75, 0x67, 0x41, 0x64, 0x64, 0x72, 0x29,
+					0x0a, 0x09, 0x09, 0x09, 0x69, 0x66, 0x20, 0x65, 0x72, 0x72, 0x20, 0x21,
+					0x3d, 0x20, 0x6e, 0x69, 0x6c, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x09, 0x09,
+					0x5f, 0x20, 0x3d, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e,
+					0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76,
+					0x69, 0x63, 0x65, 0x4c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x4c, 0x6f,
+					0x67, 0x28, 0x22, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74,
+					0x22, 0x2c, 0x20, 0x22, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2f, 0x48, 0x54,
+					0x54, 0x50, 0x22, 0x2c, 0x20, 0x22, 0x64, 0x75, 0x72, 0x69, 0x6e, 0x67,
+					0x22, 0x2c, 0x20, 0x22, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x22, 0x2c,
+					0x20, 0x22, 0x65, 0x72, 0x72, 0x22, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x29,
+					0x0a, 0x09, 0x09, 0x09, 0x09, 0x6f, 0x73, 0x2e, 0x45, 0x78, 0x69, 0x74,
+					0x28, 0x31, 0x29, 0x0a, 0x09, 0x09, 0x09, 0x7d, 0x0a, 0x09, 0x09, 0x09,
+					0x67, 0x2e, 0x41, 0x64, 0x64, 0x28, 0x66, 0x75, 0x6e, 0x63, 0x28, 0x29,
+					0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x09,
 					0x09, 0x5f, 0x20, 0x3d, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
 					0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x73, 0x65, 0x72,
 					0x76, 0x69, 0x63, 0x65, 0x4c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x4c,
 					0x6f, 0x67, 0x28, 0x22, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72,
-					0x74, 0x22, 0x2c, 0x20, 0x22, 0x48, 0x54, 0x54, 0x50, 0x22, 0x2c, 0x20,
-					0x22, 0x64, 0x75, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x2c, 0x20, 0x22, 0x4c,
-					0x69, 0x73, 0x74, 0x65, 0x6e, 0x22, 0x2c, 0x20, 0x22, 0x65, 0x72, 0x72,
-					0x22, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x29, 0x0a, 0x09, 0x09, 0x09, 0x6f,
-					0x73, 0x2e, 0x45, 0x78, 0x69, 0x74, 0x28, 0x31, 0x29, 0x0a, 0x09, 0x09,
-					0x7d, 0x0a, 0x09, 0x7d, 0x0a, 0x09, 0x7b, 0x0a, 0x09, 0x09, 0x76, 0x61,
-					0x72, 0x20, 0x28, 0x0a, 0x09, 0x09, 0x09, 0x63, 0x61, 0x6e, 0x63, 0x65,
-					0x6c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x72, 0x75, 0x70, 0x74, 0x20, 0x3d,
-					0x20, 0x6d, 0x61, 0x6b, 0x65, 0x28, 0x63, 0x68, 0x61, 0x6e, 0x20, 0x73,
-					0x74, 0x72, 0x75, 0x63, 0x74, 0x7b, 0x7d, 0x29, 0x0a, 0x09, 0x09, 0x09,
-					0x63, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
-					0x20, 0x20, 0x20, 0x20, 0x3d, 0x20, 0x6d, 0x61, 0x6b, 0x65, 0x28, 0x63,
-					0x68, 0x61, 0x6e, 0x20, 0x6f, 0x73, 0x2e, 0x53, 0x69, 0x67, 0x6e, 0x61,
-					0x6c, 0x2c, 0x20, 0x32, 0x29, 0x0a, 0x09, 0x09, 0x29, 0x0a, 0x09, 0x09,
-					0x67, 0x2e, 0x41, 0x64, 0x64, 0x28, 0x66, 0x75, 0x6e, 0x63, 0x28, 0x29,
-					0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x09,
-					0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x2e, 0x4e, 0x6f, 0x74, 0x69, 0x66,
-					0x79, 0x28, 0x63, 0x2c, 0x20, 0x73, 0x79, 0x73, 0x63, 0x61, 0x6c, 0x6c,
-					0x2e, 0x53, 0x49, 0x47, 0x49, 0x4e, 0x54, 0x2c, 0x20, 0x73, 0x79, 0x73,
-					0x63, 0x61, 0x6c, 0x6c, 0x2e, 0x53, 0x49, 0x47, 0x54, 0x45, 0x52, 0x4d,
-					0x29, 0x0a, 0x09, 0x09, 0x09, 0x73, 0x65, 0x6c, 0x65, 0x63, 0x74, 0x20,
-					0x7b, 0x0a, 0x09, 0x09, 0x09, 0x63, 0x61, 0x73, 0x65, 0x20, 0x73, 0x69,
-					0x67, 0x20, 0x3a, 0x3d, 0x20, 0x3c, 0x2d, 0x63, 0x3a, 0x0a, 0x09, 0x09,
-					0x09, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x66, 0x6d, 0x74,
-					0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x66, 0x28, 0x22, 0x72, 0x65, 0x63,
-					0x65, 0x69, 0x76, 0x65, 0x64, 0x20, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c,
-					0x20, 0x25, 0x73, 0x22, 0x2c, 0x20, 0x73, 0x69, 0x67, 0x29, 0x0a, 0x09,
-					0x09, 0x09, 0x63, 0x61, 0x73, 0x65, 0x20, 0x3c, 0x2d, 0x63, 0x61, 0x6e,
-					0x63, 0x65, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x72, 0x75, 0x70, 0x74,
-					0x3a, 0x0a, 0x09, 0x09, 0x09, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e,
-					0x20, 0x6e, 0x69, 0x6c, 0x0a, 0x09, 0x09, 0x09, 0x7d, 0x0a, 0x09, 0x09,
-					0x7d, 0x2c, 0x20, 0x66, 0x75, 0x6e, 0x63, 0x28, 0x65, 0x72, 0x72, 0x6f,
-					0x72, 0x29, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x09, 0x63, 0x6c, 0x6f, 0x73,
-					0x65, 0x28, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x49, 0x6e, 0x74, 0x65,
-					0x72, 0x72, 0x75, 0x70, 0x74, 0x29, 0x0a, 0x09, 0x09, 0x7d, 0x29, 0x0a,
-					0x09, 0x09, 0x64, 0x65, 0x66, 0x65, 0x72, 0x20, 0x63, 0x6c, 0x6f, 0x73,
-					0x65, 0x28, 0x63, 0x29, 0x0a, 0x09, 0x7d, 0x0a, 0x09, 0x2f, 0x2f, 0x20,
-					0x72, 0x75, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20, 0x67, 0x72, 0x6f, 0x75,
-					0x70, 0x0a, 0x09, 0x69, 0x66, 0x20, 0x65, 0x72, 0x72, 0x20, 0x3a, 0x3d,
-					0x20, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72,
-					0x28, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x6f, 0x70, 0x74,
-					0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-					0x4c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x29, 0x2e, 0x4c, 0x6f, 0x67, 0x28,
-					0x22, 0x65, 0x78, 0x69, 0x74, 0x22, 0x2c, 0x20, 0x67, 0x2e, 0x52, 0x75,
-					0x6e, 0x28, 0x29, 0x29, 0x3b, 0x20, 0x65, 0x72, 0x72, 0x20, 0x21, 0x3d,
-					0x20, 0x6e, 0x69, 0x6c, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x70, 0x72, 0x69,
-					0x6e, 0x74, 0x6c, 0x6e, 0x28, 0x22, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20,
-					0x77, 0x68, 0x69, 0x6c, 0x65, 0x20, 0x77, 0x72, 0x69, 0x74, 0x69, 0x6e,
-					0x67, 0x20, 0x6c, 0x6f, 0x67, 0x2c, 0x20, 0x74, 0x68, 0x69, 0x73, 0x20,
-					0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x20, 0x6e, 0x65, 0x76, 0x65, 0x72,
-					0x20, 0x68, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x2e, 0x2e, 0x2e, 0x22, 0x29,
-					0x0a, 0x09, 0x09, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2e, 0x50, 0x72, 0x69,
-					0x6e, 0x74, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x28, 0x29, 0x0a, 0x09, 0x7d,
-					0x0a, 0x7d, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x73, 0x65, 0x74, 0x75,
-					0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x28,
-					0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x67, 0x65, 0x6e, 0x65,
-					0x72, 0x61, 0x74, 0x65, 0x64, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-					0x2c, 0x20, 0x67, 0x20, 0x2a, 0x72, 0x75, 0x6e, 0x2e, 0x47, 0x72, 0x6f,
-					0x75, 0x70, 0x29, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x7b, 0x0a,
-					0x09, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x2c, 0x20, 0x65,
-					0x72, 0x72, 0x20, 0x3a, 0x3d, 0x20, 0x6e, 0x65, 0x74, 0x2e, 0x4c, 0x69,
-					0x73, 0x74, 0x65, 0x6e, 0x28, 0x22, 0x74, 0x63, 0x70, 0x22, 0x2c, 0x20,
-					0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x74, 0x72, 0x61, 0x6e,
-					0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x2e,
-					0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x28, 0x29, 0x29, 0x0a, 0x09,
-					0x69, 0x66, 0x20, 0x65, 0x72, 0x72, 0x20, 0x21, 0x3d, 0x20, 0x6e, 0x69,
-					0x6c, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e,
-					0x20, 0x65, 0x72, 0x72, 0x0a, 0x09, 0x7d, 0x0a, 0x09, 0x67, 0x2e, 0x41,
-					0x64, 0x64, 0x28, 0x66, 0x75, 0x6e, 0x63, 0x28, 0x29, 0x20, 0x65, 0x72,
-					0x72, 0x6f, 0x72, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x5f, 0x20, 0x3d, 0x20,
-					0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x6f, 0x70, 0x74, 0x69,
-					0x6f, 0x6e, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c,
-					0x6f, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x67, 0x28, 0x22, 0x74,
-					0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x2c, 0x20, 0x22,
-					0x48, 0x54, 0x54, 0x50, 0x22, 0x2c, 0x20, 0x22, 0x61, 0x64, 0x64, 0x72,
-					0x22, 0x2c, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x74,
-					0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x68, 0x74,
-					0x74, 0x70, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x28, 0x29,
-					0x29, 0x0a, 0x09, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x68,
-					0x74, 0x74, 0x70, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x28, 0x6c, 0x69,
-					0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x2c, 0x20, 0x73, 0x65, 0x72, 0x76,
-					0x69, 0x63, 0x65, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72,
-					0x74, 0x73, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x52, 0x6f, 0x75, 0x74,
-					0x65, 0x72, 0x28, 0x29, 0x29, 0x0a, 0x09, 0x7d, 0x2c, 0x20, 0x66, 0x75,
-					0x6e, 0x63, 0x28, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x29, 0x20, 0x7b, 0x0a,
-					0x09, 0x09, 0x65, 0x72, 0x72, 0x20, 0x3a, 0x3d, 0x20, 0x6c, 0x69, 0x73,
+					0x74, 0x22, 0x2c, 0x20, 0x22, 0x64, 0x65, 0x62, 0x75, 0x67, 0x2f, 0x48,
+					0x54, 0x54, 0x50, 0x22, 0x2c, 0x20, 0x22, 0x61, 0x64, 0x64, 0x72, 0x22,
+					0x2c, 0x20, 0x64, 0x65, 0x62, 0x75, 0x67, 0x41, 0x64, 0x64, 0x72, 0x29,
+					0x0a, 0x09, 0x09, 0x09, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20,
+					0x68, 0x74, 0x74, 0x70, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x28, 0x64,
+					0x65, 0x62, 0x75, 0x67, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72,
+					0x2c, 0x20, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x44, 0x65, 0x66, 0x61, 0x75,
+					0x6c, 0x74, 0x53, 0x65, 0x72, 0x76, 0x65, 0x4d, 0x75, 0x78, 0x29, 0x0a,
+					0x09, 0x09, 0x09, 0x7d, 0x2c, 0x20, 0x66, 0x75, 0x6e, 0x63, 0x28, 0x65,
+					0x72, 0x72, 0x6f, 0x72, 0x29, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x09, 0x09,
+					0x5f, 0x20, 0x3d, 0x20, 0x64, 0x65, 0x62, 0x75, 0x67, 0x4c, 0x69, 0x73,
 					0x74, 0x65, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x28,
-					0x29, 0x0a, 0x09, 0x09, 0x69, 0x66, 0x20, 0x65, 0x72, 0x72, 0x20, 0x21,
-					0x3d, 0x20, 0x6e, 0x69, 0x6c, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x09, 0x66,
-					0x6d, 0x74, 0x2e, 0x50, 0x72, 0x69, 0x6e, 0x74, 0x66, 0x28, 0x22, 0x54,
-					0x68, 0x65, 0x72, 0x65, 0x20, 0x77, 0x68, 0x65, 0x72, 0x65, 0x20, 0x62,
-					0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x20, 0x41, 0x63, 0x63, 0x65, 0x70,
-					0x74, 0x20, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-					0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x63, 0x6c, 0x6f, 0x73, 0x69, 0x6e,
-					0x67, 0x20, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x20, 0x3a,
-					0x20, 0x25, 0x76, 0x22, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x29, 0x0a, 0x09,
-					0x09, 0x7d, 0x0a, 0x09, 0x7d, 0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b,
-					0x7b, 0x69, 0x66, 0x20, 0x2e, 0x47, 0x52, 0x50, 0x43, 0x54, 0x72, 0x61,
-					0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x7d, 0x7d, 0x0a, 0x09, 0x67, 0x72,
-					0x70, 0x63, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x2c, 0x20,
-					0x65, 0x72, 0x72, 0x20, 0x3a, 0x3d, 0x20, 0x6e, 0x65, 0x74, 0x2e, 0x4c,
-					0x69, 0x73, 0x74, 0x65, 0x6e, 0x28, 0x22, 0x74, 0x63, 0x70, 0x22, 0x2c,
-					0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x74, 0x72, 0x61,
-					0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x67, 0x72, 0x70, 0x63,
-					0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x28, 0x29, 0x29, 0x0a,
-					0x09, 0x69, 0x66, 0x20, 0x65, 0x72, 0x72, 0x20, 0x21, 0x3d, 0x20, 0x6e,
-					0x69, 0x6c, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x5f, 0x20, 0x3d, 0x20, 0x73,
-					0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f,
-					0x6e, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x6f,
-					0x67, 0x67, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x67, 0x28, 0x22, 0x74, 0x72,
-					0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x2c, 0x20, 0x22, 0x67,
-					0x52, 0x50, 0x43, 0x22, 0x2c, 0x20, 0x22, 0x64, 0x75, 0x72, 0x69, 0x6e,
-					0x67, 0x22, 0x2c, 0x20, 0x22, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x22,
-					0x2c, 0x20, 0x22, 0x65, 0x72, 0x72, 0x22, 0x2c, 0x20, 0x65, 0x72, 0x72,
-					0x29, 0x0a, 0x09, 0x09, 0x6f, 0x73, 0x2e, 0x45, 0x78, 0x69, 0x74, 0x28,
-					0x31, 0x29, 0x0a, 0x09, 0x7d, 0x0a, 0x09, 0x67, 0x2e, 0x41, 0x64, 0x64,
-					0x28, 0x66, 0x75, 0x6e, 0x63, 0x28, 0x29, 0x20, 0x65, 0x72, 0x72, 0x6f,
-					0x72, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x5f, 0x20, 0x3d, 0x20, 0x73, 0x65,
-					0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-					0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x6f, 0x67,
-					0x67, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x67, 0x28, 0x22, 0x74, 0x72, 0x61,
-					0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x2c, 0x20, 0x22, 0x67, 0x52,
-					0x50, 0x43, 0x22, 0x2c, 0x20, 0x22, 0x61, 0x64, 0x64, 0x72, 0x22, 0x2c,
-					0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x74, 0x72, 0x61,
-					0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x67, 0x72, 0x70, 0x63,
-					0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x28, 0x29, 0x29, 0x0a,
-					0x09, 0x09, 0x2f, 0x2f, 0x20, 0x77, 0x65, 0x20, 0x61, 0x64, 0x64, 0x20,
-					0x74, 0x68, 0x65, 0x20, 0x47, 0x6f, 0x20, 0x4b, 0x69, 0x74, 0x20, 0x67,
-					0x52, 0x50, 0x43, 0x20, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x63, 0x65, 0x70,
-					0x74, 0x6f, 0x72, 0x20, 0x74, 0x6f, 0x20, 0x6f, 0x75, 0x72, 0x20, 0x67,
-					0x52, 0x50, 0x43, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20,
-					0x61, 0x73, 0x20, 0x69, 0x74, 0x20, 0x69, 0x73, 0x20, 0x75, 0x73, 0x65,
-					0x64, 0x20, 0x62, 0x79, 0x0a, 0x09, 0x09, 0x2f, 0x2f, 0x20, 0x74, 0x68,
-					0x65, 0x20, 0x68, 0x65, 0x72, 0x65, 0x20, 0x64, 0x65, 0x6d, 0x6f, 0x6e,
-					0x73, 0x74, 0x72, 0x61, 0x74, 0x65, 0x64, 0x20, 0x7a, 0x69, 0x70, 0x6b,
-					0x69, 0x6e, 0x20, 0x74, 0x72, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x20, 0x6d,
-					0x69, 0x64, 0x64, 0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x0a, 0x09,
-					0x09, 0x62, 0x61, 0x73, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x20,
-					0x3a, 0x3d, 0x20, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x4e, 0x65, 0x77, 0x53,
-					0x65, 0x72, 0x76, 0x65, 0x72, 0x28, 0x29, 0x0a, 0x09, 0x09, 0x67, 0x65,
-					0x6e, 0x47, 0x72, 0x70, 0x63, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f,
-					0x72, 0x74, 0x2e, 0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x53,
-					0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
-					0x28, 0x62, 0x61, 0x73, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2c,
-					0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x74, 0x72, 0x61,
-					0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x67, 0x72, 0x70, 0x63,
-					0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x28, 0x29, 0x29, 0x0a, 0x09,
-					0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x62, 0x61, 0x73, 0x65,
-					0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65,
-					0x28, 0x67, 0x72, 0x70, 0x63, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65,
-					0x72, 0x29, 0x0a, 0x09, 0x7d, 0x2c, 0x20, 0x66, 0x75, 0x6e, 0x63, 0x28,
-					0x65, 0x72, 0x72, 0x6f, 0x72, 0x29, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x5f,
-					0x20, 0x3d, 0x20, 0x67, 0x72, 0x70, 0x63, 0x4c, 0x69, 0x73, 0x74, 0x65,
-					0x6e, 0x65, 0x72, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x28, 0x29, 0x0a,
-					0x09, 0x7d, 0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x7b, 0x20, 0x65,
-					0x6e, 0x64, 0x20, 0x7d, 0x7d, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72,
-					0x6e, 0x20, 0x6e, 0x69, 0x6c, 0x0a, 0x7d, 0x0a,
+					0x29, 0x0a, 0x09, 0x09, 0x09, 0x7d, 0x29, 0x0a, 0x09, 0x09, 0x7d, 0x0a,
+					0x09, 0x7d, 0x0a, 0x09, 0x7b, 0x0a, 0x09, 0x09, 0x65, 0x72, 0x72, 0x20,
+					0x3a, 0x3d, 0x20, 0x73, 0x65, 0x74, 0x75, 0x70, 0x54, 0x72, 0x61, 0x6e,
+					0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x28, 0x73, 0x65, 0x72, 0x76, 0x69,
+					0x63, 0x65, 0x2c, 0x20, 0x26, 0x67, 0x29, 0x0a, 0x09, 0x09, 0x69, 0x66,
+					0x20, 0x65, 0x72, 0x72, 0x20, 0x21, 0x3d, 0x20, 0x6e, 0x69, 0x6c, 0x20,
+					0x7b, 0x0a, 0x09, 0x09, 0x09, 0x5f, 0x20, 0x3d, 0x20, 0x73, 0x65, 0x72,
+					0x76, 0x69, 0x63, 0x65, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+					0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x6f, 0x67, 0x67,
+					0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x67, 0x28, 0x22, 0x74, 0x72, 0x61, 0x6e,
+					0x73, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x2c, 0x20, 0x22, 0x48, 0x54, 0x54,
+					0x50, 0x22, 0x2c, 0x20, 0x22, 0x64, 0x75, 0x72, 0x69, 0x6e, 0x67, 0x22,
+					0x2c, 0x20, 0x22, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x22, 0x2c, 0x20,
+					0x22, 0x65, 0x72, 0x72, 0x22, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x29, 0x0a,
+					0x09, 0x09, 0x09, 0x6f, 0x73, 0x2e, 0x45, 0x78, 0x69, 0x74, 0x28, 0x31,
+					0x29, 0x0a, 0x09, 0x09, 0x7d, 0x0a, 0x09, 0x7d, 0x0a, 0x09, 0x7b, 0x0a,
+					0x09, 0x09, 0x76, 0x61, 0x72, 0x20, 0x28, 0x0a, 0x09, 0x09, 0x09, 0x63,
+					0x61, 0x6e, 0x63, 0x65, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x72, 0x75,
+					0x70, 0x74, 0x20, 0x3d, 0x20, 0x6d, 0x61, 0x6b, 0x65, 0x28, 0x63, 0x68,
+					0x61, 0x6e, 0x20, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x7b, 0x7d, 0x29,
+					0x0a, 0x09, 0x09, 0x09, 0x63, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x3d, 0x20, 0x6d, 0x61,
+					0x6b, 0x65, 0x28, 0x63, 0x68, 0x61, 0x6e, 0x20, 0x6f, 0x73, 0x2e, 0x53,
+					0x69, 0x67, 0x6e, 0x61, 0x6c, 0x2c, 0x20, 0x32, 0x29, 0x0a, 0x09, 0x09,
+					0x29, 0x0a, 0x09, 0x09, 0x67, 0x2e, 0x41, 0x64, 0x64, 0x28, 0x66, 0x75,
+					0x6e, 0x63, 0x28, 0x29, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x7b,
+					0x0a, 0x09, 0x09, 0x09, 0x73, 0x69, 0x67, 0x6e, 0x61, 0x6c, 0x2e, 0x4e,
+					0x6f, 0x74, 0x69, 0x66, 0x79, 0x28, 0x63, 0x2c, 0x20, 0x73, 0x79, 0x73,
+					0x63, 0x61, 0x6c, 0x6c, 0x2e, 0x53, 0x49, 0x47, 0x49, 0x4e, 0x54, 0x2c,
+					0x20, 0x73, 0x79, 0x73, 0x63, 0x61, 0x6c, 0x6c, 0x2e, 0x53, 0x49, 0x47,
+					0x54, 0x45, 0x52, 0x4d, 0x29, 0x0a, 0x09, 0x09, 0x09, 0x73, 0x65, 0x6c,
+					0x65, 0x63, 0x74, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x09, 0x63, 0x61, 0x73,
+					0x65, 0x20, 0x73, 0x69, 0x67, 0x20, 0x3a, 0x3d, 0x20, 0x3c, 0x2d, 0x63,
+					0x3a, 0x0a, 0x09, 0x09, 0x09, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e,
+					0x20, 0x66, 0x6d, 0x74, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x66, 0x28,
+					0x22, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x20, 0x73, 0x69,
+					0x67, 0x6e, 0x61, 0x6c, 0x20, 0x25, 0x73, 0x22, 0x2c, 0x20, 0x73, 0x69,
+					0x67, 0x29, 0x0a, 0x09, 0x09, 0x09, 0x63, 0x61, 0x73, 0x65, 0x20, 0x3c,
+					0x2d, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c, 0x49, 0x6e, 0x74, 0x65, 0x72,
+					0x72, 0x75, 0x70, 0x74, 0x3a, 0x0a, 0x09, 0x09, 0x09, 0x09, 0x72, 0x65,
+					0x74, 0x75, 0x72, 0x6e, 0x20, 0x6e, 0x69, 0x6c, 0x0a, 0x09, 0x09, 0x09,
+					0x7d, 0x0a, 0x09, 0x09, 0x7d, 0x2c, 0x20, 0x66, 0x75, 0x6e, 0x63, 0x28,
+					0x65, 0x72, 0x72, 0x6f, 0x72, 0x29, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x09,
+					0x63, 0x6c, 0x6f, 0x73, 0x65, 0x28, 0x63, 0x61, 0x6e, 0x63, 0x65, 0x6c,
+					0x49, 0x6e, 0x74, 0x65, 0x72, 0x72, 0x75, 0x70, 0x74, 0x29, 0x0a, 0x09,
+					0x09, 0x7d, 0x29, 0x0a, 0x09, 0x09, 0x64, 0x65, 0x66, 0x65, 0x72, 0x20,
+					0x63, 0x6c, 0x6f, 0x73, 0x65, 0x28, 0x63, 0x29, 0x0a, 0x09, 0x7d, 0x0a,
+					0x09, 0x2f, 0x2f, 0x20, 0x72, 0x75, 0x6e, 0x20, 0x74, 0x68, 0x65, 0x20,
+					0x67, 0x72, 0x6f, 0x75, 0x70, 0x0a, 0x09, 0x69, 0x66, 0x20, 0x65, 0x72,
+					0x72, 0x20, 0x3a, 0x3d, 0x20, 0x6c, 0x65, 0x76, 0x65, 0x6c, 0x2e, 0x45,
+					0x72, 0x72, 0x6f, 0x72, 0x28, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+					0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x73, 0x65, 0x72,
+					0x76, 0x69, 0x63, 0x65, 0x4c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x29, 0x2e,
+					0x4c, 0x6f, 0x67, 0x28, 0x22, 0x65, 0x78, 0x69, 0x74, 0x22, 0x2c, 0x20,
+					0x67, 0x2e, 0x52, 0x75, 0x6e, 0x28, 0x29, 0x29, 0x3b, 0x20, 0x65, 0x72,
+					0x72, 0x20, 0x21, 0x3d, 0x20, 0x6e, 0x69, 0x6c, 0x20, 0x7b, 0x0a, 0x09,
+					0x09, 0x70, 0x72, 0x69, 0x6e, 0x74, 0x6c, 0x6e, 0x28, 0x22, 0x65, 0x72,
+					0x72, 0x6f, 0x72, 0x20, 0x77, 0x68, 0x69, 0x6c, 0x65, 0x20, 0x77, 0x72,
+					0x69, 0x74, 0x69, 0x6e, 0x67, 0x20, 0x6c, 0x6f, 0x67, 0x2c, 0x20, 0x74,
+					0x68, 0x69, 0x73, 0x20, 0x73, 0x68, 0x6f, 0x75, 0x6c, 0x64, 0x20, 0x6e,
+					0x65, 0x76, 0x65, 0x72, 0x20, 0x68, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x2e,
+					0x2e, 0x2e, 0x22, 0x29, 0x0a, 0x09, 0x09, 0x64, 0x65, 0x62, 0x75, 0x67,
+					0x2e, 0x50, 0x72, 0x69, 0x6e, 0x74, 0x53, 0x74, 0x61, 0x63, 0x6b, 0x28,
+					0x29, 0x0a, 0x09, 0x7d, 0x0a, 0x7d, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20,
+					0x73, 0x65, 0x74, 0x75, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f,
+					0x72, 0x74, 0x73, 0x28, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20,
+					0x67, 0x65, 0x6e, 0x65, 0x72, 0x61, 0x74, 0x65, 0x64, 0x53, 0x65, 0x72,
+					0x76, 0x69, 0x63, 0x65, 0x2c, 0x20, 0x67, 0x20, 0x2a, 0x72, 0x75, 0x6e,
+					0x2e, 0x47, 0x72, 0x6f, 0x75, 0x70, 0x29, 0x20, 0x65, 0x72, 0x72, 0x6f,
+					0x72, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x7b, 0x69, 0x66,
+					0x20, 0x2e, 0x48, 0x61, 0x73, 0x48, 0x74, 0x74, 0x70, 0x28, 0x29, 0x7d,
+					0x7d, 0x0a, 0x09, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x2c,
+					0x20, 0x65, 0x72, 0x72, 0x20, 0x3a, 0x3d, 0x20, 0x6e, 0x65, 0x74, 0x2e,
+					0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x28, 0x22, 0x74, 0x63, 0x70, 0x22,
+					0x2c, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x74, 0x72,
+					0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e, 0x68, 0x74, 0x74,
+					0x70, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x28, 0x29, 0x29,
+					0x0a, 0x09, 0x69, 0x66, 0x20, 0x65, 0x72, 0x72, 0x20, 0x21, 0x3d, 0x20,
+					0x6e, 0x69, 0x6c, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x72, 0x65, 0x74, 0x75,
+					0x72, 0x6e, 0x20, 0x65, 0x72, 0x72, 0x0a, 0x09, 0x7d, 0x0a, 0x09, 0x67,
+					0x2e, 0x41, 0x64, 0x64, 0x28, 0x66, 0x75, 0x6e, 0x63, 0x28, 0x29, 0x20,
+					0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x5f, 0x20,
+					0x3d, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x6f, 0x70,
+					0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+					0x65, 0x4c, 0x6f, 0x67, 0x67, 0x65, 0x72, 0x2e, 0x4c, 0x6f, 0x67, 0x28,
+					0x22, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x2c,
+					0x20, 0x22, 0x48, 0x54, 0x54, 0x50, 0x22, 0x2c, 0x20, 0x22, 0x61, 0x64,
+					0x64, 0x72, 0x22, 0x2c, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+					0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x2e,
+					0x68, 0x74, 0x74, 0x70, 0x2e, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+					0x28, 0x29, 0x29, 0x0a, 0x09, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e,
+					0x20, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x28,
+					0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x2c, 0x20, 0x73, 0x65,
+					0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70,
+					0x6f, 0x72, 0x74, 0x73, 0x2e, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x52, 0x6f,
+					0x75, 0x74, 0x65, 0x72, 0x28, 0x29, 0x29, 0x0a, 0x09, 0x7d, 0x2c, 0x20,
+					0x66, 0x75, 0x6e, 0x63, 0x28, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x29, 0x20,
+					0x7b, 0x0a, 0x09, 0x09, 0x65, 0x72, 0x72, 0x20, 0x3a, 0x3d, 0x20, 0x6c,
+					0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x2e, 0x43, 0x6c, 0x6f, 0x73,
+					0x65, 0x28, 0x29, 0x0a, 0x09, 0x09, 0x69, 0x66, 0x20, 0x65, 0x72, 0x72,
+					0x20, 0x21, 0x3d, 0x20, 0x6e, 0x69, 0x6c, 0x20, 0x7b, 0x0a, 0x09, 0x09,
+					0x09, 0x66, 0x6d, 0x74, 0x2e, 0x50, 0x72, 0x69, 0x6e, 0x74, 0x66, 0x28,
+					0x22, 0x54, 0x68, 0x65, 0x72, 0x65, 0x20, 0x77, 0x68, 0x65, 0x72, 0x65,
+					0x20, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x65, 0x64, 0x20, 0x41, 0x63, 0x63,
+					0x65, 0x70, 0x74, 0x20, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f,
+					0x6e, 0x73, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x63, 0x6c, 0x6f, 0x73,
+					0x69, 0x6e, 0x67, 0x20, 0x6c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72,
+					0x20, 0x3a, 0x20, 0x25, 0x76, 0x22, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x29,
+					0x0a, 0x09, 0x09, 0x7d, 0x0a, 0x09, 0x7d, 0x29, 0x0a, 0x20, 0x20, 0x20,
+					0x20, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x7b, 0x7b, 0x69, 0x66,
+					0x20, 0x2e, 0x47, 0x52, 0x50, 0x43, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70,
+					0x6f, 0x72, 0x74, 0x7d, 0x7d, 0x0a, 0x09, 0x67, 0x72, 0x70, 0x63, 0x4c,
+					0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x2c, 0x20, 0x65, 0x72, 0x72,
+					0x20, 0x3a, 0x3d, 0x20, 0x6e, 0x65, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74,
+					0x65, 0x6e, 0x28, 0x22, 0x74, 0x63, 0x70, 0x22, 0x2c, 0x20, 0x73, 0x65,
+					0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70,
+					0x6f, 0x72, 0x74, 0x73, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x64,
+					0x64, 0x72, 0x65, 0x73, 0x73, 0x28, 0x29, 0x29, 0x0a, 0x09, 0x69, 0x66,
+					0x20, 0x65, 0x72, 0x72, 0x20, 0x21, 0x3d, 0x20, 0x6e, 0x69, 0x6c, 0x20,
+					0x7b, 0x0a, 0x09, 0x09, 0x5f, 0x20, 0x3d, 0x20, 0x73, 0x65, 0x72, 0x76,
+					0x69, 0x63, 0x65, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e,
+					0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x6f, 0x67, 0x67, 0x65,
+					0x72, 0x2e, 0x4c, 0x6f, 0x67, 0x28, 0x22, 0x74, 0x72, 0x61, 0x6e, 0x73,
+					0x70, 0x6f, 0x72, 0x74, 0x22, 0x2c, 0x20, 0x22, 0x67, 0x52, 0x50, 0x43,
+					0x22, 0x2c, 0x20, 0x22, 0x64, 0x75, 0x72, 0x69, 0x6e, 0x67, 0x22, 0x2c,
+					0x20, 0x22, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x22, 0x2c, 0x20, 0x22,
+					0x65, 0x72, 0x72, 0x22, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x29, 0x0a, 0x09,
+					0x09, 0x6f, 0x73, 0x2e, 0x45, 0x78, 0x69, 0x74, 0x28, 0x31, 0x29, 0x0a,
+					0x09, 0x7d, 0x0a, 0x09, 0x67, 0x2e, 0x41, 0x64, 0x64, 0x28, 0x66, 0x75,
+					0x6e, 0x63, 0x28, 0x29, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20, 0x7b,
+					0x0a, 0x09, 0x09, 0x5f, 0x20, 0x3d, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69,
+					0x63, 0x65, 0x2e, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x73,
+					0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x4c, 0x6f, 0x67, 0x67, 0x65, 0x72,
+					0x2e, 0x4c, 0x6f, 0x67, 0x28, 0x22, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70,
+					0x6f, 0x72, 0x74, 0x22, 0x2c, 0x20, 0x22, 0x67, 0x52, 0x50, 0x43, 0x22,
+					0x2c, 0x20, 0x22, 0x61, 0x64, 0x64, 0x72, 0x22, 0x2c, 0x20, 0x73, 0x65,
+					0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70,
+					0x6f, 0x72, 0x74, 0x73, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x41, 0x64,
+					0x64, 0x72, 0x65, 0x73, 0x73, 0x28, 0x29, 0x29, 0x0a, 0x09, 0x09, 0x2f,
+					0x2f, 0x20, 0x77, 0x65, 0x20, 0x61, 0x64, 0x64, 0x20, 0x74, 0x68, 0x65,
+					0x20, 0x47, 0x6f, 0x20, 0x4b, 0x69, 0x74, 0x20, 0x67, 0x52, 0x50, 0x43,
+					0x20, 0x49, 0x6e, 0x74, 0x65, 0x72, 0x63, 0x65, 0x70, 0x74, 0x6f, 0x72,
+					0x20, 0x74, 0x6f, 0x20, 0x6f, 0x75, 0x72, 0x20, 0x67, 0x52, 0x50, 0x43,
+					0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x61, 0x73, 0x20,
+					0x69, 0x74, 0x20, 0x69, 0x73, 0x20, 0x75, 0x73, 0x65, 0x64, 0x20, 0x62,
+					0x79, 0x0a, 0x09, 0x09, 0x2f, 0x2f, 0x20, 0x74, 0x68, 0x65, 0x20, 0x68,
+					0x65, 0x72, 0x65, 0x20, 0x64, 0x65, 0x6d, 0x6f, 0x6e, 0x73, 0x74, 0x72,
+					0x61, 0x74, 0x65, 0x64, 0x20, 0x7a, 0x69, 0x70, 0x6b, 0x69, 0x6e, 0x20,
+					0x74, 0x72, 0x61, 0x63, 0x69, 0x6e, 0x67, 0x20, 0x6d, 0x69, 0x64, 0x64,
+					0x6c, 0x65, 0x77, 0x61, 0x72, 0x65, 0x2e, 0x0a, 0x09, 0x09, 0x62, 0x61,
+					0x73, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x20, 0x3a, 0x3d, 0x20,
+					0x67, 0x72, 0x70, 0x63, 0x2e, 0x4e, 0x65, 0x77, 0x53, 0x65, 0x72, 0x76,
+					0x65, 0x72, 0x28, 0x29, 0x0a, 0x09, 0x09, 0x67, 0x65, 0x6e, 0x47, 0x72,
+					0x70, 0x63, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e,
+					0x52, 0x65, 0x67, 0x69, 0x73, 0x74, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76,
+					0x69, 0x63, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x28, 0x62, 0x61,
+					0x73, 0x65, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x2c, 0x20, 0x73, 0x65,
+					0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70,
+					0x6f, 0x72, 0x74, 0x73, 0x2e, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x53, 0x65,
+					0x72, 0x76, 0x65, 0x72, 0x28, 0x29, 0x29, 0x0a, 0x09, 0x09, 0x72, 0x65,
+					0x74, 0x75, 0x72, 0x6e, 0x20, 0x62, 0x61, 0x73, 0x65, 0x53, 0x65, 0x72,
+					0x76, 0x65, 0x72, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x28, 0x67, 0x72,
+					0x70, 0x63, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72, 0x29, 0x0a,
+					0x09, 0x7d, 0x2c, 0x20, 0x66, 0x75, 0x6e, 0x63, 0x28, 0x65, 0x72, 0x72,
+					0x6f, 0x72, 0x29, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x5f, 0x20, 0x3d, 0x20,
+					0x67, 0x72, 0x70, 0x63, 0x4c, 0x69, 0x73, 0x74, 0x65, 0x6e, 0x65, 0x72,
+					0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x28, 0x29, 0x0a, 0x09, 0x7d, 0x29,
+					0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x7b, 0x20, 0x65, 0x6e, 0x64, 0x20,
+					0x7d, 0x7d, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x6e,
+					0x69, 0x6c, 0x0a, 0x7d, 0x0a,
 				},
 				fi: FileInfo{
 					name:    "service.jet",
-					size:    4484,
-					modTime: time.Unix(0, 1587862114481683401),
+					size:    5453,
+					modTime: time.Unix(0, 1792312517641050983),
 					isDir:   false,
 				},
 			}, "/assets/service/gen/service/service.jet": {
@@ -1548,98 +2067,99 @@ func init() {
 					0x7b, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x7d, 0x7d, 0x22, 0x0a,
 					0x09, 0x22, 0x7b, 0x7b, 0x20, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
 					0x20, 0x7d, 0x7d, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x75, 0x74, 0x69, 0x6c,
-					0x73, 0x22, 0x0a, 0x09, 0x7b, 0x7b, 0x73, 0x76, 0x63, 0x49, 0x6d, 0x70,
-					0x6f, 0x72, 0x74, 0x20, 0x3a, 0x3d, 0x20, 0x2e, 0x49, 0x6d, 0x70, 0x6f,
-					0x72, 0x74, 0x20, 0x7d, 0x7d, 0x0a, 0x09, 0x7b, 0x7b, 0x20, 0x72, 0x61,
-					0x6e, 0x67, 0x65, 0x20, 0x2e, 0x47, 0x52, 0x50, 0x43, 0x54, 0x72, 0x61,
-					0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x47, 0x52, 0x50, 0x43, 0x45,
-					0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x7d, 0x7d, 0x7b, 0x7b, 0x20,
-					0x72, 0x61, 0x6e, 0x67, 0x65, 0x20, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61,
-					0x67, 0x65, 0x73, 0x7d, 0x7d, 0x7b, 0x7b, 0x20, 0x69, 0x66, 0x20, 0x2e,
-					0x54, 0x79, 0x70, 0x65, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x20,
-					0x26, 0x26, 0x20, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x49, 0x6d, 0x70,
-					0x6f, 0x72, 0x74, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x20, 0x21, 0x3d, 0x20,
-					0x73, 0x76, 0x63, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x20, 0x7d, 0x7d,
-					0x20, 0x7b, 0x7b, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x49, 0x6d, 0x70,
-					0x6f, 0x72, 0x74, 0x2e, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x7d, 0x7d, 0x20,
-					0x22, 0x7b, 0x7b, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x49, 0x6d, 0x70,
-					0x6f, 0x72, 0x74, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x7d, 0x7d, 0x22, 0x20,
-					0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x0a, 0x09, 0x7b, 0x7b, 0x65,
-					0x6e, 0x64, 0x7d, 0x7d, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x0a,
-					0x29, 0x0a, 0x7b, 0x7b, 0x20, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x20, 0x2e,
-					0x47, 0x52, 0x50, 0x43, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72,
-					0x74, 0x2e, 0x47, 0x52, 0x50, 0x43, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69,
-					0x6e, 0x74, 0x7d, 0x7d, 0x0a, 0x7b, 0x7b, 0x20, 0x72, 0x61, 0x6e, 0x67,
-					0x65, 0x20, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x7d,
-					0x7d, 0x0a, 0x7b, 0x7b, 0x20, 0x69, 0x66, 0x20, 0x2e, 0x54, 0x79, 0x70,
-					0x65, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x20, 0x7d, 0x7d, 0x0a,
-					0x20, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65,
-					0x7b, 0x7b, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x28, 0x72, 0x20,
-					0x2a, 0x7b, 0x7b, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x29, 0x20,
-					0x2a, 0x7b, 0x7b, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x49, 0x6d, 0x70,
-					0x6f, 0x72, 0x74, 0x2e, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x7d, 0x7d, 0x2e,
-					0x7b, 0x7b, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x51, 0x75, 0x61, 0x6c,
-					0x69, 0x66, 0x69, 0x65, 0x72, 0x7d, 0x7d, 0x7b, 0x0a, 0x20, 0x20, 0x20,
-					0x20, 0x69, 0x66, 0x20, 0x72, 0x20, 0x3d, 0x3d, 0x20, 0x6e, 0x69, 0x6c,
-					0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x72,
-					0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x20, 0x26, 0x7b, 0x7b, 0x2e, 0x54,
-					0x79, 0x70, 0x65, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x41,
-					0x6c, 0x69, 0x61, 0x73, 0x7d, 0x7d, 0x2e, 0x7b, 0x7b, 0x2e, 0x54, 0x79,
-					0x70, 0x65, 0x2e, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x72,
-					0x7d, 0x7d, 0x7b, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x0a, 0x20,
-					0x20, 0x20, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x26, 0x7b,
+					0x73, 0x22, 0x0a, 0x09, 0x7b, 0x7b, 0x20, 0x72, 0x61, 0x6e, 0x67, 0x65,
+					0x20, 0x2e, 0x47, 0x52, 0x50, 0x43, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70,
+					0x6f, 0x72, 0x74, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x7d,
+					0x7d, 0x20, 0x7b, 0x7b, 0x2e, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x7d, 0x7d,
+					0x20, 0x22, 0x7b, 0x7b, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x7d, 0x7d, 0x22,
+					0x0a, 0x09, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x0a, 0x29, 0x0a,
+					0x7b, 0x7b, 0x20, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x20, 0x2e, 0x47, 0x52,
+					0x50, 0x43, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e,
+					0x47, 0x52, 0x50, 0x43, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+					0x7d, 0x7d, 0x0a, 0x7b, 0x7b, 0x20, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x20,
+					0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x7d, 0x7d, 0x0a,
+					0x7b, 0x7b, 0x20, 0x69, 0x66, 0x20, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x2e,
+					0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x20, 0x7d, 0x7d, 0x0a, 0x20, 0x66,
+					0x75, 0x6e, 0x63, 0x20, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x7b, 0x7b,
+					0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x28, 0x72, 0x20, 0x2a, 0x7b,
+					0x7b, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x29, 0x20, 0x2a, 0x7b,
 					0x7b, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72,
 					0x74, 0x2e, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x7d, 0x7d, 0x2e, 0x7b, 0x7b,
 					0x2e, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x66,
-					0x69, 0x65, 0x72, 0x7d, 0x7d, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x69, 0x65, 0x72, 0x7d, 0x7d, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x69,
+					0x66, 0x20, 0x72, 0x20, 0x3d, 0x3d, 0x20, 0x6e, 0x69, 0x6c, 0x20, 0x7b,
+					0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x72, 0x65, 0x74,
+					0x75, 0x72, 0x6e, 0x20, 0x20, 0x26, 0x7b, 0x7b, 0x2e, 0x54, 0x79, 0x70,
+					0x65, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x41, 0x6c, 0x69,
+					0x61, 0x73, 0x7d, 0x7d, 0x2e, 0x7b, 0x7b, 0x2e, 0x54, 0x79, 0x70, 0x65,
+					0x2e, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x72, 0x7d, 0x7d,
+					0x7b, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x0a, 0x20, 0x20, 0x20,
+					0x20, 0x72, 0x65, 0x73, 0x20, 0x3a, 0x3d, 0x20, 0x26, 0x7b, 0x7b, 0x2e,
+					0x54, 0x79, 0x70, 0x65, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x2e,
+					0x41, 0x6c, 0x69, 0x61, 0x73, 0x7d, 0x7d, 0x2e, 0x7b, 0x7b, 0x2e, 0x54,
+					0x79, 0x70, 0x65, 0x2e, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x65,
+					0x72, 0x7d, 0x7d, 0x7b, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x7b,
+					0x20, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x20, 0x65, 0x6d, 0x62, 0x65, 0x64,
+					0x20, 0x3a, 0x3d, 0x20, 0x2e, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x73, 0x7d,
+					0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x72, 0x65, 0x73, 0x2e, 0x7b, 0x7b,
+					0x65, 0x6d, 0x62, 0x65, 0x64, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x7d, 0x7d,
+					0x20, 0x3d, 0x20, 0x26, 0x7b, 0x7b, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x2e,
+					0x54, 0x79, 0x70, 0x65, 0x7d, 0x7d, 0x7b, 0x7d, 0x0a, 0x20, 0x20, 0x20,
+					0x20, 0x7b, 0x7b, 0x20, 0x65, 0x6e, 0x64, 0x20, 0x7d, 0x7d, 0x0a, 0x20,
 					0x20, 0x20, 0x20, 0x7b, 0x7b, 0x20, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x20,
 					0x70, 0x61, 0x72, 0x61, 0x6d, 0x20, 0x3a, 0x3d, 0x20, 0x2e, 0x50, 0x61,
-					0x72, 0x61, 0x6d, 0x73, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
-					0x20, 0x20, 0x20, 0x7b, 0x7b, 0x20, 0x69, 0x66, 0x20, 0x21, 0x70, 0x61,
-					0x72, 0x61, 0x6d, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x20,
-					0x7d, 0x7d, 0x7b, 0x7b, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x2e, 0x47, 0x6f,
-					0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x3a, 0x7b, 0x7b, 0x69, 0x66, 0x20,
+					0x72, 0x61, 0x6d, 0x73, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b,
+					0x7b, 0x20, 0x69, 0x66, 0x20, 0x21, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x2e,
+					0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x20, 0x7d, 0x7d, 0x72, 0x65,
+					0x73, 0x2e, 0x7b, 0x7b, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x2e, 0x47, 0x6f,
+					0x50, 0x61, 0x74, 0x68, 0x7d, 0x7d, 0x20, 0x3d, 0x20, 0x7b, 0x7b, 0x69,
+					0x66, 0x20, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x2e, 0x47, 0x6f, 0x54, 0x79,
+					0x70, 0x65, 0x2e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x7d, 0x7d,
+					0x26, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x7b, 0x7b, 0x69, 0x66,
+					0x20, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x64,
+					0x7d, 0x7d, 0x7b, 0x7b, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x2e, 0x4e, 0x61,
+					0x6d, 0x65, 0x64, 0x7d, 0x7d, 0x28, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d,
+					0x7d, 0x7b, 0x7b, 0x69, 0x66, 0x20, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x2e,
+					0x42, 0x61, 0x73, 0x69, 0x63, 0x2e, 0x51, 0x75, 0x61, 0x6c, 0x69, 0x66,
+					0x69, 0x65, 0x72, 0x20, 0x3d, 0x3d, 0x20, 0x22, 0x69, 0x6e, 0x74, 0x22,
+					0x7d, 0x7d, 0x7b, 0x7b, 0x69, 0x66, 0x20, 0x70, 0x61, 0x72, 0x61, 0x6d,
+					0x2e, 0x42, 0x61, 0x73, 0x69, 0x63, 0x2e, 0x41, 0x72, 0x72, 0x61, 0x79,
+					0x54, 0x79, 0x70, 0x65, 0x7d, 0x7d, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e,
+					0x49, 0x6e, 0x74, 0x36, 0x34, 0x41, 0x72, 0x72, 0x54, 0x6f, 0x49, 0x6e,
+					0x74, 0x41, 0x72, 0x72, 0x7b, 0x7b, 0x65, 0x6c, 0x73, 0x65, 0x7d, 0x7d,
+					0x69, 0x6e, 0x74, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x28, 0x72,
+					0x2e, 0x7b, 0x7b, 0x20, 0x63, 0x61, 0x6d, 0x65, 0x6c, 0x43, 0x61, 0x73,
+					0x65, 0x28, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x2e, 0x4e, 0x61, 0x6d, 0x65,
+					0x29, 0x20, 0x7d, 0x7d, 0x29, 0x7b, 0x7b, 0x65, 0x6c, 0x73, 0x65, 0x7d,
+					0x7d, 0x72, 0x2e, 0x7b, 0x7b, 0x20, 0x63, 0x61, 0x6d, 0x65, 0x6c, 0x43,
+					0x61, 0x73, 0x65, 0x28, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x2e, 0x4e, 0x61,
+					0x6d, 0x65, 0x29, 0x20, 0x7d, 0x7d, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d,
+					0x7d, 0x7b, 0x7b, 0x69, 0x66, 0x20, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x2e,
+					0x4e, 0x61, 0x6d, 0x65, 0x64, 0x7d, 0x7d, 0x29, 0x7b, 0x7b, 0x65, 0x6e,
+					0x64, 0x7d, 0x7d, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x0a, 0x20,
+					0x20, 0x20, 0x20, 0x7b, 0x7b, 0x20, 0x69, 0x66, 0x20, 0x70, 0x61, 0x72,
+					0x61, 0x6d, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x20, 0x7d,
+					0x7d, 0x72, 0x65, 0x73, 0x2e, 0x7b, 0x7b, 0x70, 0x61, 0x72, 0x61, 0x6d,
+					0x2e, 0x47, 0x6f, 0x50, 0x61, 0x74, 0x68, 0x7d, 0x7d, 0x20, 0x3d, 0x20,
+					0x7b, 0x7b, 0x69, 0x66, 0x20, 0x21, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x2e,
+					0x47, 0x6f, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x50, 0x6f, 0x69, 0x6e, 0x74,
+					0x65, 0x72, 0x7d, 0x7d, 0x2a, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d,
+					0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x7b, 0x7b, 0x70, 0x61, 0x72, 0x61,
+					0x6d, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x4e, 0x61,
+					0x6d, 0x65, 0x7d, 0x7d, 0x7b, 0x7b, 0x69, 0x66, 0x20, 0x70, 0x61, 0x72,
+					0x61, 0x6d, 0x2e, 0x47, 0x6f, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x41, 0x72,
+					0x72, 0x61, 0x79, 0x54, 0x79, 0x70, 0x65, 0x7d, 0x7d, 0x41, 0x72, 0x72,
+					0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x7b, 0x7b, 0x69, 0x66, 0x20,
 					0x70, 0x61, 0x72, 0x61, 0x6d, 0x2e, 0x47, 0x6f, 0x54, 0x79, 0x70, 0x65,
-					0x2e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x7d, 0x7d, 0x26, 0x7b,
-					0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x7b, 0x7b, 0x69, 0x66, 0x20, 0x70,
-					0x61, 0x72, 0x61, 0x6d, 0x2e, 0x47, 0x6f, 0x54, 0x79, 0x70, 0x65, 0x2e,
-					0x51, 0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x72, 0x20, 0x3d, 0x3d,
-					0x20, 0x22, 0x69, 0x6e, 0x74, 0x22, 0x7d, 0x7d, 0x7b, 0x7b, 0x69, 0x66,
-					0x20, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x2e, 0x47, 0x6f, 0x54, 0x79, 0x70,
-					0x65, 0x2e, 0x41, 0x72, 0x72, 0x61, 0x79, 0x54, 0x79, 0x70, 0x65, 0x7d,
-					0x7d, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x36, 0x34,
-					0x41, 0x72, 0x72, 0x54, 0x6f, 0x49, 0x6e, 0x74, 0x41, 0x72, 0x72, 0x7b,
-					0x7b, 0x65, 0x6c, 0x73, 0x65, 0x7d, 0x7d, 0x69, 0x6e, 0x74, 0x7b, 0x7b,
-					0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x28, 0x72, 0x2e, 0x7b, 0x7b, 0x20, 0x63,
-					0x61, 0x6d, 0x65, 0x6c, 0x43, 0x61, 0x73, 0x65, 0x28, 0x70, 0x61, 0x72,
-					0x61, 0x6d, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x29, 0x20, 0x7d, 0x7d, 0x29,
-					0x7b, 0x7b, 0x65, 0x6c, 0x73, 0x65, 0x7d, 0x7d, 0x72, 0x2e, 0x7b, 0x7b,
-					0x20, 0x63, 0x61, 0x6d, 0x65, 0x6c, 0x43, 0x61, 0x73, 0x65, 0x28, 0x70,
-					0x61, 0x72, 0x61, 0x6d, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x29, 0x20, 0x7d,
-					0x7d, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x2c, 0x7b, 0x7b, 0x65,
-					0x6e, 0x64, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
-					0x20, 0x7b, 0x7b, 0x20, 0x69, 0x66, 0x20, 0x70, 0x61, 0x72, 0x61, 0x6d,
-					0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x20, 0x7d, 0x7d, 0x7b,
-					0x7b, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x2e, 0x47, 0x6f, 0x4e, 0x61, 0x6d,
-					0x65, 0x7d, 0x7d, 0x3a, 0x7b, 0x7b, 0x69, 0x66, 0x20, 0x21, 0x70, 0x61,
-					0x72, 0x61, 0x6d, 0x2e, 0x47, 0x6f, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x50,
-					0x6f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x7d, 0x7d, 0x2a, 0x7b, 0x7b, 0x65,
-					0x6e, 0x64, 0x7d, 0x7d, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x7b, 0x7b,
-					0x70, 0x61, 0x72, 0x61, 0x6d, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
-					0x65, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x7b, 0x7b, 0x69, 0x66,
-					0x20, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x2e, 0x47, 0x6f, 0x54, 0x79, 0x70,
-					0x65, 0x2e, 0x41, 0x72, 0x72, 0x61, 0x79, 0x54, 0x79, 0x70, 0x65, 0x7d,
-					0x7d, 0x41, 0x72, 0x72, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x7b,
-					0x7b, 0x69, 0x66, 0x20, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x2e, 0x47, 0x6f,
-					0x54, 0x79, 0x70, 0x65, 0x2e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x65, 0x72,
-					0x41, 0x72, 0x72, 0x61, 0x79, 0x54, 0x79, 0x70, 0x65, 0x7d, 0x7d, 0x50,
-					0x6f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d,
-					0x7d, 0x28, 0x72, 0x2e, 0x7b, 0x7b, 0x20, 0x63, 0x61, 0x6d, 0x65, 0x6c,
-					0x43, 0x61, 0x73, 0x65, 0x28, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x2e, 0x4e,
-					0x61, 0x6d, 0x65, 0x29, 0x20, 0x7d, 0x7d, 0x29, 0x2c, 0x7b, 0x7b, 0x65,
-					0x6e, 0x64, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
-					0x20, 0x7b, 0x7b, 0x20, 0x65, 0x6e, 0x64, 0x20, 0x7d, 0x7d, 0x0a, 0x20,
-					0x20, 0x20, 0x20, 0x7d, 0x0a, 0x20, 0x7d, 0x0a, 0x20, 0x66, 0x75, 0x6e,
+					0x2e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x41, 0x72, 0x72, 0x61,
+					0x79, 0x54, 0x79, 0x70, 0x65, 0x7d, 0x7d, 0x50, 0x6f, 0x69, 0x6e, 0x74,
+					0x65, 0x72, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x28, 0x72, 0x2e,
+					0x7b, 0x7b, 0x20, 0x63, 0x61, 0x6d, 0x65, 0x6c, 0x43, 0x61, 0x73, 0x65,
+					0x28, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x29,
+					0x20, 0x7d, 0x7d, 0x29, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x0a,
+					0x20, 0x20, 0x20, 0x20, 0x7b, 0x7b, 0x20, 0x65, 0x6e, 0x64, 0x20, 0x7d,
+					0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e,
+					0x20, 0x72, 0x65, 0x73, 0x0a, 0x20, 0x7d, 0x0a, 0x20, 0x66, 0x75, 0x6e,
 					0x63, 0x20, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x7b, 0x7b, 0x2e, 0x4e,
 					0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x41, 0x72, 0x72, 0x28, 0x72, 0x20, 0x5b,
 					0x5d, 0x2a, 0x7b, 0x7b, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x29,
@@ -1693,32 +2213,60 @@ func init() {
 					0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x72, 0x65,
 					0x74, 0x75, 0x72, 0x6e, 0x20, 0x20, 0x26, 0x7b, 0x7b, 0x2e, 0x4e, 0x61,
 					0x6d, 0x65, 0x7d, 0x7d, 0x7b, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
-					0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72,
-					0x6e, 0x20, 0x26, 0x7b, 0x7b, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d,
-					0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7b,
-					0x7b, 0x20, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x20, 0x70, 0x61, 0x72, 0x61,
-					0x6d, 0x20, 0x3a, 0x3d, 0x20, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
-					0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
-					0x7b, 0x7b, 0x20, 0x69, 0x66, 0x20, 0x21, 0x70, 0x61, 0x72, 0x61, 0x6d,
-					0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x20, 0x7d, 0x7d, 0x7b,
-					0x7b, 0x20, 0x63, 0x61, 0x6d, 0x65, 0x6c, 0x43, 0x61, 0x73, 0x65, 0x28,
-					0x70, 0x61, 0x72, 0x61, 0x6d, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x29, 0x20,
-					0x7d, 0x7d, 0x3a, 0x7b, 0x7b, 0x69, 0x66, 0x20, 0x70, 0x61, 0x72, 0x61,
-					0x6d, 0x2e, 0x47, 0x6f, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x50, 0x6f, 0x69,
-					0x6e, 0x74, 0x65, 0x72, 0x7d, 0x7d, 0x2a, 0x7b, 0x7b, 0x65, 0x6e, 0x64,
-					0x7d, 0x7d, 0x7b, 0x7b, 0x69, 0x66, 0x20, 0x70, 0x61, 0x72, 0x61, 0x6d,
-					0x2e, 0x47, 0x6f, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x51, 0x75, 0x61, 0x6c,
-					0x69, 0x66, 0x69, 0x65, 0x72, 0x20, 0x3d, 0x3d, 0x20, 0x22, 0x69, 0x6e,
-					0x74, 0x22, 0x7d, 0x7d, 0x7b, 0x7b, 0x69, 0x66, 0x20, 0x70, 0x61, 0x72,
-					0x61, 0x6d, 0x2e, 0x47, 0x6f, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x41, 0x72,
-					0x72, 0x61, 0x79, 0x54, 0x79, 0x70, 0x65, 0x7d, 0x7d, 0x75, 0x74, 0x69,
-					0x6c, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x41, 0x72, 0x72, 0x54, 0x6f, 0x49,
-					0x6e, 0x74, 0x36, 0x34, 0x41, 0x72, 0x72, 0x7b, 0x7b, 0x65, 0x6c, 0x73,
-					0x65, 0x7d, 0x7d, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x7b, 0x7b, 0x65, 0x6e,
-					0x64, 0x7d, 0x7d, 0x28, 0x72, 0x2e, 0x7b, 0x7b, 0x70, 0x61, 0x72, 0x61,
-					0x6d, 0x2e, 0x47, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x29, 0x7b,
+					0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x7b, 0x20, 0x69, 0x66,
+					0x20, 0x2e, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x73, 0x20, 0x7d, 0x7d, 0x0a,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x2f, 0x2f, 0x20, 0x74, 0x68, 0x65, 0x20,
+					0x65, 0x6d, 0x62, 0x65, 0x64, 0x64, 0x65, 0x64, 0x20, 0x70, 0x6f, 0x69,
+					0x6e, 0x74, 0x65, 0x72, 0x73, 0x20, 0x61, 0x72, 0x65, 0x20, 0x73, 0x65,
+					0x74, 0x20, 0x6f, 0x6e, 0x20, 0x61, 0x20, 0x63, 0x6f, 0x70, 0x79, 0x20,
+					0x73, 0x6f, 0x20, 0x74, 0x68, 0x65, 0x20, 0x70, 0x72, 0x6f, 0x6d, 0x6f,
+					0x74, 0x65, 0x64, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x20, 0x63,
+					0x61, 0x6e, 0x20, 0x62, 0x65, 0x20, 0x72, 0x65, 0x61, 0x64, 0x0a, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x63, 0x20, 0x3a, 0x3d, 0x20, 0x2a, 0x72, 0x0a,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x72, 0x20, 0x3d, 0x20, 0x26, 0x63, 0x0a,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x7b, 0x20, 0x72, 0x61, 0x6e, 0x67,
+					0x65, 0x20, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x20, 0x3a, 0x3d, 0x20, 0x2e,
+					0x45, 0x6d, 0x62, 0x65, 0x64, 0x73, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x69, 0x66, 0x20, 0x72, 0x2e, 0x7b, 0x7b, 0x65, 0x6d, 0x62,
+					0x65, 0x64, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x7d, 0x7d, 0x20, 0x3d, 0x3d,
+					0x20, 0x6e, 0x69, 0x6c, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x72, 0x2e, 0x7b, 0x7b, 0x65, 0x6d, 0x62, 0x65,
+					0x64, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x7d, 0x7d, 0x20, 0x3d, 0x20, 0x26,
+					0x7b, 0x7b, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x2e, 0x54, 0x79, 0x70, 0x65,
+					0x7d, 0x7d, 0x7b, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x0a,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x7b, 0x20, 0x65, 0x6e, 0x64, 0x20,
+					0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x7b, 0x20, 0x65,
+					0x6e, 0x64, 0x20, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x72,
+					0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x26, 0x7b, 0x7b, 0x2e, 0x4e, 0x61,
+					0x6d, 0x65, 0x7d, 0x7d, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x7b, 0x7b, 0x20, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x20,
+					0x70, 0x61, 0x72, 0x61, 0x6d, 0x20, 0x3a, 0x3d, 0x20, 0x2e, 0x50, 0x61,
+					0x72, 0x61, 0x6d, 0x73, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x7b, 0x7b, 0x20, 0x69, 0x66, 0x20, 0x21, 0x70,
+					0x61, 0x72, 0x61, 0x6d, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+					0x20, 0x7d, 0x7d, 0x7b, 0x7b, 0x20, 0x63, 0x61, 0x6d, 0x65, 0x6c, 0x43,
+					0x61, 0x73, 0x65, 0x28, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x2e, 0x4e, 0x61,
+					0x6d, 0x65, 0x29, 0x20, 0x7d, 0x7d, 0x3a, 0x7b, 0x7b, 0x69, 0x66, 0x20,
+					0x70, 0x61, 0x72, 0x61, 0x6d, 0x2e, 0x47, 0x6f, 0x54, 0x79, 0x70, 0x65,
+					0x2e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x7d, 0x7d, 0x2a, 0x7b,
+					0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x7b, 0x7b, 0x69, 0x66, 0x20, 0x70,
+					0x61, 0x72, 0x61, 0x6d, 0x2e, 0x42, 0x61, 0x73, 0x69, 0x63, 0x2e, 0x51,
+					0x75, 0x61, 0x6c, 0x69, 0x66, 0x69, 0x65, 0x72, 0x20, 0x3d, 0x3d, 0x20,
+					0x22, 0x69, 0x6e, 0x74, 0x22, 0x7d, 0x7d, 0x7b, 0x7b, 0x69, 0x66, 0x20,
+					0x70, 0x61, 0x72, 0x61, 0x6d, 0x2e, 0x42, 0x61, 0x73, 0x69, 0x63, 0x2e,
+					0x41, 0x72, 0x72, 0x61, 0x79, 0x54, 0x79, 0x70, 0x65, 0x7d, 0x7d, 0x75,
+					0x74, 0x69, 0x6c, 0x73, 0x2e, 0x49, 0x6e, 0x74, 0x41, 0x72, 0x72, 0x54,
+					0x6f, 0x49, 0x6e, 0x74, 0x36, 0x34, 0x41, 0x72, 0x72, 0x7b, 0x7b, 0x65,
+					0x6c, 0x73, 0x65, 0x7d, 0x7d, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x7b, 0x7b,
+					0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x28, 0x72, 0x2e, 0x7b, 0x7b, 0x70, 0x61,
+					0x72, 0x61, 0x6d, 0x2e, 0x47, 0x6f, 0x50, 0x61, 0x74, 0x68, 0x7d, 0x7d,
+					0x29, 0x7b, 0x7b, 0x65, 0x6c, 0x73, 0x65, 0x20, 0x69, 0x66, 0x20, 0x70,
+					0x61, 0x72, 0x61, 0x6d, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x64, 0x7d, 0x7d,
+					0x7b, 0x7b, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x2e, 0x42, 0x61, 0x73, 0x69,
+					0x63, 0x7d, 0x7d, 0x28, 0x72, 0x2e, 0x7b, 0x7b, 0x70, 0x61, 0x72, 0x61,
+					0x6d, 0x2e, 0x47, 0x6f, 0x50, 0x61, 0x74, 0x68, 0x7d, 0x7d, 0x29, 0x7b,
 					0x7b, 0x65, 0x6c, 0x73, 0x65, 0x7d, 0x7d, 0x72, 0x2e, 0x7b, 0x7b, 0x70,
-					0x61, 0x72, 0x61, 0x6d, 0x2e, 0x47, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x7d,
+					0x61, 0x72, 0x61, 0x6d, 0x2e, 0x47, 0x6f, 0x50, 0x61, 0x74, 0x68, 0x7d,
 					0x7d, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x2c, 0x7b, 0x7b, 0x65,
 					0x6e, 0x64, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
 					0x20, 0x20, 0x7b, 0x7b, 0x20, 0x69, 0x66, 0x20, 0x70, 0x61, 0x72, 0x61,
@@ -1741,7 +2289,7 @@ func init() {
 					0x61, 0x6d, 0x2e, 0x47, 0x6f, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x41, 0x72,
 					0x72, 0x61, 0x79, 0x54, 0x79, 0x70, 0x65, 0x7d, 0x7d, 0x26, 0x7b, 0x7b,
 					0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x72, 0x2e, 0x7b, 0x7b, 0x70, 0x61, 0x72,
-					0x61, 0x6d, 0x2e, 0x47, 0x6f, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x29,
+					0x61, 0x6d, 0x2e, 0x47, 0x6f, 0x50, 0x61, 0x74, 0x68, 0x7d, 0x7d, 0x29,
 					0x2c, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x20,
 					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x7b, 0x20, 0x65, 0x6e, 0x64,
 					0x20, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x0a, 0x20,
@@ -1787,8 +2335,8 @@ func init() {
 				},
 				fi: FileInfo{
 					name:    "encode_decode.jet",
-					size:    2975,
-					modTime: time.Unix(0, 1587860644777562494),
+					size:    3323,
+					modTime: time.Unix(0, 1792309795156752606),
 					isDir:   false,
 				},
 			}, "/assets/service/gen/transport/grpc/grpc.jet": {
@@ -1963,382 +2511,431 @@ func init() {
 					0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x0a, 0x09, 0x22, 0x7b, 0x7b,
 					0x20, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x49, 0x6d,
 					0x70, 0x6f, 0x72, 0x74, 0x20, 0x7d, 0x7d, 0x2f, 0x67, 0x65, 0x6e, 0x2f,
-					0x75, 0x74, 0x69, 0x6c, 0x73, 0x22, 0x0a, 0x09, 0x22, 0x63, 0x6f, 0x6e,
-					0x74, 0x65, 0x78, 0x74, 0x22, 0x0a, 0x09, 0x67, 0x6f, 0x4b, 0x69, 0x74,
-					0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x20, 0x22, 0x67, 0x69,
-					0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x6f, 0x2d,
-					0x6b, 0x69, 0x74, 0x2f, 0x6b, 0x69, 0x74, 0x2f, 0x65, 0x6e, 0x64, 0x70,
-					0x6f, 0x69, 0x6e, 0x74, 0x22, 0x0a, 0x09, 0x67, 0x6f, 0x4b, 0x69, 0x74,
-					0x47, 0x52, 0x50, 0x43, 0x20, 0x22, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-					0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x6f, 0x2d, 0x6b, 0x69, 0x74, 0x2f,
-					0x6b, 0x69, 0x74, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72,
-					0x74, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x22, 0x0a, 0x09, 0x7b, 0x7b, 0x73,
-					0x76, 0x63, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x20, 0x3a, 0x3d, 0x20,
-					0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x49, 0x6d, 0x70,
-					0x6f, 0x72, 0x74, 0x20, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b,
-					0x7b, 0x72, 0x65, 0x71, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x20, 0x3a,
-					0x3d, 0x20, 0x2e, 0x47, 0x52, 0x50, 0x43, 0x45, 0x6e, 0x64, 0x70, 0x6f,
+					0x75, 0x74, 0x69, 0x6c, 0x73, 0x22, 0x0a, 0x09, 0x7b, 0x7b, 0x20, 0x69,
+					0x66, 0x20, 0x2e, 0x47, 0x52, 0x50, 0x43, 0x45, 0x6e, 0x64, 0x70, 0x6f,
 					0x69, 0x6e, 0x74, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
-					0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x6d, 0x70, 0x6f,
-					0x72, 0x74, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x7b, 0x72,
-					0x65, 0x73, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x20, 0x3a, 0x3d, 0x20,
-					0x2e, 0x47, 0x52, 0x50, 0x43, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e,
-					0x74, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x52,
-					0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x6d, 0x70, 0x6f, 0x72,
-					0x74, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x7b, 0x69, 0x66,
-					0x20, 0x72, 0x65, 0x71, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x20, 0x20,
-					0x26, 0x26, 0x20, 0x72, 0x65, 0x71, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
-					0x2e, 0x50, 0x61, 0x74, 0x68, 0x20, 0x21, 0x3d, 0x20, 0x73, 0x76, 0x63,
-					0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x20, 0x7d, 0x7d, 0x7b, 0x7b, 0x72,
-					0x65, 0x71, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x41, 0x6c, 0x69,
-					0x61, 0x73, 0x7d, 0x7d, 0x20, 0x22, 0x7b, 0x7b, 0x72, 0x65, 0x71, 0x49,
-					0x6d, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x7d, 0x7d,
-					0x22, 0x20, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x0a, 0x20, 0x20,
-					0x20, 0x20, 0x7b, 0x7b, 0x69, 0x66, 0x20, 0x72, 0x65, 0x73, 0x49, 0x6d,
-					0x70, 0x6f, 0x72, 0x74, 0x20, 0x26, 0x26, 0x20, 0x72, 0x65, 0x73, 0x49,
-					0x6d, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x20, 0x21,
-					0x3d, 0x20, 0x73, 0x76, 0x63, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x7d,
-					0x7d, 0x7b, 0x7b, 0x72, 0x65, 0x73, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
-					0x2e, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x7d, 0x7d, 0x20, 0x22, 0x7b, 0x7b,
-					0x72, 0x65, 0x73, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x50, 0x61,
-					0x74, 0x68, 0x7d, 0x7d, 0x22, 0x20, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d,
-					0x7d, 0x0a, 0x29, 0x0a, 0x0a, 0x74, 0x79, 0x70, 0x65, 0x20, 0x7b, 0x7b,
-					0x2e, 0x47, 0x52, 0x50, 0x43, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e,
-					0x74, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x44, 0x65, 0x63, 0x6f,
-					0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x46, 0x75, 0x6e,
-					0x63, 0x20, 0x3d, 0x20, 0x66, 0x75, 0x6e, 0x63, 0x28, 0x63, 0x6f, 0x6e,
-					0x74, 0x65, 0x78, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74,
-					0x7b, 0x7b, 0x69, 0x66, 0x20, 0x2e, 0x47, 0x52, 0x50, 0x43, 0x45, 0x6e,
-					0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f,
-					0x69, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x7d,
-					0x7d, 0x2c, 0x20, 0x2a, 0x7b, 0x7b, 0x2e, 0x47, 0x52, 0x50, 0x43, 0x45,
-					0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x71, 0x75,
-					0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x4e,
-					0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d,
-					0x29, 0x20, 0x28, 0x7b, 0x7b, 0x69, 0x66, 0x20, 0x2e, 0x47, 0x52, 0x50,
-					0x43, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x45, 0x6e,
-					0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65,
-					0x73, 0x74, 0x7d, 0x7d, 0x20, 0x7b, 0x7b, 0x2e, 0x47, 0x52, 0x50, 0x43,
-					0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x45, 0x6e, 0x64,
-					0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
-					0x5b, 0x31, 0x5d, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x7d, 0x7d, 0x20, 0x2c,
-					0x20, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x65, 0x72, 0x72, 0x6f,
-					0x72, 0x29, 0x0a, 0x74, 0x79, 0x70, 0x65, 0x20, 0x7b, 0x7b, 0x2e, 0x47,
-					0x52, 0x50, 0x43, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e,
-					0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65,
-					0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x46, 0x75, 0x6e, 0x63,
-					0x20, 0x3d, 0x20, 0x66, 0x75, 0x6e, 0x63, 0x28, 0x63, 0x6f, 0x6e, 0x74,
-					0x65, 0x78, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x7b,
-					0x7b, 0x69, 0x66, 0x20, 0x2e, 0x47, 0x52, 0x50, 0x43, 0x45, 0x6e, 0x64,
-					0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69,
-					0x6e, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x7d,
-					0x7d, 0x2c, 0x20, 0x20, 0x7b, 0x7b, 0x2e, 0x47, 0x52, 0x50, 0x43, 0x45,
-					0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x45, 0x6e, 0x64, 0x70,
-					0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73,
-					0x5b, 0x30, 0x5d, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x7d, 0x7d, 0x7b, 0x7b,
-					0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x29, 0x20, 0x28, 0x7b, 0x7b, 0x69, 0x66,
-					0x20, 0x2e, 0x47, 0x52, 0x50, 0x43, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69,
-					0x6e, 0x74, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e,
-					0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x7d, 0x7d, 0x2a, 0x7b,
-					0x7b, 0x2e, 0x47, 0x52, 0x50, 0x43, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69,
-					0x6e, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d,
-					0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d,
-					0x7d, 0x2c, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x65, 0x72, 0x72,
-					0x6f, 0x72, 0x29, 0x0a, 0x0a, 0x74, 0x79, 0x70, 0x65, 0x20, 0x7b, 0x7b,
-					0x20, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x46, 0x69, 0x72, 0x73, 0x74, 0x28,
-					0x2e, 0x47, 0x52, 0x50, 0x43, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e,
-					0x74, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x29, 0x20, 0x7d, 0x7d, 0x20, 0x73,
-					0x74, 0x72, 0x75, 0x63, 0x74, 0x20, 0x7b, 0x0a, 0x09, 0x73, 0x65, 0x72,
-					0x76, 0x65, 0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x20,
-					0x5b, 0x5d, 0x67, 0x6f, 0x4b, 0x69, 0x74, 0x47, 0x52, 0x50, 0x43, 0x2e,
-					0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-					0x0a, 0x09, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x20, 0x20, 0x7b,
+					0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+					0x20, 0x7d, 0x7d, 0x22, 0x7b, 0x7b, 0x20, 0x2e, 0x53, 0x65, 0x72, 0x76,
+					0x69, 0x63, 0x65, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x20, 0x7d,
+					0x7d, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61,
+					0x74, 0x69, 0x6f, 0x6e, 0x22, 0x0a, 0x09, 0x22, 0x67, 0x6f, 0x6f, 0x67,
+					0x6c, 0x65, 0x2e, 0x67, 0x6f, 0x6c, 0x61, 0x6e, 0x67, 0x2e, 0x6f, 0x72,
+					0x67, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x2f, 0x63, 0x6f, 0x64, 0x65, 0x73,
+					0x22, 0x0a, 0x09, 0x22, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x67,
+					0x6f, 0x6c, 0x61, 0x6e, 0x67, 0x2e, 0x6f, 0x72, 0x67, 0x2f, 0x67, 0x72,
+					0x70, 0x63, 0x2f, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x7b, 0x7b,
+					0x20, 0x65, 0x6e, 0x64, 0x20, 0x7d, 0x7d, 0x0a, 0x09, 0x22, 0x63, 0x6f,
+					0x6e, 0x74, 0x65, 0x78, 0x74, 0x22, 0x0a, 0x09, 0x67, 0x6f, 0x4b, 0x69,
+					0x74, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x20, 0x22, 0x67,
+					0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x6f,
+					0x2d, 0x6b, 0x69, 0x74, 0x2f, 0x6b, 0x69, 0x74, 0x2f, 0x65, 0x6e, 0x64,
+					0x70, 0x6f, 0x69, 0x6e, 0x74, 0x22, 0x0a, 0x09, 0x67, 0x6f, 0x4b, 0x69,
+					0x74, 0x47, 0x52, 0x50, 0x43, 0x20, 0x22, 0x67, 0x69, 0x74, 0x68, 0x75,
+					0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x6f, 0x2d, 0x6b, 0x69, 0x74,
+					0x2f, 0x6b, 0x69, 0x74, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f,
+					0x72, 0x74, 0x2f, 0x67, 0x72, 0x70, 0x63, 0x22, 0x0a, 0x09, 0x7b, 0x7b,
+					0x73, 0x76, 0x63, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x20, 0x3a, 0x3d,
+					0x20, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x49, 0x6d,
+					0x70, 0x6f, 0x72, 0x74, 0x20, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20,
+					0x7b, 0x7b, 0x72, 0x65, 0x71, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x20,
+					0x3a, 0x3d, 0x20, 0x2e, 0x47, 0x52, 0x50, 0x43, 0x45, 0x6e, 0x64, 0x70,
+					0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e,
+					0x74, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x6d, 0x70,
+					0x6f, 0x72, 0x74, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x7b,
+					0x72, 0x65, 0x73, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x20, 0x3a, 0x3d,
+					0x20, 0x2e, 0x47, 0x52, 0x50, 0x43, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69,
+					0x6e, 0x74, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e,
+					0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x6d, 0x70, 0x6f,
+					0x72, 0x74, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x7b, 0x69,
+					0x66, 0x20, 0x72, 0x65, 0x71, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x20,
+					0x20, 0x26, 0x26, 0x20, 0x72, 0x65, 0x71, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+					0x74, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x20, 0x21, 0x3d, 0x20, 0x73, 0x76,
+					0x63, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x20, 0x7d, 0x7d, 0x7b, 0x7b,
+					0x72, 0x65, 0x71, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x41, 0x6c,
+					0x69, 0x61, 0x73, 0x7d, 0x7d, 0x20, 0x22, 0x7b, 0x7b, 0x72, 0x65, 0x71,
+					0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x7d,
+					0x7d, 0x22, 0x20, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x0a, 0x20,
+					0x20, 0x20, 0x20, 0x7b, 0x7b, 0x69, 0x66, 0x20, 0x72, 0x65, 0x73, 0x49,
+					0x6d, 0x70, 0x6f, 0x72, 0x74, 0x20, 0x26, 0x26, 0x20, 0x72, 0x65, 0x73,
+					0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x20,
+					0x21, 0x3d, 0x20, 0x73, 0x76, 0x63, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+					0x7d, 0x7d, 0x7b, 0x7b, 0x72, 0x65, 0x73, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+					0x74, 0x2e, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x7d, 0x7d, 0x20, 0x22, 0x7b,
+					0x7b, 0x72, 0x65, 0x73, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x50,
+					0x61, 0x74, 0x68, 0x7d, 0x7d, 0x22, 0x20, 0x7b, 0x7b, 0x65, 0x6e, 0x64,
+					0x7d, 0x7d, 0x0a, 0x29, 0x0a, 0x0a, 0x74, 0x79, 0x70, 0x65, 0x20, 0x7b,
 					0x7b, 0x2e, 0x47, 0x52, 0x50, 0x43, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69,
 					0x6e, 0x74, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x44, 0x65, 0x63,
 					0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x46, 0x75,
-					0x6e, 0x63, 0x0a, 0x09, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x20,
-					0x20, 0x7b, 0x7b, 0x2e, 0x47, 0x52, 0x50, 0x43, 0x45, 0x6e, 0x64, 0x70,
-					0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x45,
-					0x6e, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-					0x65, 0x46, 0x75, 0x6e, 0x63, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x65, 0x72,
-					0x72, 0x6f, 0x72, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x20, 0x20,
-					0x66, 0x75, 0x6e, 0x63, 0x28, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x29, 0x20,
-					0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x65,
-					0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x20, 0x67, 0x6f, 0x4b, 0x69,
-					0x74, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x45, 0x6e,
-					0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x0a, 0x09, 0x68, 0x61, 0x6e, 0x64,
-					0x6c, 0x65, 0x20, 0x20, 0x20, 0x67, 0x6f, 0x4b, 0x69, 0x74, 0x47, 0x52,
-					0x50, 0x43, 0x2e, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x0a, 0x7d,
-					0x0a, 0x0a, 0x74, 0x79, 0x70, 0x65, 0x20, 0x7b, 0x7b, 0x2e, 0x47, 0x52,
-					0x50, 0x43, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x4e,
-					0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x47, 0x52, 0x50, 0x43, 0x20, 0x69, 0x6e,
-					0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x20, 0x7b, 0x0a, 0x09, 0x48,
-					0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x28, 0x29, 0x20, 0x67, 0x6f, 0x4b,
-					0x69, 0x74, 0x47, 0x52, 0x50, 0x43, 0x2e, 0x48, 0x61, 0x6e, 0x64, 0x6c,
-					0x65, 0x72, 0x0a, 0x7d, 0x0a, 0x0a, 0x74, 0x79, 0x70, 0x65, 0x20, 0x7b,
-					0x7b, 0x2e, 0x47, 0x52, 0x50, 0x43, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69,
-					0x6e, 0x74, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x4f, 0x70, 0x74,
-					0x69, 0x6f, 0x6e, 0x20, 0x66, 0x75, 0x6e, 0x63, 0x28, 0x2a, 0x20, 0x7b,
+					0x6e, 0x63, 0x20, 0x3d, 0x20, 0x66, 0x75, 0x6e, 0x63, 0x28, 0x63, 0x6f,
+					0x6e, 0x74, 0x65, 0x78, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78,
+					0x74, 0x7b, 0x7b, 0x69, 0x66, 0x20, 0x2e, 0x47, 0x52, 0x50, 0x43, 0x45,
+					0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x45, 0x6e, 0x64, 0x70,
+					0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+					0x7d, 0x7d, 0x2c, 0x20, 0x2a, 0x7b, 0x7b, 0x2e, 0x47, 0x52, 0x50, 0x43,
+					0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x71,
+					0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e,
+					0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d,
+					0x7d, 0x29, 0x20, 0x28, 0x7b, 0x7b, 0x69, 0x66, 0x20, 0x2e, 0x47, 0x52,
+					0x50, 0x43, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x45,
+					0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x71, 0x75,
+					0x65, 0x73, 0x74, 0x7d, 0x7d, 0x20, 0x7b, 0x7b, 0x2e, 0x47, 0x52, 0x50,
+					0x43, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x45, 0x6e,
+					0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d,
+					0x73, 0x5b, 0x31, 0x5d, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x7d, 0x7d, 0x20,
+					0x2c, 0x20, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x65, 0x72, 0x72,
+					0x6f, 0x72, 0x29, 0x0a, 0x74, 0x79, 0x70, 0x65, 0x20, 0x7b, 0x7b, 0x2e,
+					0x47, 0x52, 0x50, 0x43, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+					0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x45, 0x6e, 0x63, 0x6f, 0x64,
+					0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x46, 0x75, 0x6e,
+					0x63, 0x20, 0x3d, 0x20, 0x66, 0x75, 0x6e, 0x63, 0x28, 0x63, 0x6f, 0x6e,
+					0x74, 0x65, 0x78, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74,
+					0x7b, 0x7b, 0x69, 0x66, 0x20, 0x2e, 0x47, 0x52, 0x50, 0x43, 0x45, 0x6e,
+					0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f,
+					0x69, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+					0x7d, 0x7d, 0x2c, 0x20, 0x20, 0x7b, 0x7b, 0x2e, 0x47, 0x52, 0x50, 0x43,
+					0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x45, 0x6e, 0x64,
+					0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74,
+					0x73, 0x5b, 0x30, 0x5d, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x7d, 0x7d, 0x7b,
+					0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x29, 0x20, 0x28, 0x7b, 0x7b, 0x69,
+					0x66, 0x20, 0x2e, 0x47, 0x52, 0x50, 0x43, 0x45, 0x6e, 0x64, 0x70, 0x6f,
+					0x69, 0x6e, 0x74, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+					0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x7d, 0x7d, 0x2a,
+					0x7b, 0x7b, 0x2e, 0x47, 0x52, 0x50, 0x43, 0x45, 0x6e, 0x64, 0x70, 0x6f,
+					0x69, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+					0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x4e, 0x61, 0x6d, 0x65,
+					0x7d, 0x7d, 0x2c, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x65, 0x72,
+					0x72, 0x6f, 0x72, 0x29, 0x0a, 0x0a, 0x74, 0x79, 0x70, 0x65, 0x20, 0x7b,
 					0x7b, 0x20, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x46, 0x69, 0x72, 0x73, 0x74,
 					0x28, 0x2e, 0x47, 0x52, 0x50, 0x43, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69,
-					0x6e, 0x74, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x29, 0x20, 0x7d, 0x7d, 0x29,
-					0x0a, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x73, 0x65, 0x74, 0x7b, 0x7b,
+					0x6e, 0x74, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x29, 0x20, 0x7d, 0x7d, 0x20,
+					0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x20, 0x7b, 0x0a, 0x09, 0x73, 0x65,
+					0x72, 0x76, 0x65, 0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20,
+					0x20, 0x5b, 0x5d, 0x67, 0x6f, 0x4b, 0x69, 0x74, 0x47, 0x52, 0x50, 0x43,
+					0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+					0x6e, 0x0a, 0x09, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x20, 0x20,
+					0x7b, 0x7b, 0x2e, 0x47, 0x52, 0x50, 0x43, 0x45, 0x6e, 0x64, 0x70, 0x6f,
+					0x69, 0x6e, 0x74, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x44, 0x65,
+					0x63, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x46,
+					0x75, 0x6e, 0x63, 0x0a, 0x09, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72,
+					0x20, 0x20, 0x7b, 0x7b, 0x2e, 0x47, 0x52, 0x50, 0x43, 0x45, 0x6e, 0x64,
+					0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d,
+					0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+					0x73, 0x65, 0x46, 0x75, 0x6e, 0x63, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x65,
+					0x72, 0x72, 0x6f, 0x72, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x20,
+					0x20, 0x66, 0x75, 0x6e, 0x63, 0x28, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x29,
+					0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x0a, 0x20, 0x20, 0x20, 0x20,
+					0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x20, 0x67, 0x6f, 0x4b,
+					0x69, 0x74, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x45,
+					0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x0a, 0x09, 0x68, 0x61, 0x6e,
+					0x64, 0x6c, 0x65, 0x20, 0x20, 0x20, 0x67, 0x6f, 0x4b, 0x69, 0x74, 0x47,
+					0x52, 0x50, 0x43, 0x2e, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x0a,
+					0x7d, 0x0a, 0x0a, 0x74, 0x79, 0x70, 0x65, 0x20, 0x7b, 0x7b, 0x2e, 0x47,
+					0x52, 0x50, 0x43, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e,
+					0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x47, 0x52, 0x50, 0x43, 0x20, 0x69,
+					0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x20, 0x7b, 0x0a, 0x09,
+					0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x28, 0x29, 0x20, 0x67, 0x6f,
+					0x4b, 0x69, 0x74, 0x47, 0x52, 0x50, 0x43, 0x2e, 0x48, 0x61, 0x6e, 0x64,
+					0x6c, 0x65, 0x72, 0x0a, 0x7d, 0x0a, 0x0a, 0x74, 0x79, 0x70, 0x65, 0x20,
+					0x7b, 0x7b, 0x2e, 0x47, 0x52, 0x50, 0x43, 0x45, 0x6e, 0x64, 0x70, 0x6f,
+					0x69, 0x6e, 0x74, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x4f, 0x70,
+					0x74, 0x69, 0x6f, 0x6e, 0x20, 0x66, 0x75, 0x6e, 0x63, 0x28, 0x2a, 0x20,
+					0x7b, 0x7b, 0x20, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x46, 0x69, 0x72, 0x73,
+					0x74, 0x28, 0x2e, 0x47, 0x52, 0x50, 0x43, 0x45, 0x6e, 0x64, 0x70, 0x6f,
+					0x69, 0x6e, 0x74, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x29, 0x20, 0x7d, 0x7d,
+					0x29, 0x0a, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x73, 0x65, 0x74, 0x7b,
+					0x7b, 0x2e, 0x47, 0x52, 0x50, 0x43, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69,
+					0x6e, 0x74, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x44, 0x65, 0x66,
+					0x61, 0x75, 0x6c, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x28,
+					0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x20, 0x2a, 0x20,
+					0x7b, 0x7b, 0x20, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x46, 0x69, 0x72, 0x73,
+					0x74, 0x28, 0x2e, 0x47, 0x52, 0x50, 0x43, 0x45, 0x6e, 0x64, 0x70, 0x6f,
+					0x69, 0x6e, 0x74, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x29, 0x20, 0x7d, 0x7d,
+					0x2c, 0x20, 0x67, 0x72, 0x70, 0x63, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+					0x73, 0x20, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x29, 0x20, 0x7b,
+					0x0a, 0x09, 0x69, 0x66, 0x20, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f,
+					0x72, 0x74, 0x2e, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x20, 0x3d,
+					0x3d, 0x20, 0x6e, 0x69, 0x6c, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x74, 0x72,
+					0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x64, 0x65, 0x63, 0x6f,
+					0x64, 0x65, 0x72, 0x20, 0x3d, 0x20, 0x20, 0x7b, 0x7b, 0x20, 0x6c, 0x6f,
+					0x77, 0x65, 0x72, 0x46, 0x69, 0x72, 0x73, 0x74, 0x28, 0x2e, 0x47, 0x52,
+					0x50, 0x43, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x4e,
+					0x61, 0x6d, 0x65, 0x29, 0x20, 0x7d, 0x7d, 0x44, 0x65, 0x63, 0x6f, 0x64,
+					0x65, 0x72, 0x0a, 0x09, 0x7d, 0x0a, 0x09, 0x69, 0x66, 0x20, 0x74, 0x72,
+					0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x65, 0x6e, 0x63, 0x6f,
+					0x64, 0x65, 0x72, 0x20, 0x3d, 0x3d, 0x20, 0x6e, 0x69, 0x6c, 0x20, 0x7b,
+					0x0a, 0x09, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74,
+					0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x20, 0x3d, 0x20, 0x20,
+					0x7b, 0x7b, 0x20, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x46, 0x69, 0x72, 0x73,
+					0x74, 0x28, 0x2e, 0x47, 0x52, 0x50, 0x43, 0x45, 0x6e, 0x64, 0x70, 0x6f,
+					0x69, 0x6e, 0x74, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x29, 0x20, 0x7d, 0x7d,
+					0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x0a, 0x09, 0x7d, 0x0a, 0x09,
+					0x69, 0x66, 0x20, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74,
+					0x2e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65,
+					0x72, 0x20, 0x3d, 0x3d, 0x20, 0x6e, 0x69, 0x6c, 0x20, 0x7b, 0x0a, 0x09,
+					0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x65,
+					0x72, 0x72, 0x6f, 0x72, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x20,
+					0x3d, 0x20, 0x67, 0x72, 0x70, 0x63, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+					0x73, 0x2e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x45, 0x6e, 0x63, 0x6f, 0x64,
+					0x65, 0x72, 0x0a, 0x09, 0x7d, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73,
+					0x70, 0x6f, 0x72, 0x74, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4f,
+					0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x3d, 0x20, 0x61, 0x70, 0x70,
+					0x65, 0x6e, 0x64, 0x28, 0x67, 0x72, 0x70, 0x63, 0x4f, 0x70, 0x74, 0x69,
+					0x6f, 0x6e, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4f, 0x70,
+					0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2c, 0x20, 0x74, 0x72, 0x61, 0x6e, 0x73,
+					0x70, 0x6f, 0x72, 0x74, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4f,
+					0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x2e, 0x2e, 0x29, 0x0a, 0x7d,
+					0x0a, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x6d, 0x61, 0x6b, 0x65, 0x7b,
+					0x7b, 0x2e, 0x47, 0x52, 0x50, 0x43, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69,
+					0x6e, 0x74, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x47, 0x52, 0x50,
+					0x43, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x28, 0x65,
+					0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x20, 0x67, 0x6f, 0x4b, 0x69,
+					0x74, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x45, 0x6e,
+					0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2c, 0x20, 0x67, 0x72, 0x70, 0x63,
+					0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x6f, 0x70, 0x74, 0x69,
+					0x6f, 0x6e, 0x73, 0x2c, 0x20, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+					0x20, 0x2e, 0x2e, 0x2e, 0x7b, 0x7b, 0x2e, 0x47, 0x52, 0x50, 0x43, 0x45,
+					0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x4e, 0x61, 0x6d, 0x65,
+					0x7d, 0x7d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x29, 0x20, 0x7b, 0x7b,
 					0x2e, 0x47, 0x52, 0x50, 0x43, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e,
-					0x74, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x44, 0x65, 0x66, 0x61,
-					0x75, 0x6c, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x28, 0x74,
-					0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x20, 0x2a, 0x20, 0x7b,
-					0x7b, 0x20, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x46, 0x69, 0x72, 0x73, 0x74,
-					0x28, 0x2e, 0x47, 0x52, 0x50, 0x43, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69,
-					0x6e, 0x74, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x29, 0x20, 0x7d, 0x7d, 0x2c,
-					0x20, 0x67, 0x72, 0x70, 0x63, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-					0x20, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x29, 0x20, 0x7b, 0x0a,
-					0x09, 0x69, 0x66, 0x20, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72,
-					0x74, 0x2e, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x20, 0x3d, 0x3d,
-					0x20, 0x6e, 0x69, 0x6c, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x74, 0x72, 0x61,
-					0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x64, 0x65, 0x63, 0x6f, 0x64,
-					0x65, 0x72, 0x20, 0x3d, 0x20, 0x20, 0x7b, 0x7b, 0x20, 0x6c, 0x6f, 0x77,
+					0x74, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x47, 0x52, 0x50, 0x43,
+					0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x74, 0x72, 0x61, 0x6e, 0x73,
+					0x70, 0x6f, 0x72, 0x74, 0x20, 0x3a, 0x3d, 0x20, 0x26, 0x20, 0x7b, 0x7b,
+					0x20, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x46, 0x69, 0x72, 0x73, 0x74, 0x28,
+					0x2e, 0x47, 0x52, 0x50, 0x43, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e,
+					0x74, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x29, 0x20, 0x7d, 0x7d, 0x7b, 0x65,
+					0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x3a, 0x20, 0x65, 0x6e, 0x64,
+					0x70, 0x6f, 0x69, 0x6e, 0x74, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x66,
+					0x6f, 0x72, 0x20, 0x5f, 0x2c, 0x20, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+					0x20, 0x3a, 0x3d, 0x20, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x20, 0x6f, 0x70,
+					0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x28, 0x74,
+					0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x29, 0x0a, 0x20, 0x20,
+					0x20, 0x20, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x73, 0x65, 0x74, 0x7b,
+					0x7b, 0x2e, 0x47, 0x52, 0x50, 0x43, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69,
+					0x6e, 0x74, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x44, 0x65, 0x66,
+					0x61, 0x75, 0x6c, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x28,
+					0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2c, 0x20, 0x67,
+					0x72, 0x70, 0x63, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x29, 0x0a,
+					0x20, 0x20, 0x20, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x74,
+					0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x0a, 0x7d, 0x0a, 0x0a,
+					0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x7b, 0x7b, 0x20, 0x6c, 0x6f, 0x77,
 					0x65, 0x72, 0x46, 0x69, 0x72, 0x73, 0x74, 0x28, 0x2e, 0x47, 0x52, 0x50,
 					0x43, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x4e, 0x61,
 					0x6d, 0x65, 0x29, 0x20, 0x7d, 0x7d, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65,
-					0x72, 0x0a, 0x09, 0x7d, 0x0a, 0x09, 0x69, 0x66, 0x20, 0x74, 0x72, 0x61,
-					0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x64,
-					0x65, 0x72, 0x20, 0x3d, 0x3d, 0x20, 0x6e, 0x69, 0x6c, 0x20, 0x7b, 0x0a,
-					0x09, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e,
-					0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x20, 0x3d, 0x20, 0x20, 0x7b,
-					0x7b, 0x20, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x46, 0x69, 0x72, 0x73, 0x74,
-					0x28, 0x2e, 0x47, 0x52, 0x50, 0x43, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69,
-					0x6e, 0x74, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x29, 0x20, 0x7d, 0x7d, 0x45,
-					0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x0a, 0x09, 0x7d, 0x0a, 0x09, 0x69,
-					0x66, 0x20, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e,
-					0x65, 0x72, 0x72, 0x6f, 0x72, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72,
-					0x20, 0x3d, 0x3d, 0x20, 0x6e, 0x69, 0x6c, 0x20, 0x7b, 0x0a, 0x09, 0x09,
-					0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x65, 0x72,
-					0x72, 0x6f, 0x72, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x20, 0x3d,
-					0x20, 0x67, 0x72, 0x70, 0x63, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-					0x2e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65,
-					0x72, 0x0a, 0x09, 0x7d, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70,
-					0x6f, 0x72, 0x74, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4f, 0x70,
-					0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x3d, 0x20, 0x61, 0x70, 0x70, 0x65,
-					0x6e, 0x64, 0x28, 0x67, 0x72, 0x70, 0x63, 0x4f, 0x70, 0x74, 0x69, 0x6f,
-					0x6e, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4f, 0x70, 0x74,
-					0x69, 0x6f, 0x6e, 0x73, 0x2c, 0x20, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70,
-					0x6f, 0x72, 0x74, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4f, 0x70,
-					0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x2e, 0x2e, 0x29, 0x0a, 0x7d, 0x0a,
-					0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x6d, 0x61, 0x6b, 0x65, 0x7b, 0x7b,
+					0x72, 0x28, 0x5f, 0x20, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x2e,
+					0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x7b, 0x7b, 0x69, 0x66, 0x20,
 					0x2e, 0x47, 0x52, 0x50, 0x43, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e,
-					0x74, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x47, 0x52, 0x50, 0x43,
-					0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x28, 0x65, 0x6e,
-					0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x20, 0x67, 0x6f, 0x4b, 0x69, 0x74,
+					0x74, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x52,
+					0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x7d, 0x7d, 0x2c, 0x20, 0x72, 0x65,
+					0x71, 0x20, 0x2a, 0x7b, 0x7b, 0x2e, 0x47, 0x52, 0x50, 0x43, 0x45, 0x6e,
+					0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65,
+					0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x4e, 0x61,
+					0x6d, 0x65, 0x7d, 0x7d, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x29,
+					0x20, 0x28, 0x7b, 0x7b, 0x69, 0x66, 0x20, 0x2e, 0x47, 0x52, 0x50, 0x43,
 					0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x45, 0x6e, 0x64,
-					0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2c, 0x20, 0x67, 0x72, 0x70, 0x63, 0x4f,
-					0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x6f, 0x70, 0x74, 0x69, 0x6f,
-					0x6e, 0x73, 0x2c, 0x20, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20,
-					0x2e, 0x2e, 0x2e, 0x7b, 0x7b, 0x2e, 0x47, 0x52, 0x50, 0x43, 0x45, 0x6e,
-					0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d,
-					0x7d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x29, 0x20, 0x7b, 0x7b, 0x2e,
-					0x47, 0x52, 0x50, 0x43, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
-					0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x47, 0x52, 0x50, 0x43, 0x20,
-					0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70,
-					0x6f, 0x72, 0x74, 0x20, 0x3a, 0x3d, 0x20, 0x26, 0x20, 0x7b, 0x7b, 0x20,
-					0x6c, 0x6f, 0x77, 0x65, 0x72, 0x46, 0x69, 0x72, 0x73, 0x74, 0x28, 0x2e,
-					0x47, 0x52, 0x50, 0x43, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
-					0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x29, 0x20, 0x7d, 0x7d, 0x7b, 0x65, 0x6e,
-					0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x3a, 0x20, 0x65, 0x6e, 0x64, 0x70,
-					0x6f, 0x69, 0x6e, 0x74, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x66, 0x6f,
-					0x72, 0x20, 0x5f, 0x2c, 0x20, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x20,
-					0x3a, 0x3d, 0x20, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x20, 0x6f, 0x70, 0x74,
-					0x69, 0x6f, 0x6e, 0x73, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
-					0x20, 0x20, 0x20, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x28, 0x74, 0x72,
-					0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x29, 0x0a, 0x20, 0x20, 0x20,
-					0x20, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x73, 0x65, 0x74, 0x7b, 0x7b,
-					0x2e, 0x47, 0x52, 0x50, 0x43, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e,
-					0x74, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x44, 0x65, 0x66, 0x61,
-					0x75, 0x6c, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x28, 0x74,
-					0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2c, 0x20, 0x67, 0x72,
-					0x70, 0x63, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x29, 0x0a, 0x20,
-					0x20, 0x20, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x74, 0x72,
-					0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x0a, 0x7d, 0x0a, 0x0a, 0x0a,
-					0x66, 0x75, 0x6e, 0x63, 0x20, 0x7b, 0x7b, 0x20, 0x6c, 0x6f, 0x77, 0x65,
-					0x72, 0x46, 0x69, 0x72, 0x73, 0x74, 0x28, 0x2e, 0x47, 0x52, 0x50, 0x43,
-					0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x4e, 0x61, 0x6d,
-					0x65, 0x29, 0x20, 0x7d, 0x7d, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x72,
-					0x28, 0x5f, 0x20, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x2e, 0x43,
-					0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x7b, 0x7b, 0x69, 0x66, 0x20, 0x2e,
-					0x47, 0x52, 0x50, 0x43, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
-					0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x52, 0x65,
-					0x71, 0x75, 0x65, 0x73, 0x74, 0x7d, 0x7d, 0x2c, 0x20, 0x72, 0x65, 0x71,
-					0x20, 0x2a, 0x7b, 0x7b, 0x2e, 0x47, 0x52, 0x50, 0x43, 0x45, 0x6e, 0x64,
 					0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-					0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x4e, 0x61, 0x6d,
-					0x65, 0x7d, 0x7d, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x29, 0x20,
-					0x28, 0x7b, 0x7b, 0x69, 0x66, 0x20, 0x2e, 0x47, 0x52, 0x50, 0x43, 0x45,
+					0x74, 0x7d, 0x7d, 0x20, 0x7b, 0x7b, 0x2e, 0x47, 0x52, 0x50, 0x43, 0x45,
 					0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x45, 0x6e, 0x64, 0x70,
+					0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x5b,
+					0x31, 0x5d, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x7d, 0x7d, 0x20, 0x2c, 0x20,
+					0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x65, 0x72, 0x72, 0x6f, 0x72,
+					0x29, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x7b, 0x69,
+					0x66, 0x20, 0x2e, 0x47, 0x52, 0x50, 0x43, 0x45, 0x6e, 0x64, 0x70, 0x6f,
+					0x69, 0x6e, 0x74, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+					0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x7d, 0x7d, 0x72,
+					0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x2a, 0x64, 0x65, 0x63, 0x6f, 0x64,
+					0x65, 0x7b, 0x7b, 0x2e, 0x47, 0x52, 0x50, 0x43, 0x45, 0x6e, 0x64, 0x70,
 					0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-					0x7d, 0x7d, 0x20, 0x7b, 0x7b, 0x2e, 0x47, 0x52, 0x50, 0x43, 0x45, 0x6e,
-					0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f,
-					0x69, 0x6e, 0x74, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x5b, 0x31,
-					0x5d, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x7d, 0x7d, 0x20, 0x2c, 0x20, 0x7b,
-					0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x29,
-					0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x7b, 0x69, 0x66,
-					0x20, 0x2e, 0x47, 0x52, 0x50, 0x43, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69,
-					0x6e, 0x74, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e,
-					0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x7d, 0x7d, 0x72, 0x65,
-					0x74, 0x75, 0x72, 0x6e, 0x20, 0x2a, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65,
+					0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x4e, 0x61, 0x6d, 0x65,
+					0x7d, 0x7d, 0x28, 0x72, 0x65, 0x71, 0x29, 0x2c, 0x20, 0x6e, 0x69, 0x6c,
+					0x7b, 0x7b, 0x20, 0x65, 0x6c, 0x73, 0x65, 0x20, 0x7d, 0x7d, 0x72, 0x65,
+					0x74, 0x75, 0x72, 0x6e, 0x20, 0x6e, 0x69, 0x6c, 0x7b, 0x7b, 0x20, 0x65,
+					0x6e, 0x64, 0x20, 0x7d, 0x7d, 0x0a, 0x7d, 0x0a, 0x0a, 0x66, 0x75, 0x6e,
+					0x63, 0x20, 0x7b, 0x7b, 0x20, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x46, 0x69,
+					0x72, 0x73, 0x74, 0x28, 0x2e, 0x47, 0x52, 0x50, 0x43, 0x45, 0x6e, 0x64,
+					0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x29, 0x20,
+					0x7d, 0x7d, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x28, 0x5f, 0x20,
+					0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x74,
+					0x65, 0x78, 0x74, 0x7b, 0x7b, 0x20, 0x69, 0x66, 0x20, 0x2e, 0x47, 0x52,
+					0x50, 0x43, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x45,
+					0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x70,
+					0x6f, 0x6e, 0x73, 0x65, 0x7d, 0x7d, 0x2c, 0x20, 0x20, 0x72, 0x65, 0x73,
 					0x7b, 0x7b, 0x2e, 0x47, 0x52, 0x50, 0x43, 0x45, 0x6e, 0x64, 0x70, 0x6f,
-					0x69, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d,
-					0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d,
-					0x7d, 0x28, 0x72, 0x65, 0x71, 0x29, 0x2c, 0x20, 0x6e, 0x69, 0x6c, 0x7b,
-					0x7b, 0x20, 0x65, 0x6c, 0x73, 0x65, 0x20, 0x7d, 0x7d, 0x72, 0x65, 0x74,
-					0x75, 0x72, 0x6e, 0x20, 0x6e, 0x69, 0x6c, 0x7b, 0x7b, 0x20, 0x65, 0x6e,
-					0x64, 0x20, 0x7d, 0x7d, 0x0a, 0x7d, 0x0a, 0x0a, 0x66, 0x75, 0x6e, 0x63,
-					0x20, 0x7b, 0x7b, 0x20, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x46, 0x69, 0x72,
-					0x73, 0x74, 0x28, 0x2e, 0x47, 0x52, 0x50, 0x43, 0x45, 0x6e, 0x64, 0x70,
-					0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x29, 0x20, 0x7d,
-					0x7d, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x28, 0x5f, 0x20, 0x63,
-					0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65,
-					0x78, 0x74, 0x7b, 0x7b, 0x20, 0x69, 0x66, 0x20, 0x2e, 0x47, 0x52, 0x50,
-					0x43, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x45, 0x6e,
-					0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-					0x6e, 0x73, 0x65, 0x7d, 0x7d, 0x2c, 0x20, 0x20, 0x72, 0x65, 0x73, 0x7b,
-					0x7b, 0x2e, 0x47, 0x52, 0x50, 0x43, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69,
-					0x6e, 0x74, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e,
-					0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x5b, 0x30, 0x5d, 0x2e, 0x54,
-					0x79, 0x70, 0x65, 0x7d, 0x7d, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d,
-					0x29, 0x20, 0x28, 0x7b, 0x7b, 0x69, 0x66, 0x20, 0x2e, 0x47, 0x52, 0x50,
-					0x43, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x45, 0x6e,
-					0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-					0x6e, 0x73, 0x65, 0x7d, 0x7d, 0x2a, 0x7b, 0x7b, 0x2e, 0x47, 0x52, 0x50,
-					0x43, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x52, 0x65,
-					0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
-					0x65, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x2c, 0x7b, 0x7b, 0x65,
-					0x6e, 0x64, 0x7d, 0x7d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x29, 0x20, 0x7b,
-					0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x7b, 0x69, 0x66, 0x20, 0x2e,
-					0x47, 0x52, 0x50, 0x43, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
-					0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x52, 0x65,
-					0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x20, 0x7d, 0x7d, 0x72, 0x65, 0x74,
-					0x75, 0x72, 0x6e, 0x20, 0x26, 0x7b, 0x7b, 0x2e, 0x47, 0x52, 0x50, 0x43,
-					0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x73,
-					0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-					0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x7b, 0x0a, 0x20, 0x20, 0x20,
-					0x20, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x7b, 0x20, 0x69, 0x66, 0x20, 0x2e,
-					0x47, 0x52, 0x50, 0x43, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
-					0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x52, 0x65,
-					0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x7d, 0x7d, 0x7b, 0x7b, 0x20, 0x63,
-					0x61, 0x6d, 0x65, 0x6c, 0x43, 0x61, 0x73, 0x65, 0x28, 0x2e, 0x47, 0x52,
+					0x69, 0x6e, 0x74, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+					0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x5b, 0x30, 0x5d, 0x2e,
+					0x54, 0x79, 0x70, 0x65, 0x7d, 0x7d, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d,
+					0x7d, 0x29, 0x20, 0x28, 0x7b, 0x7b, 0x69, 0x66, 0x20, 0x2e, 0x47, 0x52,
+					0x50, 0x43, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x45,
+					0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x70,
+					0x6f, 0x6e, 0x73, 0x65, 0x7d, 0x7d, 0x2a, 0x7b, 0x7b, 0x2e, 0x47, 0x52,
 					0x50, 0x43, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x52,
 					0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61,
-					0x67, 0x65, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x5b, 0x31, 0x5d,
-					0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x29, 0x20, 0x7d, 0x7d, 0x3a, 0x20, 0x65,
-					0x6e, 0x63, 0x6f, 0x64, 0x65, 0x7b, 0x7b, 0x2e, 0x47, 0x52, 0x50, 0x43,
+					0x67, 0x65, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x2c, 0x7b, 0x7b,
+					0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x29, 0x20,
+					0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x7b, 0x69, 0x66, 0x20,
+					0x2e, 0x47, 0x52, 0x50, 0x43, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e,
+					0x74, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x52,
+					0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x20, 0x7d, 0x7d, 0x72, 0x65,
+					0x74, 0x75, 0x72, 0x6e, 0x20, 0x26, 0x7b, 0x7b, 0x2e, 0x47, 0x52, 0x50,
+					0x43, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x52, 0x65,
+					0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+					0x65, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x7b, 0x0a, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x7b, 0x20, 0x69, 0x66, 0x20,
+					0x2e, 0x47, 0x52, 0x50, 0x43, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e,
+					0x74, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x52,
+					0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x7d, 0x7d, 0x7b, 0x7b, 0x20,
+					0x63, 0x61, 0x6d, 0x65, 0x6c, 0x43, 0x61, 0x73, 0x65, 0x28, 0x2e, 0x47,
+					0x52, 0x50, 0x43, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e,
+					0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x65, 0x73, 0x73,
+					0x61, 0x67, 0x65, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x5b, 0x31,
+					0x5d, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x29, 0x20, 0x7d, 0x7d, 0x3a, 0x20,
+					0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x7b, 0x7b, 0x2e, 0x47, 0x52, 0x50,
+					0x43, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x52, 0x65,
+					0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+					0x65, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x5b, 0x31, 0x5d, 0x2e,
+					0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x4e, 0x61, 0x6d, 0x65,
+					0x7d, 0x7d, 0x28, 0x72, 0x65, 0x73, 0x29, 0x2c, 0x7b, 0x7b, 0x20, 0x65,
+					0x6e, 0x64, 0x20, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7d,
+					0x2c, 0x20, 0x6e, 0x69, 0x6c, 0x7b, 0x7b, 0x20, 0x65, 0x6c, 0x73, 0x65,
+					0x20, 0x7d, 0x7d, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x6e, 0x69,
+					0x6c, 0x7b, 0x7b, 0x20, 0x65, 0x6e, 0x64, 0x20, 0x7d, 0x7d, 0x0a, 0x7d,
+					0x0a, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x28, 0x68, 0x20, 0x2a, 0x7b,
+					0x7b, 0x20, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x46, 0x69, 0x72, 0x73, 0x74,
+					0x28, 0x2e, 0x47, 0x52, 0x50, 0x43, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69,
+					0x6e, 0x74, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x29, 0x20, 0x7d, 0x7d, 0x29,
+					0x20, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x28, 0x29, 0x20, 0x67,
+					0x6f, 0x4b, 0x69, 0x74, 0x47, 0x52, 0x50, 0x43, 0x2e, 0x48, 0x61, 0x6e,
+					0x64, 0x6c, 0x65, 0x72, 0x20, 0x7b, 0x0a, 0x09, 0x69, 0x66, 0x20, 0x68,
+					0x2e, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x20, 0x21, 0x3d, 0x20, 0x6e,
+					0x69, 0x6c, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72,
+					0x6e, 0x20, 0x68, 0x2e, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x0a, 0x09,
+					0x7d, 0x0a, 0x09, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x20, 0x3a,
+					0x3d, 0x20, 0x66, 0x75, 0x6e, 0x63, 0x28, 0x63, 0x74, 0x78, 0x20, 0x63,
+					0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+					0x78, 0x74, 0x2c, 0x20, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+					0x20, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x7b, 0x7d,
+					0x29, 0x20, 0x28, 0x72, 0x65, 0x20, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66,
+					0x61, 0x63, 0x65, 0x7b, 0x7d, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x20, 0x65,
+					0x72, 0x72, 0x6f, 0x72, 0x29, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x65, 0x70,
+					0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x20, 0x3a, 0x3d, 0x20,
+					0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x28, 0x64, 0x65,
+					0x66, 0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x7b, 0x7b,
+					0x2e, 0x47, 0x52, 0x50, 0x43, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e,
+					0x74, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x52, 0x65, 0x73, 0x70,
+					0x6f, 0x6e, 0x73, 0x65, 0x29, 0x0a, 0x09, 0x09, 0x69, 0x66, 0x20, 0x65,
+					0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x72,
+					0x72, 0x20, 0x21, 0x3d, 0x20, 0x6e, 0x69, 0x6c, 0x20, 0x7b, 0x0a, 0x09,
+					0x09, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x26, 0x7b, 0x7b,
+					0x2e, 0x47, 0x52, 0x50, 0x43, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e,
+					0x74, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x65,
+					0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d,
+					0x7b, 0x0a, 0x09, 0x09, 0x09, 0x09, 0x7b, 0x7b, 0x20, 0x63, 0x61, 0x6d,
+					0x65, 0x6c, 0x43, 0x61, 0x73, 0x65, 0x28, 0x2e, 0x47, 0x52, 0x50, 0x43,
 					0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x73,
 					0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-					0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x5b, 0x31, 0x5d, 0x2e, 0x4d,
-					0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d,
-					0x7d, 0x28, 0x72, 0x65, 0x73, 0x29, 0x2c, 0x7b, 0x7b, 0x20, 0x65, 0x6e,
-					0x64, 0x20, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x2c,
-					0x20, 0x6e, 0x69, 0x6c, 0x7b, 0x7b, 0x20, 0x65, 0x6c, 0x73, 0x65, 0x20,
-					0x7d, 0x7d, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x6e, 0x69, 0x6c,
-					0x7b, 0x7b, 0x20, 0x65, 0x6e, 0x64, 0x20, 0x7d, 0x7d, 0x0a, 0x7d, 0x0a,
-					0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x28, 0x68, 0x20, 0x2a, 0x7b, 0x7b,
-					0x20, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x46, 0x69, 0x72, 0x73, 0x74, 0x28,
-					0x2e, 0x47, 0x52, 0x50, 0x43, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e,
-					0x74, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x29, 0x20, 0x7d, 0x7d, 0x29, 0x20,
-					0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x28, 0x29, 0x20, 0x67, 0x6f,
-					0x4b, 0x69, 0x74, 0x47, 0x52, 0x50, 0x43, 0x2e, 0x48, 0x61, 0x6e, 0x64,
-					0x6c, 0x65, 0x72, 0x20, 0x7b, 0x0a, 0x09, 0x69, 0x66, 0x20, 0x68, 0x2e,
-					0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x20, 0x21, 0x3d, 0x20, 0x6e, 0x69,
-					0x6c, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e,
-					0x20, 0x68, 0x2e, 0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x0a, 0x09, 0x7d,
-					0x0a, 0x09, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x20, 0x3a, 0x3d,
-					0x20, 0x66, 0x75, 0x6e, 0x63, 0x28, 0x63, 0x74, 0x78, 0x20, 0x63, 0x6f,
-					0x6e, 0x74, 0x65, 0x78, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78,
-					0x74, 0x2c, 0x20, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x20,
-					0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x7b, 0x7d, 0x29,
-					0x20, 0x28, 0x72, 0x65, 0x20, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
-					0x63, 0x65, 0x7b, 0x7d, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x20, 0x65, 0x72,
-					0x72, 0x6f, 0x72, 0x29, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x65, 0x70, 0x52,
-					0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x20, 0x3a, 0x3d, 0x20, 0x72,
-					0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x28, 0x64, 0x65, 0x66,
-					0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x7b, 0x7b, 0x2e,
-					0x47, 0x52, 0x50, 0x43, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
-					0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x52, 0x65, 0x73, 0x70, 0x6f,
-					0x6e, 0x73, 0x65, 0x29, 0x0a, 0x09, 0x09, 0x69, 0x66, 0x20, 0x65, 0x70,
-					0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72,
-					0x20, 0x21, 0x3d, 0x20, 0x6e, 0x69, 0x6c, 0x20, 0x7b, 0x0a, 0x09, 0x09,
-					0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x26, 0x7b, 0x7b, 0x2e,
-					0x47, 0x52, 0x50, 0x43, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
-					0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x65, 0x73,
-					0x73, 0x61, 0x67, 0x65, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x7b,
-					0x0a, 0x09, 0x09, 0x09, 0x09, 0x7b, 0x7b, 0x20, 0x63, 0x61, 0x6d, 0x65,
+					0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x5b, 0x30, 0x5d, 0x2e, 0x4e,
+					0x61, 0x6d, 0x65, 0x29, 0x20, 0x7d, 0x7d, 0x3a, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x68, 0x2e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x45, 0x6e, 0x63,
+					0x6f, 0x64, 0x65, 0x72, 0x28, 0x65, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f,
+					0x6e, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x29, 0x2c, 0x0a, 0x09, 0x09,
+					0x09, 0x7d, 0x2c, 0x20, 0x6e, 0x69, 0x6c, 0x0a, 0x09, 0x09, 0x7d, 0x0a,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x7b, 0x20, 0x69,
+					0x66, 0x20, 0x2e, 0x47, 0x52, 0x50, 0x43, 0x45, 0x6e, 0x64, 0x70, 0x6f,
+					0x69, 0x6e, 0x74, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+					0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x7d, 0x7d, 0x72,
+					0x65, 0x73, 0x20, 0x3a, 0x3d, 0x20, 0x65, 0x70, 0x52, 0x65, 0x73, 0x70,
+					0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x7b, 0x7b, 0x20, 0x63, 0x61, 0x6d, 0x65,
 					0x6c, 0x43, 0x61, 0x73, 0x65, 0x28, 0x2e, 0x47, 0x52, 0x50, 0x43, 0x45,
 					0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x70,
 					0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e,
-					0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x5b, 0x30, 0x5d, 0x2e, 0x4e, 0x61,
-					0x6d, 0x65, 0x29, 0x20, 0x7d, 0x7d, 0x3a, 0x20, 0x20, 0x20, 0x20, 0x20,
-					0x20, 0x68, 0x2e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x45, 0x6e, 0x63, 0x6f,
-					0x64, 0x65, 0x72, 0x28, 0x65, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-					0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x29, 0x2c, 0x0a, 0x09, 0x09, 0x09,
-					0x7d, 0x2c, 0x20, 0x6e, 0x69, 0x6c, 0x0a, 0x09, 0x09, 0x7d, 0x0a, 0x20,
-					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x7b, 0x20, 0x69, 0x66,
-					0x20, 0x2e, 0x47, 0x52, 0x50, 0x43, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69,
-					0x6e, 0x74, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e,
-					0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x7d, 0x7d, 0x72, 0x65,
-					0x73, 0x20, 0x3a, 0x3d, 0x20, 0x65, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f,
-					0x6e, 0x73, 0x65, 0x2e, 0x7b, 0x7b, 0x20, 0x63, 0x61, 0x6d, 0x65, 0x6c,
-					0x43, 0x61, 0x73, 0x65, 0x28, 0x2e, 0x47, 0x52, 0x50, 0x43, 0x45, 0x6e,
+					0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x5b, 0x31, 0x5d, 0x2e, 0x4e, 0x61,
+					0x6d, 0x65, 0x29, 0x20, 0x7d, 0x7d, 0x7b, 0x7b, 0x20, 0x65, 0x6e, 0x64,
+					0x20, 0x7d, 0x7d, 0x0a, 0x09, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e,
+					0x20, 0x7b, 0x7b, 0x20, 0x69, 0x66, 0x20, 0x21, 0x2e, 0x47, 0x52, 0x50,
+					0x43, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x45, 0x6e,
 					0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-					0x6e, 0x73, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x50,
-					0x61, 0x72, 0x61, 0x6d, 0x73, 0x5b, 0x31, 0x5d, 0x2e, 0x4e, 0x61, 0x6d,
-					0x65, 0x29, 0x20, 0x7d, 0x7d, 0x7b, 0x7b, 0x20, 0x65, 0x6e, 0x64, 0x20,
-					0x7d, 0x7d, 0x0a, 0x09, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20,
-					0x7b, 0x7b, 0x20, 0x69, 0x66, 0x20, 0x21, 0x2e, 0x47, 0x52, 0x50, 0x43,
-					0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x45, 0x6e, 0x64,
-					0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-					0x73, 0x65, 0x7d, 0x7d, 0x6e, 0x69, 0x6c, 0x2c, 0x20, 0x7b, 0x7b, 0x65,
-					0x6e, 0x64, 0x7d, 0x7d, 0x68, 0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65,
-					0x72, 0x28, 0x63, 0x74, 0x78, 0x7b, 0x7b, 0x20, 0x69, 0x66, 0x20, 0x2e,
-					0x47, 0x52, 0x50, 0x43, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
-					0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x52, 0x65,
-					0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x7d, 0x7d, 0x2c, 0x20, 0x72, 0x65,
-					0x73, 0x7b, 0x7b, 0x20, 0x65, 0x6e, 0x64, 0x20, 0x7d, 0x7d, 0x29, 0x0a,
-					0x09, 0x7d, 0x0a, 0x09, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x20,
-					0x3a, 0x3d, 0x20, 0x66, 0x75, 0x6e, 0x63, 0x28, 0x63, 0x74, 0x78, 0x20,
-					0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x74,
-					0x65, 0x78, 0x74, 0x2c, 0x20, 0x72, 0x20, 0x69, 0x6e, 0x74, 0x65, 0x72,
-					0x66, 0x61, 0x63, 0x65, 0x7b, 0x7d, 0x29, 0x20, 0x28, 0x72, 0x65, 0x20,
-					0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x7b, 0x7d, 0x2c,
-					0x20, 0x65, 0x72, 0x72, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x29, 0x20,
-					0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x7b,
+					0x6e, 0x73, 0x65, 0x7d, 0x7d, 0x6e, 0x69, 0x6c, 0x2c, 0x20, 0x7b, 0x7b,
+					0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x68, 0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x64,
+					0x65, 0x72, 0x28, 0x63, 0x74, 0x78, 0x7b, 0x7b, 0x20, 0x69, 0x66, 0x20,
+					0x2e, 0x47, 0x52, 0x50, 0x43, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e,
+					0x74, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x52,
+					0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x7d, 0x7d, 0x2c, 0x20, 0x72,
+					0x65, 0x73, 0x7b, 0x7b, 0x20, 0x65, 0x6e, 0x64, 0x20, 0x7d, 0x7d, 0x29,
+					0x0a, 0x09, 0x7d, 0x0a, 0x09, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x72,
+					0x20, 0x3a, 0x3d, 0x20, 0x66, 0x75, 0x6e, 0x63, 0x28, 0x63, 0x74, 0x78,
+					0x20, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x2e, 0x43, 0x6f, 0x6e,
+					0x74, 0x65, 0x78, 0x74, 0x2c, 0x20, 0x72, 0x20, 0x69, 0x6e, 0x74, 0x65,
+					0x72, 0x66, 0x61, 0x63, 0x65, 0x7b, 0x7d, 0x29, 0x20, 0x28, 0x72, 0x65,
+					0x20, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x7b, 0x7d,
+					0x2c, 0x20, 0x65, 0x72, 0x72, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x29,
+					0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7b,
+					0x7b, 0x20, 0x69, 0x66, 0x20, 0x2e, 0x47, 0x52, 0x50, 0x43, 0x45, 0x6e,
+					0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f,
+					0x69, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20,
+					0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x72,
+					0x65, 0x71, 0x20, 0x3a, 0x3d, 0x20, 0x72, 0x2e, 0x28, 0x2a, 0x7b, 0x7b,
+					0x20, 0x2e, 0x47, 0x52, 0x50, 0x43, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69,
+					0x6e, 0x74, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65,
+					0x73, 0x73, 0x61, 0x67, 0x65, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d,
+					0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x7b,
 					0x20, 0x69, 0x66, 0x20, 0x2e, 0x47, 0x52, 0x50, 0x43, 0x45, 0x6e, 0x64,
 					0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69,
-					0x6e, 0x74, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x7d,
-					0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x72, 0x65,
-					0x71, 0x20, 0x3a, 0x3d, 0x20, 0x72, 0x2e, 0x28, 0x2a, 0x7b, 0x7b, 0x20,
+					0x6e, 0x74, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f,
+					0x6e, 0x73, 0x20, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2c, 0x20, 0x65,
+					0x72, 0x72, 0x20, 0x3a, 0x3d, 0x20, 0x68, 0x2e, 0x64, 0x65, 0x63, 0x6f,
+					0x64, 0x65, 0x72, 0x28, 0x63, 0x74, 0x78, 0x2c, 0x20, 0x72, 0x65, 0x71,
+					0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x69, 0x66,
+					0x20, 0x65, 0x72, 0x72, 0x20, 0x21, 0x3d, 0x20, 0x6e, 0x69, 0x6c, 0x20,
+					0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x72, 0x65, 0x71,
+					0x75, 0x65, 0x73, 0x74, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x0a, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x69, 0x66, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64,
+					0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x20, 0x3a, 0x3d, 0x20, 0x76, 0x61,
+					0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x7b, 0x7b, 0x20,
 					0x2e, 0x47, 0x52, 0x50, 0x43, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e,
-					0x74, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73,
-					0x73, 0x61, 0x67, 0x65, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x29,
+					0x74, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x20, 0x7d, 0x7d, 0x52, 0x65, 0x71,
+					0x75, 0x65, 0x73, 0x74, 0x28, 0x26, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
+					0x74, 0x29, 0x3b, 0x20, 0x6c, 0x65, 0x6e, 0x28, 0x66, 0x69, 0x65, 0x6c,
+					0x64, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x29, 0x20, 0x3e, 0x20, 0x30,
+					0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x72, 0x65,
+					0x71, 0x75, 0x65, 0x73, 0x74, 0x2c, 0x20, 0x73, 0x74, 0x61, 0x74, 0x75,
+					0x73, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x28, 0x63, 0x6f, 0x64, 0x65,
+					0x73, 0x2e, 0x49, 0x6e, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x41, 0x72, 0x67,
+					0x75, 0x6d, 0x65, 0x6e, 0x74, 0x2c, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64,
+					0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72,
+					0x28, 0x29, 0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x72, 0x65,
+					0x74, 0x75, 0x72, 0x6e, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+					0x2c, 0x20, 0x6e, 0x69, 0x6c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x7b, 0x7b, 0x20, 0x65, 0x6c, 0x73, 0x65, 0x20, 0x7d, 0x7d,
 					0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x72, 0x65, 0x74,
 					0x75, 0x72, 0x6e, 0x20, 0x68, 0x2e, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65,
 					0x72, 0x28, 0x63, 0x74, 0x78, 0x2c, 0x20, 0x72, 0x65, 0x71, 0x29, 0x0a,
-					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x7b, 0x65, 0x6c,
-					0x73, 0x65, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
-					0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x6e, 0x69, 0x6c, 0x2c,
-					0x20, 0x68, 0x2e, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x28, 0x63,
-					0x74, 0x78, 0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
-					0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x0a, 0x09, 0x7d, 0x0a, 0x09,
-					0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x67, 0x6f, 0x4b, 0x69, 0x74,
-					0x47, 0x52, 0x50, 0x43, 0x2e, 0x4e, 0x65, 0x77, 0x53, 0x65, 0x72, 0x76,
-					0x65, 0x72, 0x28, 0x0a, 0x09, 0x09, 0x68, 0x2e, 0x65, 0x6e, 0x64, 0x70,
-					0x6f, 0x69, 0x6e, 0x74, 0x2c, 0x0a, 0x09, 0x09, 0x64, 0x65, 0x63, 0x6f,
-					0x64, 0x65, 0x72, 0x2c, 0x0a, 0x09, 0x09, 0x65, 0x6e, 0x63, 0x6f, 0x64,
-					0x65, 0x72, 0x2c, 0x0a, 0x09, 0x09, 0x68, 0x2e, 0x73, 0x65, 0x72, 0x76,
-					0x65, 0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x2e, 0x2e,
-					0x2c, 0x0a, 0x09, 0x29, 0x0a, 0x7d, 0x0a,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x7b, 0x20, 0x65,
+					0x6e, 0x64, 0x20, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x7b, 0x7b, 0x65, 0x6c, 0x73, 0x65, 0x7d, 0x7d, 0x0a, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72,
+					0x6e, 0x20, 0x6e, 0x69, 0x6c, 0x2c, 0x20, 0x68, 0x2e, 0x64, 0x65, 0x63,
+					0x6f, 0x64, 0x65, 0x72, 0x28, 0x63, 0x74, 0x78, 0x29, 0x0a, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d,
+					0x7d, 0x0a, 0x09, 0x7d, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e,
+					0x20, 0x67, 0x6f, 0x4b, 0x69, 0x74, 0x47, 0x52, 0x50, 0x43, 0x2e, 0x4e,
+					0x65, 0x77, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x28, 0x0a, 0x09, 0x09,
+					0x68, 0x2e, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2c, 0x0a,
+					0x09, 0x09, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x2c, 0x0a, 0x09,
+					0x09, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x2c, 0x0a, 0x09, 0x09,
+					0x68, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4f, 0x70, 0x74, 0x69,
+					0x6f, 0x6e, 0x73, 0x2e, 0x2e, 0x2e, 0x2c, 0x0a, 0x09, 0x29, 0x0a, 0x7d,
+					0x0a,
 				},
 				fi: FileInfo{
 					name:    "method.jet",
-					size:    4651,
-					modTime: time.Unix(0, 1587861064550153558),
+					size:    5233,
+					modTime: time.Unix(0, 1792310169567515323),
 					isDir:   false,
 				},
 			}, "/assets/service/gen/transport/grpc/options.jet": {
@@ -2495,33 +3092,37 @@ func init() {
 				data: []byte{
 					0x73, 0x79, 0x6e, 0x74, 0x61, 0x78, 0x20, 0x3d, 0x20, 0x22, 0x70, 0x72,
 					0x6f, 0x74, 0x6f, 0x33, 0x22, 0x3b, 0x0a, 0x0a, 0x70, 0x61, 0x63, 0x6b,
-					0x61, 0x67, 0x65, 0x20, 0x67, 0x72, 0x70, 0x63, 0x3b, 0x0a, 0x0a, 0x7b,
-					0x7b, 0x20, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x20, 0x2e, 0x47, 0x52, 0x50,
-					0x43, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x47,
-					0x52, 0x50, 0x43, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x7d,
-					0x7d, 0x7b, 0x7b, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x20, 0x2e, 0x4d, 0x65,
-					0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x7d, 0x7d, 0x7b, 0x7b, 0x2e, 0x53,
-					0x74, 0x72, 0x69, 0x6e, 0x67, 0x28, 0x29, 0x7d, 0x7d, 0x7b, 0x7b, 0x65,
-					0x6e, 0x64, 0x7d, 0x7d, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x0a,
-					0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x7b, 0x7b, 0x2e, 0x49,
-					0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x7d, 0x7d, 0x20, 0x7b,
-					0x0a, 0x20, 0x20, 0x20, 0x7b, 0x7b, 0x20, 0x72, 0x61, 0x6e, 0x67, 0x65,
-					0x20, 0x2e, 0x47, 0x52, 0x50, 0x43, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70,
-					0x6f, 0x72, 0x74, 0x2e, 0x47, 0x52, 0x50, 0x43, 0x45, 0x6e, 0x64, 0x70,
-					0x6f, 0x69, 0x6e, 0x74, 0x7d, 0x7d, 0x72, 0x70, 0x63, 0x20, 0x7b, 0x7b,
-					0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x20, 0x28, 0x20, 0x7b, 0x7b,
-					0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73,
-					0x61, 0x67, 0x65, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x29, 0x20,
-					0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x28, 0x20, 0x7b, 0x7b,
-					0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x65, 0x73,
-					0x73, 0x61, 0x67, 0x65, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x29,
-					0x20, 0x7b, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x7b, 0x7b, 0x65, 0x6e, 0x64,
-					0x7d, 0x7d, 0x0a, 0x7d, 0x0a,
+					0x61, 0x67, 0x65, 0x20, 0x7b, 0x7b, 0x20, 0x2e, 0x50, 0x72, 0x6f, 0x74,
+					0x6f, 0x50, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x28, 0x29, 0x20, 0x7d,
+					0x7d, 0x3b, 0x0a, 0x0a, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x67,
+					0x6f, 0x5f, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x20, 0x3d, 0x20,
+					0x22, 0x67, 0x72, 0x70, 0x63, 0x22, 0x3b, 0x0a, 0x0a, 0x7b, 0x7b, 0x20,
+					0x72, 0x61, 0x6e, 0x67, 0x65, 0x20, 0x2e, 0x47, 0x52, 0x50, 0x43, 0x54,
+					0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x47, 0x52, 0x50,
+					0x43, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x7d, 0x7d, 0x7b,
+					0x7b, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x20, 0x2e, 0x4d, 0x65, 0x73, 0x73,
+					0x61, 0x67, 0x65, 0x73, 0x7d, 0x7d, 0x7b, 0x7b, 0x2e, 0x53, 0x74, 0x72,
+					0x69, 0x6e, 0x67, 0x28, 0x29, 0x7d, 0x7d, 0x7b, 0x7b, 0x65, 0x6e, 0x64,
+					0x7d, 0x7d, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x0a, 0x73, 0x65,
+					0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x7b, 0x7b, 0x2e, 0x49, 0x6e, 0x74,
+					0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x7d, 0x7d, 0x20, 0x7b, 0x0a, 0x20,
+					0x20, 0x20, 0x7b, 0x7b, 0x20, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x20, 0x2e,
+					0x47, 0x52, 0x50, 0x43, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72,
+					0x74, 0x2e, 0x47, 0x52, 0x50, 0x43, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69,
+					0x6e, 0x74, 0x7d, 0x7d, 0x72, 0x70, 0x63, 0x20, 0x7b, 0x7b, 0x2e, 0x4e,
+					0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x20, 0x28, 0x20, 0x7b, 0x7b, 0x2e, 0x52,
+					0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+					0x65, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x29, 0x20, 0x72, 0x65,
+					0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x28, 0x20, 0x7b, 0x7b, 0x2e, 0x52,
+					0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61,
+					0x67, 0x65, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x29, 0x20, 0x7b,
+					0x7d, 0x0a, 0x20, 0x20, 0x20, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d,
+					0x0a, 0x7d, 0x0a,
 				},
 				fi: FileInfo{
 					name:    "proto.jet",
-					size:    281,
-					modTime: time.Unix(0, 1587413696358731066),
+					size:    327,
+					modTime: time.Unix(0, 1792308422967246126),
 					isDir:   false,
 				},
 			}, "/assets/service/gen/transport/http": {
@@ -2538,72 +3139,135 @@ func init() {
 					0x74, 0x2e, 0x48, 0x61, 0x73, 0x55, 0x72, 0x6c, 0x20, 0x7d, 0x7d, 0x0a,
 					0x20, 0x20, 0x20, 0x20, 0x76, 0x61, 0x72, 0x73, 0x20, 0x3a, 0x3d, 0x20,
 					0x6d, 0x75, 0x78, 0x2e, 0x56, 0x61, 0x72, 0x73, 0x28, 0x72, 0x29, 0x0a,
-					0x7b, 0x7b, 0x20, 0x65, 0x6e, 0x64, 0x20, 0x7d, 0x7d, 0x0a, 0x0a, 0x7b,
-					0x7b, 0x69, 0x66, 0x20, 0x21, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-					0x74, 0x2e, 0x48, 0x61, 0x73, 0x42, 0x6f, 0x64, 0x79, 0x20, 0x7d, 0x7d,
-					0x0a, 0x20, 0x20, 0x20, 0x65, 0x72, 0x72, 0x20, 0x3d, 0x20, 0x68, 0x74,
-					0x74, 0x70, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x7b, 0x7b,
-					0x68, 0x74, 0x74, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44,
-					0x65, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x28, 0x2e, 0x52, 0x65, 0x71, 0x75,
-					0x65, 0x73, 0x74, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x29, 0x7d,
-					0x7d, 0x28, 0x72, 0x2c, 0x20, 0x26, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-					0x74, 0x29, 0x0a, 0x7b, 0x7b, 0x20, 0x65, 0x6e, 0x64, 0x20, 0x7d, 0x7d,
-					0x0a, 0x7b, 0x7b, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x20, 0x70, 0x61, 0x72,
-					0x61, 0x6d, 0x20, 0x3a, 0x3d, 0x20, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65,
-					0x73, 0x74, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x20, 0x7d, 0x7d,
-					0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x7b, 0x69, 0x66, 0x20, 0x70, 0x61,
-					0x72, 0x61, 0x6d, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x54, 0x79, 0x70,
-					0x65, 0x20, 0x3d, 0x3d, 0x20, 0x22, 0x51, 0x55, 0x45, 0x52, 0x59, 0x22,
-					0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x72,
-					0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x7b, 0x7b, 0x70, 0x61, 0x72,
-					0x61, 0x6d, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x7d, 0x7d, 0x7b, 0x7b,
+					0x7b, 0x7b, 0x20, 0x65, 0x6e, 0x64, 0x20, 0x7d, 0x7d, 0x0a, 0x76, 0x61,
+					0x72, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72,
+					0x73, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x2e, 0x46, 0x69, 0x65,
+					0x6c, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x0a, 0x0a, 0x7b, 0x7b,
+					0x69, 0x66, 0x20, 0x21, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+					0x2e, 0x48, 0x61, 0x73, 0x42, 0x6f, 0x64, 0x79, 0x20, 0x7d, 0x7d, 0x0a,
+					0x20, 0x20, 0x20, 0x69, 0x66, 0x20, 0x65, 0x72, 0x72, 0x20, 0x3a, 0x3d,
+					0x20, 0x68, 0x74, 0x74, 0x70, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+					0x2e, 0x7b, 0x7b, 0x68, 0x74, 0x74, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65,
+					0x73, 0x74, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x28, 0x2e, 0x52,
+					0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x46, 0x6f, 0x72, 0x6d, 0x61,
+					0x74, 0x29, 0x7d, 0x7d, 0x28, 0x72, 0x2c, 0x20, 0x26, 0x72, 0x65, 0x71,
+					0x75, 0x65, 0x73, 0x74, 0x29, 0x3b, 0x20, 0x65, 0x72, 0x72, 0x20, 0x21,
+					0x3d, 0x20, 0x6e, 0x69, 0x6c, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x72, 0x72, 0x6f,
+					0x72, 0x73, 0x20, 0x3d, 0x20, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x28,
+					0x66, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x2c,
+					0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x2e, 0x46, 0x69, 0x65, 0x6c,
+					0x64, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x7b, 0x4c, 0x6f, 0x63, 0x61, 0x74,
+					0x69, 0x6f, 0x6e, 0x3a, 0x20, 0x22, 0x62, 0x6f, 0x64, 0x79, 0x22, 0x2c,
+					0x20, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x3a, 0x20, 0x65, 0x72, 0x72,
+					0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x28, 0x29, 0x7d, 0x29, 0x0a, 0x20,
+					0x20, 0x20, 0x7d, 0x0a, 0x7b, 0x7b, 0x20, 0x65, 0x6e, 0x64, 0x20, 0x7d,
+					0x7d, 0x0a, 0x7b, 0x7b, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x20, 0x65, 0x6d,
+					0x62, 0x65, 0x64, 0x20, 0x3a, 0x3d, 0x20, 0x2e, 0x52, 0x65, 0x71, 0x75,
+					0x65, 0x73, 0x74, 0x2e, 0x45, 0x6d, 0x62, 0x65, 0x64, 0x73, 0x20, 0x7d,
+					0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x69, 0x66, 0x20, 0x72, 0x65, 0x71,
+					0x75, 0x65, 0x73, 0x74, 0x2e, 0x7b, 0x7b, 0x65, 0x6d, 0x62, 0x65, 0x64,
+					0x2e, 0x50, 0x61, 0x74, 0x68, 0x7d, 0x7d, 0x20, 0x3d, 0x3d, 0x20, 0x6e,
+					0x69, 0x6c, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x7b, 0x7b, 0x65,
+					0x6d, 0x62, 0x65, 0x64, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x7d, 0x7d, 0x20,
+					0x3d, 0x20, 0x26, 0x7b, 0x7b, 0x65, 0x6d, 0x62, 0x65, 0x64, 0x2e, 0x54,
+					0x79, 0x70, 0x65, 0x7d, 0x7d, 0x7b, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20,
+					0x7d, 0x0a, 0x7b, 0x7b, 0x20, 0x65, 0x6e, 0x64, 0x20, 0x7d, 0x7d, 0x0a,
+					0x7b, 0x7b, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x20, 0x70, 0x61, 0x72, 0x61,
+					0x6d, 0x20, 0x3a, 0x3d, 0x20, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+					0x74, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73, 0x20, 0x7d, 0x7d, 0x0a,
+					0x20, 0x20, 0x20, 0x20, 0x7b, 0x7b, 0x69, 0x66, 0x20, 0x70, 0x61, 0x72,
+					0x61, 0x6d, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x54, 0x79, 0x70, 0x65,
+					0x20, 0x21, 0x3d, 0x20, 0x22, 0x42, 0x4f, 0x44, 0x59, 0x22, 0x7d, 0x7d,
+					0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x7b, 0x70,
+					0x61, 0x72, 0x61, 0x6d, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x4e, 0x61,
+					0x6d, 0x65, 0x28, 0x29, 0x7d, 0x7d, 0x20, 0x3a, 0x3d, 0x20, 0x7b, 0x7b,
+					0x70, 0x61, 0x72, 0x61, 0x6d, 0x2e, 0x53, 0x6f, 0x75, 0x72, 0x63, 0x65,
+					0x28, 0x29, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x7b, 0x7b, 0x69, 0x66, 0x20, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x2e,
+					0x52, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x7d, 0x7d, 0x0a, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x69, 0x66, 0x20, 0x7b, 0x7b,
+					0x70, 0x61, 0x72, 0x61, 0x6d, 0x2e, 0x56, 0x61, 0x6c, 0x75, 0x65, 0x4e,
+					0x61, 0x6d, 0x65, 0x28, 0x29, 0x7d, 0x7d, 0x20, 0x3d, 0x3d, 0x20, 0x22,
+					0x22, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x72, 0x72,
+					0x6f, 0x72, 0x73, 0x20, 0x3d, 0x20, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x64,
+					0x28, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73,
+					0x2c, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x2e, 0x46, 0x69, 0x65,
+					0x6c, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x7b, 0x4e, 0x61, 0x6d, 0x65,
+					0x3a, 0x20, 0x22, 0x7b, 0x7b, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x2e, 0x4e,
+					0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x22, 0x2c, 0x20, 0x4c, 0x6f, 0x63, 0x61,
+					0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x20, 0x22, 0x7b, 0x7b, 0x70, 0x61, 0x72,
+					0x61, 0x6d, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x28,
+					0x29, 0x7d, 0x7d, 0x22, 0x2c, 0x20, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+					0x3a, 0x20, 0x22, 0x72, 0x65, 0x71, 0x75, 0x69, 0x72, 0x65, 0x64, 0x22,
+					0x7d, 0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7d,
+					0x20, 0x65, 0x6c, 0x73, 0x65, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x7b, 0x7b, 0x20, 0x65, 0x6c, 0x73, 0x65, 0x20,
+					0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x69,
+					0x66, 0x20, 0x7b, 0x7b, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x2e, 0x56, 0x61,
+					0x6c, 0x75, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x28, 0x29, 0x7d, 0x7d, 0x20,
+					0x21, 0x3d, 0x20, 0x22, 0x22, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x7b, 0x7b, 0x20, 0x65, 0x6e, 0x64, 0x20, 0x7d,
+					0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x7b,
 					0x69, 0x66, 0x20, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x2e, 0x50, 0x61, 0x72,
 					0x73, 0x65, 0x72, 0x20, 0x26, 0x26, 0x20, 0x21, 0x70, 0x61, 0x72, 0x61,
 					0x6d, 0x2e, 0x50, 0x61, 0x72, 0x73, 0x65, 0x72, 0x2e, 0x4e, 0x6f, 0x45,
-					0x72, 0x72, 0x6f, 0x72, 0x7d, 0x7d, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x7b,
-					0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x3d, 0x20, 0x7b, 0x7b, 0x69, 0x66,
-					0x20, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x2e, 0x50, 0x61, 0x72, 0x73, 0x65,
-					0x72, 0x7d, 0x7d, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x7b, 0x7b, 0x70,
-					0x61, 0x72, 0x61, 0x6d, 0x2e, 0x50, 0x61, 0x72, 0x73, 0x65, 0x72, 0x2e,
-					0x46, 0x6e, 0x7d, 0x7d, 0x28, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d,
-					0x72, 0x2e, 0x55, 0x52, 0x4c, 0x2e, 0x51, 0x75, 0x65, 0x72, 0x79, 0x28,
-					0x29, 0x2e, 0x47, 0x65, 0x74, 0x28, 0x22, 0x7b, 0x7b, 0x70, 0x61, 0x72,
-					0x61, 0x6d, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x22, 0x29, 0x7b,
-					0x7b, 0x69, 0x66, 0x20, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x2e, 0x50, 0x61,
-					0x72, 0x73, 0x65, 0x72, 0x7d, 0x7d, 0x29, 0x7b, 0x7b, 0x65, 0x6e, 0x64,
-					0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x7b, 0x20, 0x65, 0x6c,
-					0x73, 0x65, 0x20, 0x69, 0x66, 0x20, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x2e,
-					0x50, 0x61, 0x72, 0x61, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x20, 0x3d, 0x3d,
-					0x20, 0x22, 0x55, 0x52, 0x4c, 0x22, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x20,
-					0x20, 0x20, 0x20, 0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-					0x2e, 0x7b, 0x7b, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x2e, 0x46, 0x69, 0x65,
-					0x6c, 0x64, 0x7d, 0x7d, 0x7b, 0x7b, 0x69, 0x66, 0x20, 0x70, 0x61, 0x72,
-					0x61, 0x6d, 0x2e, 0x50, 0x61, 0x72, 0x73, 0x65, 0x72, 0x20, 0x26, 0x26,
-					0x20, 0x21, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x2e, 0x50, 0x61, 0x72, 0x73,
-					0x65, 0x72, 0x2e, 0x4e, 0x6f, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x7d, 0x7d,
-					0x2c, 0x20, 0x65, 0x72, 0x72, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d,
+					0x72, 0x72, 0x6f, 0x72, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x69, 0x66, 0x20, 0x76, 0x61,
+					0x6c, 0x75, 0x65, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x20, 0x3a, 0x3d, 0x20,
+					0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e, 0x7b, 0x7b, 0x70, 0x61, 0x72, 0x61,
+					0x6d, 0x2e, 0x50, 0x61, 0x72, 0x73, 0x65, 0x72, 0x2e, 0x46, 0x6e, 0x7d,
+					0x7d, 0x28, 0x7b, 0x7b, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x2e, 0x56, 0x61,
+					0x6c, 0x75, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x28, 0x29, 0x7d, 0x7d, 0x29,
+					0x3b, 0x20, 0x65, 0x72, 0x72, 0x20, 0x21, 0x3d, 0x20, 0x6e, 0x69, 0x6c,
+					0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64,
+					0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x20, 0x3d, 0x20, 0x61, 0x70, 0x70,
+					0x65, 0x6e, 0x64, 0x28, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x72, 0x72,
+					0x6f, 0x72, 0x73, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x2e,
+					0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x7b, 0x4e,
+					0x61, 0x6d, 0x65, 0x3a, 0x20, 0x22, 0x7b, 0x7b, 0x70, 0x61, 0x72, 0x61,
+					0x6d, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x22, 0x2c, 0x20, 0x4c,
+					0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x20, 0x22, 0x7b, 0x7b,
+					0x70, 0x61, 0x72, 0x61, 0x6d, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69,
+					0x6f, 0x6e, 0x28, 0x29, 0x7d, 0x7d, 0x22, 0x2c, 0x20, 0x52, 0x65, 0x61,
+					0x73, 0x6f, 0x6e, 0x3a, 0x20, 0x65, 0x72, 0x72, 0x2e, 0x45, 0x72, 0x72,
+					0x6f, 0x72, 0x28, 0x29, 0x7d, 0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x20, 0x65, 0x6c, 0x73,
+					0x65, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x72, 0x65, 0x71, 0x75,
+					0x65, 0x73, 0x74, 0x2e, 0x7b, 0x7b, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x2e,
+					0x46, 0x69, 0x65, 0x6c, 0x64, 0x7d, 0x7d, 0x20, 0x3d, 0x20, 0x7b, 0x7b,
+					0x69, 0x66, 0x20, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x2e, 0x43, 0x6f, 0x6e,
+					0x76, 0x65, 0x72, 0x74, 0x7d, 0x7d, 0x7b, 0x7b, 0x70, 0x61, 0x72, 0x61,
+					0x6d, 0x2e, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x7d, 0x7d, 0x28,
+					0x76, 0x61, 0x6c, 0x75, 0x65, 0x29, 0x7b, 0x7b, 0x65, 0x6c, 0x73, 0x65,
+					0x7d, 0x7d, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x7b, 0x7b, 0x65, 0x6e, 0x64,
+					0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x7b, 0x7b, 0x20, 0x65, 0x6c, 0x73, 0x65, 0x20, 0x7d, 0x7d, 0x0a,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x7b, 0x7b, 0x70, 0x61,
+					0x72, 0x61, 0x6d, 0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x7d, 0x7d, 0x20,
 					0x3d, 0x20, 0x7b, 0x7b, 0x69, 0x66, 0x20, 0x70, 0x61, 0x72, 0x61, 0x6d,
-					0x2e, 0x50, 0x61, 0x72, 0x73, 0x65, 0x72, 0x7d, 0x7d, 0x75, 0x74, 0x69,
-					0x6c, 0x73, 0x2e, 0x7b, 0x7b, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x2e, 0x50,
-					0x61, 0x72, 0x73, 0x65, 0x72, 0x2e, 0x46, 0x6e, 0x7d, 0x7d, 0x28, 0x7b,
-					0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x76, 0x61, 0x72, 0x73, 0x5b, 0x22,
-					0x7b, 0x7b, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x2e, 0x4e, 0x61, 0x6d, 0x65,
-					0x7d, 0x7d, 0x22, 0x5d, 0x7b, 0x7b, 0x69, 0x66, 0x20, 0x70, 0x61, 0x72,
-					0x61, 0x6d, 0x2e, 0x50, 0x61, 0x72, 0x73, 0x65, 0x72, 0x7d, 0x7d, 0x29,
+					0x2e, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x7d, 0x7d, 0x7b, 0x7b,
+					0x70, 0x61, 0x72, 0x61, 0x6d, 0x2e, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72,
+					0x74, 0x7d, 0x7d, 0x28, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x7b,
+					0x7b, 0x69, 0x66, 0x20, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x2e, 0x50, 0x61,
+					0x72, 0x73, 0x65, 0x72, 0x7d, 0x7d, 0x75, 0x74, 0x69, 0x6c, 0x73, 0x2e,
+					0x7b, 0x7b, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x2e, 0x50, 0x61, 0x72, 0x73,
+					0x65, 0x72, 0x2e, 0x46, 0x6e, 0x7d, 0x7d, 0x28, 0x7b, 0x7b, 0x65, 0x6e,
+					0x64, 0x7d, 0x7d, 0x7b, 0x7b, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x2e, 0x56,
+					0x61, 0x6c, 0x75, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x28, 0x29, 0x7d, 0x7d,
+					0x7b, 0x7b, 0x69, 0x66, 0x20, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x2e, 0x50,
+					0x61, 0x72, 0x73, 0x65, 0x72, 0x7d, 0x7d, 0x29, 0x7b, 0x7b, 0x65, 0x6e,
+					0x64, 0x7d, 0x7d, 0x7b, 0x7b, 0x69, 0x66, 0x20, 0x70, 0x61, 0x72, 0x61,
+					0x6d, 0x2e, 0x43, 0x6f, 0x6e, 0x76, 0x65, 0x72, 0x74, 0x7d, 0x7d, 0x29,
 					0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20,
-					0x7b, 0x7b, 0x20, 0x65, 0x6e, 0x64, 0x20, 0x7d, 0x7d, 0x0a, 0x20, 0x20,
-					0x20, 0x20, 0x7b, 0x7b, 0x69, 0x66, 0x20, 0x70, 0x61, 0x72, 0x61, 0x6d,
-					0x2e, 0x50, 0x61, 0x72, 0x73, 0x65, 0x72, 0x20, 0x26, 0x26, 0x20, 0x21,
-					0x70, 0x61, 0x72, 0x61, 0x6d, 0x2e, 0x50, 0x61, 0x72, 0x73, 0x65, 0x72,
-					0x2e, 0x4e, 0x6f, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x7d, 0x7d, 0x0a, 0x20,
-					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x69, 0x66, 0x20, 0x65, 0x72,
-					0x72, 0x20, 0x21, 0x3d, 0x20, 0x6e, 0x69, 0x6c, 0x20, 0x7b, 0x0a, 0x20,
-					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x72,
-					0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73,
-					0x74, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x2e, 0x48, 0x54,
-					0x54, 0x50, 0x42, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-					0x28, 0x65, 0x72, 0x72, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x28, 0x29,
-					0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x0a,
+					0x20, 0x20, 0x20, 0x20, 0x7b, 0x7b, 0x20, 0x65, 0x6e, 0x64, 0x20, 0x7d,
+					0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x0a,
 					0x20, 0x20, 0x20, 0x20, 0x7b, 0x7b, 0x20, 0x65, 0x6e, 0x64, 0x20, 0x7d,
 					0x7d, 0x0a, 0x7b, 0x7b, 0x20, 0x65, 0x6e, 0x64, 0x20, 0x7d, 0x7d, 0x0a,
 					0x7b, 0x7b, 0x69, 0x66, 0x20, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
@@ -2615,27 +3279,48 @@ func init() {
 					0x20, 0x7b, 0x7b, 0x69, 0x66, 0x20, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x2e,
 					0x50, 0x61, 0x72, 0x61, 0x6d, 0x54, 0x79, 0x70, 0x65, 0x20, 0x3d, 0x3d,
 					0x20, 0x22, 0x42, 0x4f, 0x44, 0x59, 0x22, 0x7d, 0x7d, 0x0a, 0x20, 0x20,
-					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x65, 0x72,
-					0x72, 0x20, 0x3d, 0x20, 0x68, 0x74, 0x74, 0x70, 0x4f, 0x70, 0x74, 0x69,
-					0x6f, 0x6e, 0x73, 0x2e, 0x7b, 0x7b, 0x68, 0x74, 0x74, 0x70, 0x52, 0x65,
-					0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x72,
-					0x28, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x29,
-					0x7d, 0x7d, 0x28, 0x72, 0x2c, 0x20, 0x7b, 0x7b, 0x69, 0x66, 0x20, 0x21,
-					0x70, 0x61, 0x72, 0x61, 0x6d, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x2e, 0x50,
-					0x6f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x7d, 0x7d, 0x26, 0x7b, 0x7b, 0x65,
-					0x6e, 0x64, 0x7d, 0x7d, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e,
-					0x7b, 0x7b, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x2e, 0x46, 0x69, 0x65, 0x6c,
-					0x64, 0x7d, 0x7d, 0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
-					0x20, 0x7b, 0x7b, 0x20, 0x65, 0x6e, 0x64, 0x20, 0x7d, 0x7d, 0x0a, 0x20,
-					0x20, 0x20, 0x20, 0x7b, 0x7b, 0x20, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x0a,
-					0x7b, 0x7b, 0x20, 0x65, 0x6e, 0x64, 0x20, 0x7d, 0x7d, 0x0a, 0x72, 0x65,
-					0x74, 0x75, 0x72, 0x6e, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-					0x2c, 0x20, 0x65, 0x72, 0x72,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x69, 0x66,
+					0x20, 0x65, 0x72, 0x72, 0x20, 0x3a, 0x3d, 0x20, 0x68, 0x74, 0x74, 0x70,
+					0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x7b, 0x7b, 0x68, 0x74,
+					0x74, 0x70, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x44, 0x65, 0x63,
+					0x6f, 0x64, 0x65, 0x72, 0x28, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x2e, 0x4e,
+					0x61, 0x6d, 0x65, 0x29, 0x7d, 0x7d, 0x28, 0x72, 0x2c, 0x20, 0x7b, 0x7b,
+					0x69, 0x66, 0x20, 0x21, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x2e, 0x54, 0x79,
+					0x70, 0x65, 0x2e, 0x50, 0x6f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x7d, 0x7d,
+					0x26, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x72, 0x65, 0x71, 0x75,
+					0x65, 0x73, 0x74, 0x2e, 0x7b, 0x7b, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x2e,
+					0x46, 0x69, 0x65, 0x6c, 0x64, 0x7d, 0x7d, 0x29, 0x3b, 0x20, 0x65, 0x72,
+					0x72, 0x20, 0x21, 0x3d, 0x20, 0x6e, 0x69, 0x6c, 0x20, 0x7b, 0x0a, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x72, 0x72, 0x6f,
+					0x72, 0x73, 0x20, 0x3d, 0x20, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x28,
+					0x66, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x2c,
+					0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x2e, 0x46, 0x69, 0x65, 0x6c,
+					0x64, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x7b, 0x4e, 0x61, 0x6d, 0x65, 0x3a,
+					0x20, 0x22, 0x7b, 0x7b, 0x70, 0x61, 0x72, 0x61, 0x6d, 0x2e, 0x46, 0x69,
+					0x65, 0x6c, 0x64, 0x7d, 0x7d, 0x22, 0x2c, 0x20, 0x4c, 0x6f, 0x63, 0x61,
+					0x74, 0x69, 0x6f, 0x6e, 0x3a, 0x20, 0x22, 0x62, 0x6f, 0x64, 0x79, 0x22,
+					0x2c, 0x20, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x3a, 0x20, 0x65, 0x72,
+					0x72, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x28, 0x29, 0x7d, 0x29, 0x0a,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x7b,
+					0x20, 0x65, 0x6e, 0x64, 0x20, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20,
+					0x7b, 0x7b, 0x20, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x0a, 0x7b, 0x7b, 0x20,
+					0x65, 0x6e, 0x64, 0x20, 0x7d, 0x7d, 0x0a, 0x69, 0x66, 0x20, 0x6c, 0x65,
+					0x6e, 0x28, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72,
+					0x73, 0x29, 0x20, 0x3e, 0x20, 0x30, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20,
+					0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x72, 0x65, 0x71, 0x75,
+					0x65, 0x73, 0x74, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x2e,
+					0x48, 0x54, 0x54, 0x50, 0x42, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65,
+					0x73, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x28, 0x66, 0x69, 0x65,
+					0x6c, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x29, 0x0a, 0x7d, 0x0a,
+					0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65,
+					0x73, 0x74, 0x2c, 0x20, 0x6e, 0x69, 0x6c, 0x0a,
 				},
 				fi: FileInfo{
 					name:    "_decoder.jet",
-					size:    1157,
-					modTime: time.Unix(0, 1587859514500997419),
+					size:    2168,
+					modTime: time.Unix(0, 1792310018103200714),
 					isDir:   false,
 				},
 			}, "/assets/service/gen/transport/http/http.jet": {
@@ -2994,379 +3679,427 @@ func init() {
 					0x73, 0x22, 0x0a, 0x09, 0x22, 0x7b, 0x7b, 0x20, 0x2e, 0x53, 0x65, 0x72,
 					0x76, 0x69, 0x63, 0x65, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x20,
 					0x7d, 0x7d, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x75, 0x74, 0x69, 0x6c, 0x73,
-					0x22, 0x0a, 0x09, 0x22, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x22,
-					0x0a, 0x09, 0x22, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
-					0x6d, 0x2f, 0x67, 0x6f, 0x72, 0x69, 0x6c, 0x6c, 0x61, 0x2f, 0x6d, 0x75,
-					0x78, 0x22, 0x0a, 0x09, 0x22, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e,
-					0x67, 0x2f, 0x6a, 0x73, 0x6f, 0x6e, 0x22, 0x0a, 0x0a, 0x09, 0x67, 0x6f,
-					0x4b, 0x69, 0x74, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x20,
-					0x22, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-					0x67, 0x6f, 0x2d, 0x6b, 0x69, 0x74, 0x2f, 0x6b, 0x69, 0x74, 0x2f, 0x65,
-					0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x22, 0x0a, 0x09, 0x67, 0x6f,
-					0x4b, 0x69, 0x74, 0x48, 0x74, 0x74, 0x70, 0x20, 0x22, 0x67, 0x69, 0x74,
-					0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x6f, 0x2d, 0x6b,
-					0x69, 0x74, 0x2f, 0x6b, 0x69, 0x74, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73,
-					0x70, 0x6f, 0x72, 0x74, 0x2f, 0x68, 0x74, 0x74, 0x70, 0x22, 0x0a, 0x09,
-					0x67, 0x6f, 0x48, 0x74, 0x74, 0x70, 0x20, 0x22, 0x6e, 0x65, 0x74, 0x2f,
-					0x68, 0x74, 0x74, 0x70, 0x22, 0x0a, 0x7b, 0x7b, 0x69, 0x66, 0x20, 0x2e,
-					0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x71,
-					0x75, 0x65, 0x73, 0x74, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x7d, 0x7d,
-					0x7b, 0x7b, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e,
-					0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x6d, 0x70, 0x6f, 0x72,
-					0x74, 0x2e, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x7d, 0x7d, 0x20, 0x22, 0x7b,
-					0x7b, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x52,
-					0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
-					0x2e, 0x50, 0x61, 0x74, 0x68, 0x7d, 0x7d, 0x22, 0x20, 0x7b, 0x7b, 0x65,
-					0x6e, 0x64, 0x7d, 0x7d, 0x0a, 0x7b, 0x7b, 0x69, 0x66, 0x20, 0x2e, 0x45,
-					0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x70,
-					0x6f, 0x6e, 0x73, 0x65, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x7d, 0x7d,
-					0x7b, 0x7b, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e,
-					0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x6d, 0x70, 0x6f,
-					0x72, 0x74, 0x2e, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x7d, 0x7d, 0x20, 0x22,
-					0x7b, 0x7b, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e,
-					0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x6d, 0x70, 0x6f,
-					0x72, 0x74, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x7d, 0x7d, 0x22, 0x20, 0x7b,
-					0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x0a, 0x29, 0x0a, 0x74, 0x79, 0x70,
-					0x65, 0x20, 0x7b, 0x7b, 0x20, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69,
-					0x6e, 0x74, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x20, 0x7d, 0x7d, 0x44, 0x65,
-					0x63, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x46,
-					0x75, 0x6e, 0x63, 0x20, 0x66, 0x75, 0x6e, 0x63, 0x28, 0x63, 0x6f, 0x6e,
-					0x74, 0x65, 0x78, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74,
+					0x22, 0x0a, 0x09, 0x7b, 0x7b, 0x20, 0x69, 0x66, 0x20, 0x2e, 0x45, 0x6e,
+					0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65,
+					0x73, 0x74, 0x20, 0x7d, 0x7d, 0x22, 0x7b, 0x7b, 0x20, 0x2e, 0x53, 0x65,
+					0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74,
+					0x20, 0x7d, 0x7d, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x65, 0x72, 0x72, 0x6f,
+					0x72, 0x73, 0x22, 0x7b, 0x7b, 0x20, 0x65, 0x6e, 0x64, 0x20, 0x7d, 0x7d,
+					0x0a, 0x09, 0x7b, 0x7b, 0x20, 0x69, 0x66, 0x20, 0x2e, 0x45, 0x6e, 0x64,
+					0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61,
+					0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x7d, 0x7d, 0x22, 0x7b, 0x7b, 0x20,
+					0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x49, 0x6d, 0x70,
+					0x6f, 0x72, 0x74, 0x20, 0x7d, 0x7d, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x76,
+					0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x7b, 0x7b,
+					0x20, 0x65, 0x6e, 0x64, 0x20, 0x7d, 0x7d, 0x0a, 0x09, 0x22, 0x63, 0x6f,
+					0x6e, 0x74, 0x65, 0x78, 0x74, 0x22, 0x0a, 0x09, 0x22, 0x67, 0x69, 0x74,
+					0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x6f, 0x72, 0x69,
+					0x6c, 0x6c, 0x61, 0x2f, 0x6d, 0x75, 0x78, 0x22, 0x0a, 0x09, 0x22, 0x65,
+					0x6e, 0x63, 0x6f, 0x64, 0x69, 0x6e, 0x67, 0x2f, 0x6a, 0x73, 0x6f, 0x6e,
+					0x22, 0x0a, 0x0a, 0x09, 0x67, 0x6f, 0x4b, 0x69, 0x74, 0x45, 0x6e, 0x64,
+					0x70, 0x6f, 0x69, 0x6e, 0x74, 0x20, 0x22, 0x67, 0x69, 0x74, 0x68, 0x75,
+					0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x67, 0x6f, 0x2d, 0x6b, 0x69, 0x74,
+					0x2f, 0x6b, 0x69, 0x74, 0x2f, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e,
+					0x74, 0x22, 0x0a, 0x09, 0x67, 0x6f, 0x4b, 0x69, 0x74, 0x48, 0x74, 0x74,
+					0x70, 0x20, 0x22, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f,
+					0x6d, 0x2f, 0x67, 0x6f, 0x2d, 0x6b, 0x69, 0x74, 0x2f, 0x6b, 0x69, 0x74,
+					0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2f, 0x68,
+					0x74, 0x74, 0x70, 0x22, 0x0a, 0x09, 0x67, 0x6f, 0x48, 0x74, 0x74, 0x70,
+					0x20, 0x22, 0x6e, 0x65, 0x74, 0x2f, 0x68, 0x74, 0x74, 0x70, 0x22, 0x0a,
 					0x7b, 0x7b, 0x69, 0x66, 0x20, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69,
-					0x6e, 0x74, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x7d, 0x7d,
-					0x20, 0x2c, 0x20, 0x2a, 0x67, 0x6f, 0x48, 0x74, 0x74, 0x70, 0x2e, 0x52,
-					0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x7b, 0x7b, 0x20, 0x65, 0x6e, 0x64,
-					0x20, 0x7d, 0x7d, 0x29, 0x20, 0x28, 0x7b, 0x7b, 0x69, 0x66, 0x20, 0x2e,
-					0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x71,
-					0x75, 0x65, 0x73, 0x74, 0x7d, 0x7d, 0x20, 0x7b, 0x7b, 0x2e, 0x45, 0x6e,
-					0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d,
-					0x73, 0x5b, 0x31, 0x5d, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x7d, 0x7d, 0x20,
-					0x2c, 0x20, 0x7b, 0x7b, 0x20, 0x65, 0x6e, 0x64, 0x20, 0x7d, 0x7d, 0x65,
-					0x72, 0x72, 0x6f, 0x72, 0x29, 0x0a, 0x0a, 0x74, 0x79, 0x70, 0x65, 0x20,
-					0x7b, 0x7b, 0x20, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
-					0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x20, 0x7d, 0x7d, 0x45, 0x6e, 0x63, 0x6f,
-					0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x46, 0x75,
-					0x6e, 0x63, 0x20, 0x66, 0x75, 0x6e, 0x63, 0x28, 0x63, 0x6f, 0x6e, 0x74,
-					0x65, 0x78, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x2c,
-					0x20, 0x67, 0x6f, 0x48, 0x74, 0x74, 0x70, 0x2e, 0x52, 0x65, 0x73, 0x70,
-					0x6f, 0x6e, 0x73, 0x65, 0x57, 0x72, 0x69, 0x74, 0x65, 0x72, 0x7b, 0x7b,
-					0x69, 0x66, 0x20, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
-					0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x7d, 0x7d, 0x2c,
-					0x20, 0x7b, 0x7b, 0x20, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e,
-					0x74, 0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x5b, 0x30, 0x5d,
-					0x2e, 0x54, 0x79, 0x70, 0x65, 0x20, 0x7d, 0x7d, 0x20, 0x7b, 0x7b, 0x65,
-					0x6e, 0x64, 0x7d, 0x7d, 0x29, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x0a,
-					0x0a, 0x74, 0x79, 0x70, 0x65, 0x20, 0x7b, 0x7b, 0x20, 0x6c, 0x6f, 0x77,
-					0x65, 0x72, 0x46, 0x69, 0x72, 0x73, 0x74, 0x28, 0x20, 0x2e, 0x45, 0x6e,
-					0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x20,
-					0x29, 0x20, 0x7d, 0x7d, 0x20, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x20,
-					0x7b, 0x0a, 0x09, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x6f, 0x75,
-					0x74, 0x65, 0x73, 0x20, 0x5b, 0x5d, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64,
-					0x52, 0x6f, 0x75, 0x74, 0x65, 0x0a, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65,
-					0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x20, 0x20, 0x20,
-					0x20, 0x20, 0x5b, 0x5d, 0x67, 0x6f, 0x4b, 0x69, 0x74, 0x48, 0x74, 0x74,
-					0x70, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4f, 0x70, 0x74, 0x69,
-					0x6f, 0x6e, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72,
-					0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x20, 0x20, 0x67, 0x6f, 0x4b,
-					0x69, 0x74, 0x48, 0x74, 0x74, 0x70, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72,
-					0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x0a, 0x20, 0x20, 0x20, 0x20,
-					0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x6e, 0x74, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x6d,
+					0x70, 0x6f, 0x72, 0x74, 0x7d, 0x7d, 0x7b, 0x7b, 0x2e, 0x45, 0x6e, 0x64,
+					0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+					0x74, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x41, 0x6c, 0x69, 0x61,
+					0x73, 0x7d, 0x7d, 0x20, 0x22, 0x7b, 0x7b, 0x2e, 0x45, 0x6e, 0x64, 0x70,
+					0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+					0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x7d,
+					0x7d, 0x22, 0x20, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x0a, 0x7b,
+					0x7b, 0x69, 0x66, 0x20, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e,
+					0x74, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x49, 0x6d,
+					0x70, 0x6f, 0x72, 0x74, 0x7d, 0x7d, 0x7b, 0x7b, 0x2e, 0x45, 0x6e, 0x64,
+					0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+					0x73, 0x65, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x41, 0x6c, 0x69,
+					0x61, 0x73, 0x7d, 0x7d, 0x20, 0x22, 0x7b, 0x7b, 0x2e, 0x45, 0x6e, 0x64,
+					0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+					0x73, 0x65, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x50, 0x61, 0x74,
+					0x68, 0x7d, 0x7d, 0x22, 0x20, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d,
+					0x0a, 0x7b, 0x7b, 0x69, 0x66, 0x20, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f,
+					0x69, 0x6e, 0x74, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x54, 0x72, 0x61, 0x6e,
+					0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+					0x74, 0x7d, 0x7d, 0x7b, 0x7b, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x20, 0x2e,
+					0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x48, 0x74, 0x74,
+					0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x52,
+					0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+					0x74, 0x73, 0x7d, 0x7d, 0x7b, 0x7b, 0x2e, 0x41, 0x6c, 0x69, 0x61, 0x73,
+					0x7d, 0x7d, 0x20, 0x22, 0x7b, 0x7b, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x7d,
+					0x7d, 0x22, 0x0a, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x7b, 0x7b,
+					0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x0a, 0x29, 0x0a, 0x74, 0x79, 0x70, 0x65,
 					0x20, 0x7b, 0x7b, 0x20, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e,
 					0x74, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x20, 0x7d, 0x7d, 0x44, 0x65, 0x63,
 					0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x46, 0x75,
-					0x6e, 0x63, 0x0a, 0x09, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x20,
-					0x20, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x7b, 0x20, 0x2e, 0x45, 0x6e, 0x64,
-					0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x20, 0x7d,
-					0x7d, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-					0x6e, 0x73, 0x65, 0x46, 0x75, 0x6e, 0x63, 0x0a, 0x09, 0x65, 0x6e, 0x64,
-					0x70, 0x6f, 0x69, 0x6e, 0x74, 0x20, 0x20, 0x20, 0x20, 0x20, 0x67, 0x6f,
-					0x4b, 0x69, 0x74, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e,
-					0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x0a, 0x09, 0x68, 0x61,
-					0x6e, 0x64, 0x6c, 0x65, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x67,
-					0x6f, 0x48, 0x74, 0x74, 0x70, 0x2e, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65,
-					0x72, 0x0a, 0x7d, 0x0a, 0x0a, 0x74, 0x79, 0x70, 0x65, 0x20, 0x7b, 0x7b,
-					0x20, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x4e,
-					0x61, 0x6d, 0x65, 0x20, 0x7d, 0x7d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-					0x20, 0x66, 0x75, 0x6e, 0x63, 0x28, 0x2a, 0x7b, 0x7b, 0x20, 0x6c, 0x6f,
-					0x77, 0x65, 0x72, 0x46, 0x69, 0x72, 0x73, 0x74, 0x28, 0x20, 0x2e, 0x45,
-					0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x4e, 0x61, 0x6d, 0x65,
-					0x20, 0x29, 0x20, 0x7d, 0x7d, 0x29, 0x0a, 0x0a, 0x66, 0x75, 0x6e, 0x63,
-					0x20, 0x73, 0x65, 0x74, 0x7b, 0x7b, 0x20, 0x2e, 0x45, 0x6e, 0x64, 0x70,
-					0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x20, 0x7d, 0x7d,
-					0x44, 0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f,
-					0x6e, 0x73, 0x28, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74,
-					0x20, 0x2a, 0x7b, 0x7b, 0x20, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x46, 0x69,
-					0x72, 0x73, 0x74, 0x28, 0x20, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69,
-					0x6e, 0x74, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x20, 0x29, 0x20, 0x7d, 0x7d,
-					0x2c, 0x20, 0x68, 0x74, 0x74, 0x70, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-					0x73, 0x20, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x29, 0x20, 0x7b,
-					0x0a, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e,
-					0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73,
-					0x20, 0x3d, 0x20, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x28, 0x7b, 0x7b,
-					0x20, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x46, 0x69, 0x72, 0x73, 0x74, 0x28,
-					0x20, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x4e,
-					0x61, 0x6d, 0x65, 0x20, 0x29, 0x20, 0x7d, 0x7d, 0x52, 0x6f, 0x75, 0x74,
-					0x65, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x28, 0x29, 0x2c, 0x20,
-					0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x6d, 0x65,
-					0x74, 0x68, 0x6f, 0x64, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x2e, 0x2e,
-					0x2e, 0x29, 0x0a, 0x09, 0x69, 0x66, 0x20, 0x74, 0x72, 0x61, 0x6e, 0x73,
-					0x70, 0x6f, 0x72, 0x74, 0x2e, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x72,
-					0x20, 0x3d, 0x3d, 0x20, 0x6e, 0x69, 0x6c, 0x20, 0x7b, 0x0a, 0x09, 0x09,
-					0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x64, 0x65,
-					0x63, 0x6f, 0x64, 0x65, 0x72, 0x20, 0x3d, 0x20, 0x6d, 0x61, 0x6b, 0x65,
+					0x6e, 0x63, 0x20, 0x66, 0x75, 0x6e, 0x63, 0x28, 0x63, 0x6f, 0x6e, 0x74,
+					0x65, 0x78, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x7b,
+					0x7b, 0x69, 0x66, 0x20, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e,
+					0x74, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x7d, 0x7d, 0x20,
+					0x2c, 0x20, 0x2a, 0x67, 0x6f, 0x48, 0x74, 0x74, 0x70, 0x2e, 0x52, 0x65,
+					0x71, 0x75, 0x65, 0x73, 0x74, 0x7b, 0x7b, 0x20, 0x65, 0x6e, 0x64, 0x20,
+					0x7d, 0x7d, 0x29, 0x20, 0x28, 0x7b, 0x7b, 0x69, 0x66, 0x20, 0x2e, 0x45,
+					0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x71, 0x75,
+					0x65, 0x73, 0x74, 0x7d, 0x7d, 0x20, 0x7b, 0x7b, 0x2e, 0x45, 0x6e, 0x64,
+					0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x73,
+					0x5b, 0x31, 0x5d, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x7d, 0x7d, 0x20, 0x2c,
+					0x20, 0x7b, 0x7b, 0x20, 0x65, 0x6e, 0x64, 0x20, 0x7d, 0x7d, 0x65, 0x72,
+					0x72, 0x6f, 0x72, 0x29, 0x0a, 0x0a, 0x74, 0x79, 0x70, 0x65, 0x20, 0x7b,
+					0x7b, 0x20, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e,
+					0x4e, 0x61, 0x6d, 0x65, 0x20, 0x7d, 0x7d, 0x45, 0x6e, 0x63, 0x6f, 0x64,
+					0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x46, 0x75, 0x6e,
+					0x63, 0x20, 0x66, 0x75, 0x6e, 0x63, 0x28, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+					0x78, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x2c, 0x20,
+					0x67, 0x6f, 0x48, 0x74, 0x74, 0x70, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+					0x6e, 0x73, 0x65, 0x57, 0x72, 0x69, 0x74, 0x65, 0x72, 0x7b, 0x7b, 0x69,
+					0x66, 0x20, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e,
+					0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x7d, 0x7d, 0x2c, 0x20,
+					0x7b, 0x7b, 0x20, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+					0x2e, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x5b, 0x30, 0x5d, 0x2e,
+					0x54, 0x79, 0x70, 0x65, 0x20, 0x7d, 0x7d, 0x20, 0x7b, 0x7b, 0x65, 0x6e,
+					0x64, 0x7d, 0x7d, 0x29, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x0a, 0x0a,
+					0x74, 0x79, 0x70, 0x65, 0x20, 0x7b, 0x7b, 0x20, 0x6c, 0x6f, 0x77, 0x65,
+					0x72, 0x46, 0x69, 0x72, 0x73, 0x74, 0x28, 0x20, 0x2e, 0x45, 0x6e, 0x64,
+					0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x20, 0x29,
+					0x20, 0x7d, 0x7d, 0x20, 0x73, 0x74, 0x72, 0x75, 0x63, 0x74, 0x20, 0x7b,
+					0x0a, 0x09, 0x6d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x6f, 0x75, 0x74,
+					0x65, 0x73, 0x20, 0x5b, 0x5d, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52,
+					0x6f, 0x75, 0x74, 0x65, 0x0a, 0x09, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+					0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x5b, 0x5d, 0x67, 0x6f, 0x4b, 0x69, 0x74, 0x48, 0x74, 0x74, 0x70,
+					0x2e, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+					0x6e, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x45,
+					0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x20, 0x20, 0x67, 0x6f, 0x4b, 0x69,
+					0x74, 0x48, 0x74, 0x74, 0x70, 0x2e, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x45,
+					0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x64,
+					0x65, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
 					0x7b, 0x7b, 0x20, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
 					0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x20, 0x7d, 0x7d, 0x44, 0x65, 0x63, 0x6f,
-					0x64, 0x65, 0x72, 0x28, 0x68, 0x74, 0x74, 0x70, 0x4f, 0x70, 0x74, 0x69,
-					0x6f, 0x6e, 0x73, 0x29, 0x0a, 0x09, 0x7d, 0x0a, 0x09, 0x69, 0x66, 0x20,
-					0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x65, 0x6e,
-					0x63, 0x6f, 0x64, 0x65, 0x72, 0x20, 0x3d, 0x3d, 0x20, 0x6e, 0x69, 0x6c,
-					0x20, 0x7b, 0x0a, 0x09, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f,
-					0x72, 0x74, 0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x20, 0x3d,
-					0x20, 0x6d, 0x61, 0x6b, 0x65, 0x7b, 0x7b, 0x20, 0x2e, 0x45, 0x6e, 0x64,
-					0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x20, 0x7d,
-					0x7d, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x28, 0x68, 0x74, 0x74,
-					0x70, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x29, 0x0a, 0x09, 0x7d,
-					0x0a, 0x09, 0x69, 0x66, 0x20, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f,
-					0x72, 0x74, 0x2e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x45, 0x6e, 0x63, 0x6f,
-					0x64, 0x65, 0x72, 0x20, 0x3d, 0x3d, 0x20, 0x6e, 0x69, 0x6c, 0x20, 0x7b,
-					0x0a, 0x09, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74,
-					0x2e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65,
-					0x72, 0x20, 0x3d, 0x20, 0x68, 0x74, 0x74, 0x70, 0x4f, 0x70, 0x74, 0x69,
-					0x6f, 0x6e, 0x73, 0x2e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x45, 0x6e, 0x63,
-					0x6f, 0x64, 0x65, 0x72, 0x0a, 0x09, 0x7d, 0x0a, 0x09, 0x74, 0x72, 0x61,
-					0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
-					0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x3d, 0x20, 0x61,
-					0x70, 0x70, 0x65, 0x6e, 0x64, 0x28, 0x68, 0x74, 0x74, 0x70, 0x4f, 0x70,
-					0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
-					0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2c, 0x20, 0x74, 0x72, 0x61,
-					0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65,
-					0x72, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x2e, 0x2e, 0x29,
-					0x0a, 0x7d, 0x0a, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x6d, 0x61, 0x6b,
-					0x65, 0x7b, 0x7b, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
-					0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x48, 0x74, 0x74, 0x70, 0x54,
-					0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x28, 0x65, 0x6e, 0x64,
-					0x70, 0x6f, 0x69, 0x6e, 0x74, 0x20, 0x67, 0x6f, 0x4b, 0x69, 0x74, 0x45,
-					0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x45, 0x6e, 0x64, 0x70,
-					0x6f, 0x69, 0x6e, 0x74, 0x2c, 0x20, 0x68, 0x74, 0x74, 0x70, 0x4f, 0x70,
-					0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-					0x73, 0x2c, 0x20, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x2e,
-					0x2e, 0x2e, 0x7b, 0x7b, 0x20, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69,
-					0x6e, 0x74, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x20, 0x7d, 0x7d, 0x4f, 0x70,
-					0x74, 0x69, 0x6f, 0x6e, 0x29, 0x20, 0x48, 0x54, 0x54, 0x50, 0x20, 0x7b,
-					0x0a, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x20,
-					0x3a, 0x3d, 0x20, 0x26, 0x7b, 0x7b, 0x20, 0x6c, 0x6f, 0x77, 0x65, 0x72,
-					0x46, 0x69, 0x72, 0x73, 0x74, 0x28, 0x20, 0x2e, 0x45, 0x6e, 0x64, 0x70,
-					0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x20, 0x29, 0x20,
-					0x7d, 0x7d, 0x7b, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x3a,
-					0x20, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x7d, 0x0a, 0x20,
-					0x20, 0x20, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x5f, 0x2c, 0x20, 0x6f, 0x70,
-					0x74, 0x69, 0x6f, 0x6e, 0x20, 0x3a, 0x3d, 0x20, 0x72, 0x61, 0x6e, 0x67,
-					0x65, 0x20, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x7b, 0x0a,
-					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x6f, 0x70, 0x74, 0x69,
-					0x6f, 0x6e, 0x28, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74,
-					0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20,
+					0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x46, 0x75, 0x6e,
+					0x63, 0x0a, 0x09, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x7b, 0x7b, 0x20, 0x2e, 0x45, 0x6e, 0x64, 0x70,
+					0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x20, 0x7d, 0x7d,
+					0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+					0x73, 0x65, 0x46, 0x75, 0x6e, 0x63, 0x0a, 0x09, 0x65, 0x6e, 0x64, 0x70,
+					0x6f, 0x69, 0x6e, 0x74, 0x20, 0x20, 0x20, 0x20, 0x20, 0x67, 0x6f, 0x4b,
+					0x69, 0x74, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x45,
+					0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x0a, 0x09, 0x68, 0x61, 0x6e,
+					0x64, 0x6c, 0x65, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x67, 0x6f,
+					0x48, 0x74, 0x74, 0x70, 0x2e, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72,
+					0x0a, 0x7d, 0x0a, 0x0a, 0x74, 0x79, 0x70, 0x65, 0x20, 0x7b, 0x7b, 0x20,
+					0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x4e, 0x61,
+					0x6d, 0x65, 0x20, 0x7d, 0x7d, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x20,
+					0x66, 0x75, 0x6e, 0x63, 0x28, 0x2a, 0x7b, 0x7b, 0x20, 0x6c, 0x6f, 0x77,
+					0x65, 0x72, 0x46, 0x69, 0x72, 0x73, 0x74, 0x28, 0x20, 0x2e, 0x45, 0x6e,
+					0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x20,
+					0x29, 0x20, 0x7d, 0x7d, 0x29, 0x0a, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20,
 					0x73, 0x65, 0x74, 0x7b, 0x7b, 0x20, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f,
 					0x69, 0x6e, 0x74, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x20, 0x7d, 0x7d, 0x44,
 					0x65, 0x66, 0x61, 0x75, 0x6c, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
-					0x73, 0x28, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2c,
+					0x73, 0x28, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x20,
+					0x2a, 0x7b, 0x7b, 0x20, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x46, 0x69, 0x72,
+					0x73, 0x74, 0x28, 0x20, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e,
+					0x74, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x20, 0x29, 0x20, 0x7d, 0x7d, 0x2c,
 					0x20, 0x68, 0x74, 0x74, 0x70, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-					0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e,
-					0x20, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x0a, 0x7d,
-					0x0a, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x7b, 0x7b, 0x20, 0x6c, 0x6f,
-					0x77, 0x65, 0x72, 0x46, 0x69, 0x72, 0x73, 0x74, 0x28, 0x20, 0x2e, 0x45,
-					0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x4e, 0x61, 0x6d, 0x65,
-					0x20, 0x29, 0x20, 0x7d, 0x7d, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x4d, 0x65,
-					0x74, 0x68, 0x6f, 0x64, 0x73, 0x28, 0x29, 0x20, 0x5b, 0x5d, 0x4d, 0x65,
-					0x74, 0x68, 0x6f, 0x64, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x20, 0x7b, 0x0a,
-					0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x5b, 0x5d, 0x4d, 0x65,
-					0x74, 0x68, 0x6f, 0x64, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x7b, 0x0a, 0x20,
-					0x20, 0x20, 0x20, 0x7b, 0x7b, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x20, 0x2e,
-					0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x48, 0x74, 0x74,
-					0x70, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x4d,
-					0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x7d,
-					0x7d, 0x7b, 0x0a, 0x09, 0x09, 0x09, 0x4e, 0x61, 0x6d, 0x65, 0x3a, 0x20,
-					0x20, 0x20, 0x22, 0x7b, 0x7b, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d,
-					0x22, 0x2c, 0x0a, 0x09, 0x09, 0x09, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x3a,
-					0x20, 0x20, 0x22, 0x7b, 0x7b, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x7d,
-					0x7d, 0x22, 0x2c, 0x0a, 0x09, 0x09, 0x09, 0x4d, 0x65, 0x74, 0x68, 0x6f,
-					0x64, 0x73, 0x3a, 0x20, 0x5b, 0x5d, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64,
-					0x7b, 0x7b, 0x22, 0x7b, 0x22, 0x7d, 0x7d, 0x7b, 0x7b, 0x72, 0x61, 0x6e,
-					0x67, 0x65, 0x20, 0x69, 0x6e, 0x78, 0x2c, 0x20, 0x6d, 0x74, 0x68, 0x20,
-					0x3a, 0x3d, 0x20, 0x2e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x7d,
-					0x7d, 0x7b, 0x7b, 0x20, 0x69, 0x66, 0x20, 0x69, 0x6e, 0x78, 0x20, 0x3e,
-					0x20, 0x30, 0x20, 0x7d, 0x7d, 0x2c, 0x20, 0x7b, 0x7b, 0x20, 0x65, 0x6e,
-					0x64, 0x20, 0x7d, 0x7d, 0x7b, 0x7b, 0x20, 0x6d, 0x74, 0x68, 0x20, 0x7d,
-					0x7d, 0x7b, 0x7b, 0x20, 0x65, 0x6e, 0x64, 0x20, 0x7d, 0x7d, 0x7b, 0x7b,
-					0x22, 0x7d, 0x22, 0x7d, 0x7d, 0x2c, 0x0a, 0x09, 0x09, 0x7d, 0x2c, 0x7b,
-					0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d,
-					0x0a, 0x7d, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x6d, 0x61, 0x6b, 0x65,
+					0x20, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x29, 0x20, 0x7b, 0x0a,
+					0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x6d,
+					0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x20,
+					0x3d, 0x20, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x64, 0x28, 0x7b, 0x7b, 0x20,
+					0x6c, 0x6f, 0x77, 0x65, 0x72, 0x46, 0x69, 0x72, 0x73, 0x74, 0x28, 0x20,
+					0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x4e, 0x61,
+					0x6d, 0x65, 0x20, 0x29, 0x20, 0x7d, 0x7d, 0x52, 0x6f, 0x75, 0x74, 0x65,
+					0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x28, 0x29, 0x2c, 0x20, 0x74,
+					0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x6d, 0x65, 0x74,
+					0x68, 0x6f, 0x64, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x2e, 0x2e, 0x2e,
+					0x29, 0x0a, 0x09, 0x69, 0x66, 0x20, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70,
+					0x6f, 0x72, 0x74, 0x2e, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x20,
+					0x3d, 0x3d, 0x20, 0x6e, 0x69, 0x6c, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x74,
+					0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x64, 0x65, 0x63,
+					0x6f, 0x64, 0x65, 0x72, 0x20, 0x3d, 0x20, 0x6d, 0x61, 0x6b, 0x65, 0x7b,
+					0x7b, 0x20, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e,
+					0x4e, 0x61, 0x6d, 0x65, 0x20, 0x7d, 0x7d, 0x44, 0x65, 0x63, 0x6f, 0x64,
+					0x65, 0x72, 0x28, 0x68, 0x74, 0x74, 0x70, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+					0x6e, 0x73, 0x29, 0x0a, 0x09, 0x7d, 0x0a, 0x09, 0x69, 0x66, 0x20, 0x74,
+					0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x65, 0x6e, 0x63,
+					0x6f, 0x64, 0x65, 0x72, 0x20, 0x3d, 0x3d, 0x20, 0x6e, 0x69, 0x6c, 0x20,
+					0x7b, 0x0a, 0x09, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72,
+					0x74, 0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x20, 0x3d, 0x20,
+					0x6d, 0x61, 0x6b, 0x65, 0x7b, 0x7b, 0x20, 0x2e, 0x45, 0x6e, 0x64, 0x70,
+					0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x20, 0x7d, 0x7d,
+					0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x28, 0x68, 0x74, 0x74, 0x70,
+					0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x29, 0x0a, 0x09, 0x7d, 0x0a,
+					0x09, 0x69, 0x66, 0x20, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72,
+					0x74, 0x2e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x45, 0x6e, 0x63, 0x6f, 0x64,
+					0x65, 0x72, 0x20, 0x3d, 0x3d, 0x20, 0x6e, 0x69, 0x6c, 0x20, 0x7b, 0x0a,
+					0x09, 0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e,
+					0x65, 0x72, 0x72, 0x6f, 0x72, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72,
+					0x20, 0x3d, 0x20, 0x68, 0x74, 0x74, 0x70, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+					0x6e, 0x73, 0x2e, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x45, 0x6e, 0x63, 0x6f,
+					0x64, 0x65, 0x72, 0x0a, 0x09, 0x7d, 0x0a, 0x09, 0x74, 0x72, 0x61, 0x6e,
+					0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+					0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x3d, 0x20, 0x61, 0x70,
+					0x70, 0x65, 0x6e, 0x64, 0x28, 0x68, 0x74, 0x74, 0x70, 0x4f, 0x70, 0x74,
+					0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4f,
+					0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2c, 0x20, 0x74, 0x72, 0x61, 0x6e,
+					0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+					0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x2e, 0x2e, 0x29, 0x0a,
+					0x7d, 0x0a, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x6d, 0x61, 0x6b, 0x65,
+					0x7b, 0x7b, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e,
+					0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x48, 0x74, 0x74, 0x70, 0x54, 0x72,
+					0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x28, 0x65, 0x6e, 0x64, 0x70,
+					0x6f, 0x69, 0x6e, 0x74, 0x20, 0x67, 0x6f, 0x4b, 0x69, 0x74, 0x45, 0x6e,
+					0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f,
+					0x69, 0x6e, 0x74, 0x2c, 0x20, 0x68, 0x74, 0x74, 0x70, 0x4f, 0x70, 0x74,
+					0x69, 0x6f, 0x6e, 0x73, 0x20, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+					0x2c, 0x20, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x2e, 0x2e,
+					0x2e, 0x7b, 0x7b, 0x20, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e,
+					0x74, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x20, 0x7d, 0x7d, 0x4f, 0x70, 0x74,
+					0x69, 0x6f, 0x6e, 0x29, 0x20, 0x48, 0x54, 0x54, 0x50, 0x20, 0x7b, 0x0a,
+					0x09, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x20, 0x3a,
+					0x3d, 0x20, 0x26, 0x7b, 0x7b, 0x20, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x46,
+					0x69, 0x72, 0x73, 0x74, 0x28, 0x20, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f,
+					0x69, 0x6e, 0x74, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x20, 0x29, 0x20, 0x7d,
+					0x7d, 0x7b, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x3a, 0x20,
+					0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x7d, 0x0a, 0x20, 0x20,
+					0x20, 0x20, 0x66, 0x6f, 0x72, 0x20, 0x5f, 0x2c, 0x20, 0x6f, 0x70, 0x74,
+					0x69, 0x6f, 0x6e, 0x20, 0x3a, 0x3d, 0x20, 0x72, 0x61, 0x6e, 0x67, 0x65,
+					0x20, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20, 0x7b, 0x0a, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+					0x6e, 0x28, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x29,
+					0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x73,
+					0x65, 0x74, 0x7b, 0x7b, 0x20, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69,
+					0x6e, 0x74, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x20, 0x7d, 0x7d, 0x44, 0x65,
+					0x66, 0x61, 0x75, 0x6c, 0x74, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+					0x28, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2c, 0x20,
+					0x68, 0x74, 0x74, 0x70, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x29,
+					0x0a, 0x20, 0x20, 0x20, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20,
+					0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x0a, 0x7d, 0x0a,
+					0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x7b, 0x7b, 0x20, 0x6c, 0x6f, 0x77,
+					0x65, 0x72, 0x46, 0x69, 0x72, 0x73, 0x74, 0x28, 0x20, 0x2e, 0x45, 0x6e,
+					0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x20,
+					0x29, 0x20, 0x7d, 0x7d, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x4d, 0x65, 0x74,
+					0x68, 0x6f, 0x64, 0x73, 0x28, 0x29, 0x20, 0x5b, 0x5d, 0x4d, 0x65, 0x74,
+					0x68, 0x6f, 0x64, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x20, 0x7b, 0x0a, 0x09,
+					0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x5b, 0x5d, 0x4d, 0x65, 0x74,
+					0x68, 0x6f, 0x64, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x7b, 0x0a, 0x20, 0x20,
+					0x20, 0x20, 0x7b, 0x7b, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x20, 0x2e, 0x45,
+					0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x48, 0x74, 0x74, 0x70,
+					0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x4d, 0x65,
+					0x74, 0x68, 0x6f, 0x64, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x7d, 0x7d,
+					0x7b, 0x0a, 0x09, 0x09, 0x09, 0x4e, 0x61, 0x6d, 0x65, 0x3a, 0x20, 0x20,
+					0x20, 0x22, 0x7b, 0x7b, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d, 0x7d, 0x22,
+					0x2c, 0x0a, 0x09, 0x09, 0x09, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x3a, 0x20,
+					0x20, 0x22, 0x7b, 0x7b, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x7d, 0x7d,
+					0x22, 0x2c, 0x0a, 0x09, 0x09, 0x09, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64,
+					0x73, 0x3a, 0x20, 0x5b, 0x5d, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x7b,
+					0x7b, 0x22, 0x7b, 0x22, 0x7d, 0x7d, 0x7b, 0x7b, 0x72, 0x61, 0x6e, 0x67,
+					0x65, 0x20, 0x69, 0x6e, 0x78, 0x2c, 0x20, 0x6d, 0x74, 0x68, 0x20, 0x3a,
+					0x3d, 0x20, 0x2e, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x73, 0x7d, 0x7d,
+					0x7b, 0x7b, 0x20, 0x69, 0x66, 0x20, 0x69, 0x6e, 0x78, 0x20, 0x3e, 0x20,
+					0x30, 0x20, 0x7d, 0x7d, 0x2c, 0x20, 0x7b, 0x7b, 0x20, 0x65, 0x6e, 0x64,
+					0x20, 0x7d, 0x7d, 0x7b, 0x7b, 0x20, 0x6d, 0x74, 0x68, 0x20, 0x7d, 0x7d,
+					0x7b, 0x7b, 0x20, 0x65, 0x6e, 0x64, 0x20, 0x7d, 0x7d, 0x7b, 0x7b, 0x22,
+					0x7d, 0x22, 0x7d, 0x7d, 0x2c, 0x0a, 0x09, 0x09, 0x7d, 0x2c, 0x7b, 0x7b,
+					0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x0a,
+					0x7d, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x6d, 0x61, 0x6b, 0x65, 0x7b,
+					0x7b, 0x20, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e,
+					0x4e, 0x61, 0x6d, 0x65, 0x20, 0x7d, 0x7d, 0x44, 0x65, 0x63, 0x6f, 0x64,
+					0x65, 0x72, 0x28, 0x68, 0x74, 0x74, 0x70, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+					0x6e, 0x73, 0x20, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x29, 0x20,
 					0x7b, 0x7b, 0x20, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
 					0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x20, 0x7d, 0x7d, 0x44, 0x65, 0x63, 0x6f,
-					0x64, 0x65, 0x72, 0x28, 0x68, 0x74, 0x74, 0x70, 0x4f, 0x70, 0x74, 0x69,
-					0x6f, 0x6e, 0x73, 0x20, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x29,
-					0x20, 0x7b, 0x7b, 0x20, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e,
-					0x74, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x20, 0x7d, 0x7d, 0x44, 0x65, 0x63,
-					0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x46, 0x75,
-					0x6e, 0x63, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x72, 0x65, 0x74,
-					0x75, 0x72, 0x6e, 0x20, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x28, 0x5f, 0x20,
-					0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x74,
-					0x65, 0x78, 0x74, 0x7b, 0x7b, 0x69, 0x66, 0x20, 0x2e, 0x45, 0x6e, 0x64,
-					0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-					0x74, 0x7d, 0x7d, 0x2c, 0x20, 0x72, 0x20, 0x2a, 0x67, 0x6f, 0x48, 0x74,
-					0x74, 0x70, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x7b, 0x7b,
-					0x20, 0x65, 0x6e, 0x64, 0x20, 0x7d, 0x7d, 0x29, 0x20, 0x28, 0x7b, 0x7b,
+					0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x46, 0x75, 0x6e,
+					0x63, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x72, 0x65, 0x74, 0x75,
+					0x72, 0x6e, 0x20, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x28, 0x5f, 0x20, 0x63,
+					0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+					0x78, 0x74, 0x7b, 0x7b, 0x69, 0x66, 0x20, 0x2e, 0x45, 0x6e, 0x64, 0x70,
+					0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+					0x7d, 0x7d, 0x2c, 0x20, 0x72, 0x20, 0x2a, 0x67, 0x6f, 0x48, 0x74, 0x74,
+					0x70, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x7b, 0x7b, 0x20,
+					0x65, 0x6e, 0x64, 0x20, 0x7d, 0x7d, 0x29, 0x20, 0x28, 0x7b, 0x7b, 0x69,
+					0x66, 0x20, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e,
+					0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x7d, 0x7d, 0x72, 0x65, 0x71,
+					0x75, 0x65, 0x73, 0x74, 0x20, 0x20, 0x7b, 0x7b, 0x20, 0x72, 0x65, 0x71,
+					0x50, 0x61, 0x72, 0x61, 0x6d, 0x20, 0x3a, 0x3d, 0x20, 0x2e, 0x45, 0x6e,
+					0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x50, 0x61, 0x72, 0x61, 0x6d,
+					0x73, 0x5b, 0x31, 0x5d, 0x20, 0x7d, 0x7d, 0x20, 0x7b, 0x7b, 0x20, 0x72,
+					0x65, 0x71, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x2e, 0x54, 0x79, 0x70, 0x65,
+					0x20, 0x7d, 0x7d, 0x2c, 0x20, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d,
+					0x65, 0x72, 0x72, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x29, 0x20, 0x7b,
+					0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x09, 0x7b, 0x7b, 0x20,
 					0x69, 0x66, 0x20, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
-					0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x7d, 0x7d, 0x72, 0x65,
-					0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x20, 0x7b, 0x7b, 0x20, 0x72, 0x65,
-					0x71, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x20, 0x3a, 0x3d, 0x20, 0x2e, 0x45,
-					0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x50, 0x61, 0x72, 0x61,
-					0x6d, 0x73, 0x5b, 0x31, 0x5d, 0x20, 0x7d, 0x7d, 0x20, 0x7b, 0x7b, 0x20,
-					0x72, 0x65, 0x71, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x2e, 0x54, 0x79, 0x70,
-					0x65, 0x20, 0x7d, 0x7d, 0x2c, 0x20, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d,
-					0x7d, 0x65, 0x72, 0x72, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x29, 0x20,
-					0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x09, 0x7b, 0x7b,
-					0x20, 0x69, 0x66, 0x20, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e,
-					0x74, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x7d, 0x7d,
-					0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x09, 0x20, 0x7b, 0x7b,
-					0x20, 0x72, 0x65, 0x71, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x20, 0x3a, 0x3d,
-					0x20, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x50,
-					0x61, 0x72, 0x61, 0x6d, 0x73, 0x5b, 0x31, 0x5d, 0x20, 0x7d, 0x7d, 0x0a,
+					0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x7d, 0x7d, 0x0a,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x09, 0x20, 0x7b, 0x7b, 0x20,
+					0x72, 0x65, 0x71, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x20, 0x3a, 0x3d, 0x20,
+					0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x50, 0x61,
+					0x72, 0x61, 0x6d, 0x73, 0x5b, 0x31, 0x5d, 0x20, 0x7d, 0x7d, 0x0a, 0x20,
 					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
-					0x20, 0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x3d,
-					0x20, 0x20, 0x7b, 0x7b, 0x20, 0x72, 0x65, 0x71, 0x50, 0x61, 0x72, 0x61,
-					0x6d, 0x2e, 0x54, 0x79, 0x70, 0x65, 0x20, 0x7d, 0x7d, 0x7b, 0x7d, 0x0a,
+					0x20, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x3d, 0x20,
+					0x20, 0x7b, 0x7b, 0x20, 0x72, 0x65, 0x71, 0x50, 0x61, 0x72, 0x61, 0x6d,
+					0x2e, 0x54, 0x79, 0x70, 0x65, 0x20, 0x7d, 0x7d, 0x7b, 0x7d, 0x0a, 0x20,
 					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
-					0x20, 0x20, 0x20, 0x7b, 0x7b, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65,
-					0x20, 0x22, 0x2e, 0x2f, 0x5f, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x72,
-					0x2e, 0x6a, 0x65, 0x74, 0x22, 0x20, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f,
-					0x69, 0x6e, 0x74, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x54, 0x72, 0x61, 0x6e,
-					0x73, 0x70, 0x6f, 0x72, 0x74, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20,
-					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x7b, 0x20, 0x65, 0x6c,
-					0x73, 0x65, 0x20, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
-					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x72, 0x65, 0x74,
-					0x75, 0x72, 0x6e, 0x20, 0x65, 0x72, 0x72, 0x0a, 0x20, 0x20, 0x20, 0x20,
-					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x7b, 0x20, 0x65, 0x6e,
-					0x64, 0x20, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x0a, 0x7d,
-					0x0a, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x6d, 0x61, 0x6b, 0x65, 0x7b,
+					0x20, 0x20, 0x7b, 0x7b, 0x69, 0x6e, 0x63, 0x6c, 0x75, 0x64, 0x65, 0x20,
+					0x22, 0x2e, 0x2f, 0x5f, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x2e,
+					0x6a, 0x65, 0x74, 0x22, 0x20, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69,
+					0x6e, 0x74, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x54, 0x72, 0x61, 0x6e, 0x73,
+					0x70, 0x6f, 0x72, 0x74, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x7b, 0x20, 0x65, 0x6c, 0x73,
+					0x65, 0x20, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x72, 0x65, 0x74, 0x75,
+					0x72, 0x6e, 0x20, 0x65, 0x72, 0x72, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x7b, 0x20, 0x65, 0x6e, 0x64,
+					0x20, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d, 0x0a, 0x7d, 0x0a,
+					0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x6d, 0x61, 0x6b, 0x65, 0x7b, 0x7b,
+					0x20, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x4e,
+					0x61, 0x6d, 0x65, 0x20, 0x7d, 0x7d, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65,
+					0x72, 0x28, 0x68, 0x74, 0x74, 0x70, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+					0x73, 0x20, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x29, 0x20, 0x7b,
 					0x7b, 0x20, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e,
 					0x4e, 0x61, 0x6d, 0x65, 0x20, 0x7d, 0x7d, 0x45, 0x6e, 0x63, 0x6f, 0x64,
-					0x65, 0x72, 0x28, 0x68, 0x74, 0x74, 0x70, 0x4f, 0x70, 0x74, 0x69, 0x6f,
-					0x6e, 0x73, 0x20, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x29, 0x20,
-					0x7b, 0x7b, 0x20, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
-					0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x20, 0x7d, 0x7d, 0x45, 0x6e, 0x63, 0x6f,
-					0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x46, 0x75,
-					0x6e, 0x63, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x72, 0x65, 0x74,
-					0x75, 0x72, 0x6e, 0x20, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x28, 0x63, 0x74,
-					0x78, 0x20, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x2e, 0x43, 0x6f,
-					0x6e, 0x74, 0x65, 0x78, 0x74, 0x2c, 0x20, 0x77, 0x20, 0x67, 0x6f, 0x48,
-					0x74, 0x74, 0x70, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-					0x57, 0x72, 0x69, 0x74, 0x65, 0x72, 0x7b, 0x7b, 0x69, 0x66, 0x20, 0x2e,
-					0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x73,
-					0x70, 0x6f, 0x6e, 0x73, 0x65, 0x7d, 0x7d, 0x2c, 0x20, 0x72, 0x65, 0x73,
-					0x70, 0x6f, 0x6e, 0x73, 0x65, 0x20, 0x20, 0x7b, 0x7b, 0x20, 0x72, 0x65,
-					0x73, 0x70, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x20, 0x3a, 0x3d, 0x20, 0x2e,
-					0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x73,
-					0x75, 0x6c, 0x74, 0x73, 0x5b, 0x30, 0x5d, 0x20, 0x7d, 0x7d, 0x20, 0x7b,
-					0x7b, 0x20, 0x72, 0x65, 0x73, 0x70, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x2e,
-					0x54, 0x79, 0x70, 0x65, 0x20, 0x7d, 0x7d, 0x20, 0x7b, 0x7b, 0x20, 0x65,
-					0x6e, 0x64, 0x20, 0x7d, 0x7d, 0x29, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72,
-					0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7b,
-					0x7b, 0x69, 0x66, 0x20, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e,
-					0x74, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x7d, 0x7d,
-					0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x68, 0x74, 0x74, 0x70, 0x4f,
-					0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x7b, 0x7b, 0x20, 0x68, 0x74,
-					0x74, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x45, 0x6e,
-					0x63, 0x6f, 0x64, 0x65, 0x72, 0x28, 0x20, 0x2e, 0x45, 0x6e, 0x64, 0x70,
-					0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x54, 0x72, 0x61,
-					0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f,
-					0x6e, 0x73, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x20, 0x29, 0x20,
-					0x7d, 0x7d, 0x28, 0x63, 0x74, 0x78, 0x2c, 0x20, 0x77, 0x2c, 0x20, 0x72,
-					0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x29, 0x7b, 0x7b, 0x65, 0x6c,
-					0x73, 0x65, 0x7d, 0x7d, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x6e,
-					0x69, 0x6c, 0x7b, 0x7b, 0x20, 0x65, 0x6e, 0x64, 0x20, 0x7d, 0x7d, 0x0a,
-					0x20, 0x20, 0x20, 0x20, 0x7d, 0x0a, 0x7d, 0x0a, 0x0a, 0x66, 0x75, 0x6e,
-					0x63, 0x20, 0x28, 0x68, 0x20, 0x2a, 0x7b, 0x7b, 0x20, 0x6c, 0x6f, 0x77,
-					0x65, 0x72, 0x46, 0x69, 0x72, 0x73, 0x74, 0x28, 0x20, 0x2e, 0x45, 0x6e,
-					0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x20,
-					0x29, 0x20, 0x7d, 0x7d, 0x29, 0x20, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64,
-					0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x28, 0x29, 0x20, 0x5b, 0x5d, 0x4d,
-					0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x20, 0x7b,
-					0x0a, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x68, 0x2e, 0x6d,
-					0x65, 0x74, 0x68, 0x6f, 0x64, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x0a,
-					0x7d, 0x0a, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x28, 0x68, 0x20, 0x2a,
-					0x7b, 0x7b, 0x20, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x46, 0x69, 0x72, 0x73,
-					0x74, 0x28, 0x20, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
-					0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x20, 0x29, 0x20, 0x7d, 0x7d, 0x29, 0x20,
-					0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x28, 0x29, 0x20, 0x67, 0x6f,
-					0x48, 0x74, 0x74, 0x70, 0x2e, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72,
-					0x20, 0x7b, 0x0a, 0x09, 0x69, 0x66, 0x20, 0x68, 0x2e, 0x68, 0x61, 0x6e,
-					0x64, 0x6c, 0x65, 0x20, 0x21, 0x3d, 0x20, 0x6e, 0x69, 0x6c, 0x20, 0x7b,
-					0x0a, 0x09, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x68, 0x2e,
-					0x68, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x0a, 0x09, 0x7d, 0x0a, 0x09, 0x65,
-					0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x20, 0x3a, 0x3d, 0x20, 0x66, 0x75,
-					0x6e, 0x63, 0x28, 0x63, 0x74, 0x78, 0x20, 0x63, 0x6f, 0x6e, 0x74, 0x65,
-					0x78, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x2c, 0x20,
-					0x77, 0x20, 0x67, 0x6f, 0x48, 0x74, 0x74, 0x70, 0x2e, 0x52, 0x65, 0x73,
-					0x70, 0x6f, 0x6e, 0x73, 0x65, 0x57, 0x72, 0x69, 0x74, 0x65, 0x72, 0x2c,
-					0x20, 0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x20, 0x69, 0x6e,
-					0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x7b, 0x7d, 0x29, 0x20, 0x65,
-					0x72, 0x72, 0x6f, 0x72, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x65, 0x70, 0x52,
-					0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x20, 0x3a, 0x3d, 0x20, 0x72,
-					0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x28, 0x64, 0x65, 0x66,
-					0x69, 0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x7b, 0x7b, 0x2e,
-					0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x4e, 0x61, 0x6d,
-					0x65, 0x7d, 0x7d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x29,
-					0x0a, 0x09, 0x09, 0x69, 0x66, 0x20, 0x65, 0x70, 0x52, 0x65, 0x73, 0x70,
-					0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x20, 0x21, 0x3d, 0x20,
-					0x6e, 0x69, 0x6c, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x09, 0x68, 0x2e, 0x65,
-					0x72, 0x72, 0x6f, 0x72, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x28,
-					0x63, 0x74, 0x78, 0x2c, 0x20, 0x65, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f,
-					0x6e, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x2c, 0x20, 0x77, 0x29, 0x0a,
-					0x09, 0x09, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x6e, 0x69,
-					0x6c, 0x0a, 0x09, 0x09, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
-					0x20, 0x20, 0x7b, 0x7b, 0x20, 0x69, 0x66, 0x20, 0x2e, 0x45, 0x6e, 0x64,
-					0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-					0x73, 0x65, 0x7d, 0x7d, 0x72, 0x65, 0x73, 0x20, 0x3a, 0x3d, 0x20, 0x65,
-					0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65,
-					0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x7b, 0x7b, 0x20, 0x65, 0x6e, 0x64,
-					0x20, 0x7d, 0x7d, 0x0a, 0x09, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e,
-					0x20, 0x68, 0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x28, 0x63,
-					0x74, 0x78, 0x2c, 0x20, 0x77, 0x7b, 0x7b, 0x20, 0x69, 0x66, 0x20, 0x2e,
-					0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x73,
-					0x70, 0x6f, 0x6e, 0x73, 0x65, 0x7d, 0x7d, 0x2c, 0x20, 0x72, 0x65, 0x73,
-					0x7b, 0x7b, 0x20, 0x65, 0x6e, 0x64, 0x20, 0x7d, 0x7d, 0x29, 0x0a, 0x09,
-					0x7d, 0x0a, 0x09, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x20, 0x3a,
-					0x3d, 0x20, 0x66, 0x75, 0x6e, 0x63, 0x28, 0x63, 0x74, 0x78, 0x20, 0x63,
-					0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65,
-					0x78, 0x74, 0x2c, 0x20, 0x72, 0x20, 0x2a, 0x67, 0x6f, 0x48, 0x74, 0x74,
-					0x70, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x29, 0x20, 0x28,
-					0x72, 0x65, 0x20, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65,
-					0x7b, 0x7d, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x20, 0x65, 0x72, 0x72, 0x6f,
-					0x72, 0x29, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x46, 0x75, 0x6e,
+					0x63, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x72, 0x65, 0x74, 0x75,
+					0x72, 0x6e, 0x20, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x28, 0x63, 0x74, 0x78,
+					0x20, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x2e, 0x43, 0x6f, 0x6e,
+					0x74, 0x65, 0x78, 0x74, 0x2c, 0x20, 0x77, 0x20, 0x67, 0x6f, 0x48, 0x74,
+					0x74, 0x70, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x57,
+					0x72, 0x69, 0x74, 0x65, 0x72, 0x7b, 0x7b, 0x69, 0x66, 0x20, 0x2e, 0x45,
+					0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x70,
+					0x6f, 0x6e, 0x73, 0x65, 0x7d, 0x7d, 0x2c, 0x20, 0x72, 0x65, 0x73, 0x70,
+					0x6f, 0x6e, 0x73, 0x65, 0x20, 0x20, 0x7b, 0x7b, 0x20, 0x72, 0x65, 0x73,
+					0x70, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x20, 0x3a, 0x3d, 0x20, 0x2e, 0x45,
+					0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x75,
+					0x6c, 0x74, 0x73, 0x5b, 0x30, 0x5d, 0x20, 0x7d, 0x7d, 0x20, 0x7b, 0x7b,
+					0x20, 0x72, 0x65, 0x73, 0x70, 0x50, 0x61, 0x72, 0x61, 0x6d, 0x2e, 0x54,
+					0x79, 0x70, 0x65, 0x20, 0x7d, 0x7d, 0x20, 0x7b, 0x7b, 0x20, 0x65, 0x6e,
+					0x64, 0x20, 0x7d, 0x7d, 0x29, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x20,
+					0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x7b,
+					0x69, 0x66, 0x20, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74,
+					0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x7d, 0x7d, 0x72,
+					0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x68, 0x74, 0x74, 0x70, 0x4f, 0x70,
+					0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x7b, 0x7b, 0x20, 0x68, 0x74, 0x74,
+					0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x45, 0x6e, 0x63,
+					0x6f, 0x64, 0x65, 0x72, 0x28, 0x20, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f,
+					0x69, 0x6e, 0x74, 0x2e, 0x48, 0x74, 0x74, 0x70, 0x54, 0x72, 0x61, 0x6e,
+					0x73, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+					0x73, 0x65, 0x46, 0x6f, 0x72, 0x6d, 0x61, 0x74, 0x20, 0x29, 0x20, 0x7d,
+					0x7d, 0x28, 0x63, 0x74, 0x78, 0x2c, 0x20, 0x77, 0x2c, 0x20, 0x72, 0x65,
+					0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x29, 0x7b, 0x7b, 0x65, 0x6c, 0x73,
+					0x65, 0x7d, 0x7d, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x6e, 0x69,
+					0x6c, 0x7b, 0x7b, 0x20, 0x65, 0x6e, 0x64, 0x20, 0x7d, 0x7d, 0x0a, 0x20,
+					0x20, 0x20, 0x20, 0x7d, 0x0a, 0x7d, 0x0a, 0x0a, 0x66, 0x75, 0x6e, 0x63,
+					0x20, 0x28, 0x68, 0x20, 0x2a, 0x7b, 0x7b, 0x20, 0x6c, 0x6f, 0x77, 0x65,
+					0x72, 0x46, 0x69, 0x72, 0x73, 0x74, 0x28, 0x20, 0x2e, 0x45, 0x6e, 0x64,
+					0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x20, 0x29,
+					0x20, 0x7d, 0x7d, 0x29, 0x20, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x52,
+					0x6f, 0x75, 0x74, 0x65, 0x73, 0x28, 0x29, 0x20, 0x5b, 0x5d, 0x4d, 0x65,
+					0x74, 0x68, 0x6f, 0x64, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x20, 0x7b, 0x0a,
+					0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x68, 0x2e, 0x6d, 0x65,
+					0x74, 0x68, 0x6f, 0x64, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x0a, 0x7d,
+					0x0a, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x28, 0x68, 0x20, 0x2a, 0x7b,
+					0x7b, 0x20, 0x6c, 0x6f, 0x77, 0x65, 0x72, 0x46, 0x69, 0x72, 0x73, 0x74,
+					0x28, 0x20, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e,
+					0x4e, 0x61, 0x6d, 0x65, 0x20, 0x29, 0x20, 0x7d, 0x7d, 0x29, 0x20, 0x48,
+					0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x28, 0x29, 0x20, 0x67, 0x6f, 0x48,
+					0x74, 0x74, 0x70, 0x2e, 0x48, 0x61, 0x6e, 0x64, 0x6c, 0x65, 0x72, 0x20,
+					0x7b, 0x0a, 0x09, 0x69, 0x66, 0x20, 0x68, 0x2e, 0x68, 0x61, 0x6e, 0x64,
+					0x6c, 0x65, 0x20, 0x21, 0x3d, 0x20, 0x6e, 0x69, 0x6c, 0x20, 0x7b, 0x0a,
+					0x09, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x68, 0x2e, 0x68,
+					0x61, 0x6e, 0x64, 0x6c, 0x65, 0x0a, 0x09, 0x7d, 0x0a, 0x09, 0x65, 0x6e,
+					0x63, 0x6f, 0x64, 0x65, 0x72, 0x20, 0x3a, 0x3d, 0x20, 0x66, 0x75, 0x6e,
+					0x63, 0x28, 0x63, 0x74, 0x78, 0x20, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x78,
+					0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78, 0x74, 0x2c, 0x20, 0x77,
+					0x20, 0x67, 0x6f, 0x48, 0x74, 0x74, 0x70, 0x2e, 0x52, 0x65, 0x73, 0x70,
+					0x6f, 0x6e, 0x73, 0x65, 0x57, 0x72, 0x69, 0x74, 0x65, 0x72, 0x2c, 0x20,
+					0x72, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x20, 0x69, 0x6e, 0x74,
+					0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x7b, 0x7d, 0x29, 0x20, 0x65, 0x72,
+					0x72, 0x6f, 0x72, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x65, 0x70, 0x52, 0x65,
+					0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x20, 0x3a, 0x3d, 0x20, 0x72, 0x65,
+					0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x28, 0x64, 0x65, 0x66, 0x69,
+					0x6e, 0x69, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x7b, 0x7b, 0x2e, 0x45,
+					0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x4e, 0x61, 0x6d, 0x65,
+					0x7d, 0x7d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x29, 0x0a,
+					0x09, 0x09, 0x69, 0x66, 0x20, 0x65, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f,
+					0x6e, 0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x20, 0x21, 0x3d, 0x20, 0x6e,
+					0x69, 0x6c, 0x20, 0x7b, 0x0a, 0x09, 0x09, 0x09, 0x68, 0x2e, 0x65, 0x72,
+					0x72, 0x6f, 0x72, 0x45, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x28, 0x63,
+					0x74, 0x78, 0x2c, 0x20, 0x65, 0x70, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+					0x73, 0x65, 0x2e, 0x45, 0x72, 0x72, 0x2c, 0x20, 0x77, 0x29, 0x0a, 0x09,
+					0x09, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x6e, 0x69, 0x6c,
+					0x0a, 0x09, 0x09, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
 					0x20, 0x7b, 0x7b, 0x20, 0x69, 0x66, 0x20, 0x2e, 0x45, 0x6e, 0x64, 0x70,
-					0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-					0x20, 0x7d, 0x7d, 0x0a, 0x09, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e,
+					0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+					0x65, 0x7d, 0x7d, 0x72, 0x65, 0x73, 0x20, 0x3a, 0x3d, 0x20, 0x65, 0x70,
+					0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x2e, 0x52, 0x65, 0x73,
+					0x70, 0x6f, 0x6e, 0x73, 0x65, 0x7b, 0x7b, 0x20, 0x65, 0x6e, 0x64, 0x20,
+					0x7d, 0x7d, 0x0a, 0x09, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20,
+					0x68, 0x2e, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x28, 0x63, 0x74,
+					0x78, 0x2c, 0x20, 0x77, 0x7b, 0x7b, 0x20, 0x69, 0x66, 0x20, 0x2e, 0x45,
+					0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x73, 0x70,
+					0x6f, 0x6e, 0x73, 0x65, 0x7d, 0x7d, 0x2c, 0x20, 0x72, 0x65, 0x73, 0x7b,
+					0x7b, 0x20, 0x65, 0x6e, 0x64, 0x20, 0x7d, 0x7d, 0x29, 0x0a, 0x09, 0x7d,
+					0x0a, 0x09, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x20, 0x3a, 0x3d,
+					0x20, 0x66, 0x75, 0x6e, 0x63, 0x28, 0x63, 0x74, 0x78, 0x20, 0x63, 0x6f,
+					0x6e, 0x74, 0x65, 0x78, 0x74, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x78,
+					0x74, 0x2c, 0x20, 0x72, 0x20, 0x2a, 0x67, 0x6f, 0x48, 0x74, 0x74, 0x70,
+					0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x29, 0x20, 0x28, 0x72,
+					0x65, 0x20, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x7b,
+					0x7d, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72,
+					0x29, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20,
+					0x7b, 0x7b, 0x20, 0x69, 0x66, 0x20, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f,
+					0x69, 0x6e, 0x74, 0x2e, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69,
+					0x6f, 0x6e, 0x73, 0x20, 0x7d, 0x7d, 0x0a, 0x09, 0x09, 0x72, 0x65, 0x71,
+					0x75, 0x65, 0x73, 0x74, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x20, 0x3a, 0x3d,
 					0x20, 0x68, 0x2e, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x28, 0x63,
-					0x74, 0x78, 0x2c, 0x20, 0x72, 0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20,
-					0x20, 0x20, 0x20, 0x7b, 0x7b, 0x65, 0x6c, 0x73, 0x65, 0x7d, 0x7d, 0x0a,
-					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x72, 0x65, 0x74, 0x75,
-					0x72, 0x6e, 0x20, 0x6e, 0x69, 0x6c, 0x2c, 0x20, 0x68, 0x2e, 0x64, 0x65,
-					0x63, 0x6f, 0x64, 0x65, 0x72, 0x28, 0x63, 0x74, 0x78, 0x29, 0x0a, 0x20,
-					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x7b, 0x65, 0x6e, 0x64,
-					0x7d, 0x7d, 0x0a, 0x09, 0x7d, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72,
-					0x6e, 0x20, 0x67, 0x6f, 0x4b, 0x69, 0x74, 0x48, 0x74, 0x74, 0x70, 0x2e,
-					0x4e, 0x65, 0x77, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72, 0x28, 0x0a, 0x09,
-					0x09, 0x68, 0x2e, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2c,
-					0x0a, 0x09, 0x09, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x2c, 0x0a,
-					0x09, 0x09, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x2c, 0x0a, 0x09,
-					0x09, 0x68, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72, 0x4f, 0x70, 0x74,
-					0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x2e, 0x2e, 0x2c, 0x0a, 0x09, 0x29, 0x0a,
-					0x7d,
+					0x74, 0x78, 0x2c, 0x20, 0x72, 0x29, 0x0a, 0x09, 0x09, 0x69, 0x66, 0x20,
+					0x65, 0x72, 0x72, 0x20, 0x21, 0x3d, 0x20, 0x6e, 0x69, 0x6c, 0x20, 0x7b,
+					0x0a, 0x09, 0x09, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x72,
+					0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x0a,
+					0x09, 0x09, 0x7d, 0x0a, 0x09, 0x09, 0x69, 0x66, 0x20, 0x66, 0x69, 0x65,
+					0x6c, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x20, 0x3a, 0x3d, 0x20,
+					0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x7b,
+					0x7b, 0x20, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e,
+					0x4e, 0x61, 0x6d, 0x65, 0x20, 0x7d, 0x7d, 0x52, 0x65, 0x71, 0x75, 0x65,
+					0x73, 0x74, 0x28, 0x26, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x29,
+					0x3b, 0x20, 0x6c, 0x65, 0x6e, 0x28, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x45,
+					0x72, 0x72, 0x6f, 0x72, 0x73, 0x29, 0x20, 0x3e, 0x20, 0x30, 0x20, 0x7b,
+					0x0a, 0x09, 0x09, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x72,
+					0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x6f,
+					0x72, 0x73, 0x2e, 0x48, 0x54, 0x54, 0x50, 0x42, 0x61, 0x64, 0x52, 0x65,
+					0x71, 0x75, 0x65, 0x73, 0x74, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x28,
+					0x66, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x29,
+					0x0a, 0x09, 0x09, 0x7d, 0x0a, 0x09, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72,
+					0x6e, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x2c, 0x20, 0x6e,
+					0x69, 0x6c, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7b,
+					0x7b, 0x20, 0x65, 0x6c, 0x73, 0x65, 0x20, 0x69, 0x66, 0x20, 0x2e, 0x45,
+					0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x71, 0x75,
+					0x65, 0x73, 0x74, 0x20, 0x7d, 0x7d, 0x0a, 0x09, 0x09, 0x72, 0x65, 0x74,
+					0x75, 0x72, 0x6e, 0x20, 0x68, 0x2e, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65,
+					0x72, 0x28, 0x63, 0x74, 0x78, 0x2c, 0x20, 0x72, 0x29, 0x0a, 0x20, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x7b, 0x65, 0x6c, 0x73, 0x65,
+					0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x72,
+					0x65, 0x74, 0x75, 0x72, 0x6e, 0x20, 0x6e, 0x69, 0x6c, 0x2c, 0x20, 0x68,
+					0x2e, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65, 0x72, 0x28, 0x63, 0x74, 0x78,
+					0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x7b,
+					0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x0a, 0x09, 0x7d, 0x0a, 0x09, 0x72, 0x65,
+					0x74, 0x75, 0x72, 0x6e, 0x20, 0x67, 0x6f, 0x4b, 0x69, 0x74, 0x48, 0x74,
+					0x74, 0x70, 0x2e, 0x4e, 0x65, 0x77, 0x53, 0x65, 0x72, 0x76, 0x65, 0x72,
+					0x28, 0x0a, 0x09, 0x09, 0x68, 0x2e, 0x65, 0x6e, 0x64, 0x70, 0x6f, 0x69,
+					0x6e, 0x74, 0x2c, 0x0a, 0x09, 0x09, 0x64, 0x65, 0x63, 0x6f, 0x64, 0x65,
+					0x72, 0x2c, 0x0a, 0x09, 0x09, 0x65, 0x6e, 0x63, 0x6f, 0x64, 0x65, 0x72,
+					0x2c, 0x0a, 0x09, 0x09, 0x68, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x65, 0x72,
+					0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x2e, 0x2e, 0x2e, 0x2c, 0x0a,
+					0x09, 0x29, 0x0a, 0x7d,
 				},
 				fi: FileInfo{
 					name:    "method.jet",
-					size:    4585,
-					modTime: time.Unix(0, 1587861909031645346),
+					size:    5164,
+					modTime: time.Unix(0, 1792310755478599637),
 					isDir:   false,
 				},
 			}, "/assets/service/gen/transport/http/options.jet": {
@@ -3643,10 +4376,12 @@ func init() {
 					0x20, 0x44, 0x4f, 0x20, 0x4e, 0x4f, 0x54, 0x20, 0x45, 0x44, 0x49, 0x54,
 					0x0a, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x20, 0x74, 0x72, 0x61,
 					0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x0a, 0x0a, 0x69, 0x6d, 0x70, 0x6f,
-					0x72, 0x74, 0x20, 0x28, 0x0a, 0x09, 0x22, 0x7b, 0x7b, 0x20, 0x2e, 0x49,
-					0x6d, 0x70, 0x6f, 0x72, 0x74, 0x20, 0x7d, 0x7d, 0x2f, 0x67, 0x65, 0x6e,
-					0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x2f, 0x68,
-					0x74, 0x74, 0x70, 0x22, 0x7b, 0x7b, 0x69, 0x66, 0x20, 0x2e, 0x47, 0x52,
+					0x72, 0x74, 0x20, 0x28, 0x7b, 0x7b, 0x69, 0x66, 0x20, 0x2e, 0x48, 0x61,
+					0x73, 0x48, 0x74, 0x74, 0x70, 0x28, 0x29, 0x7d, 0x7d, 0x0a, 0x09, 0x22,
+					0x7b, 0x7b, 0x20, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x20, 0x7d,
+					0x7d, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x70,
+					0x6f, 0x72, 0x74, 0x2f, 0x68, 0x74, 0x74, 0x70, 0x22, 0x7b, 0x7b, 0x65,
+					0x6e, 0x64, 0x7d, 0x7d, 0x7b, 0x7b, 0x69, 0x66, 0x20, 0x2e, 0x47, 0x52,
 					0x50, 0x43, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x7d,
 					0x7d, 0x0a, 0x09, 0x22, 0x7b, 0x7b, 0x20, 0x2e, 0x49, 0x6d, 0x70, 0x6f,
 					0x72, 0x74, 0x20, 0x7d, 0x7d, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x74, 0x72,
@@ -3654,19 +4389,21 @@ func init() {
 					0x22, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x0a, 0x29, 0x0a, 0x0a,
 					0x74, 0x79, 0x70, 0x65, 0x20, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f,
 					0x72, 0x74, 0x73, 0x20, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63,
-					0x65, 0x20, 0x7b, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x48, 0x54, 0x54, 0x50,
-					0x28, 0x29, 0x20, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x54, 0x72, 0x61, 0x6e,
-					0x73, 0x70, 0x6f, 0x72, 0x74, 0x7b, 0x7b, 0x69, 0x66, 0x20, 0x2e, 0x47,
-					0x52, 0x50, 0x43, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74,
-					0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x47, 0x52, 0x50, 0x43, 0x28,
-					0x29, 0x20, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73,
-					0x70, 0x6f, 0x72, 0x74, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x7b, 0x65,
-					0x6e, 0x64, 0x7d, 0x7d, 0x0a, 0x7d, 0x0a,
+					0x65, 0x20, 0x7b, 0x20, 0x7b, 0x7b, 0x69, 0x66, 0x20, 0x2e, 0x48, 0x61,
+					0x73, 0x48, 0x74, 0x74, 0x70, 0x28, 0x29, 0x7d, 0x7d, 0x0a, 0x20, 0x20,
+					0x20, 0x20, 0x48, 0x54, 0x54, 0x50, 0x28, 0x29, 0x20, 0x68, 0x74, 0x74,
+					0x70, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72, 0x74, 0x7b,
+					0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x7b, 0x7b, 0x69, 0x66, 0x20, 0x2e,
+					0x47, 0x52, 0x50, 0x43, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x70, 0x6f, 0x72,
+					0x74, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x47, 0x52, 0x50, 0x43,
+					0x28, 0x29, 0x20, 0x67, 0x72, 0x70, 0x63, 0x2e, 0x54, 0x72, 0x61, 0x6e,
+					0x73, 0x70, 0x6f, 0x72, 0x74, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x7b,
+					0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x0a, 0x7d, 0x0a,
 				},
 				fi: FileInfo{
 					name:    "transport.jet",
-					size:    283,
-					modTime: time.Unix(0, 1587422010467589249),
+					size:    332,
+					modTime: time.Unix(0, 1792308422967130591),
 					isDir:   false,
 				},
 			}, "/assets/service/gen/utils": {
@@ -3681,6 +4418,7 @@ func init() {
 				data: []byte{
 					0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x20, 0x75, 0x74, 0x69, 0x6c,
 					0x73, 0x0a, 0x0a, 0x69, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x20, 0x28, 0x0a,
+					0x09, 0x22, 0x6e, 0x65, 0x74, 0x2f, 0x68, 0x74, 0x74, 0x70, 0x22, 0x0a,
 					0x09, 0x22, 0x73, 0x74, 0x72, 0x63, 0x6f, 0x6e, 0x76, 0x22, 0x0a, 0x09,
 					0x22, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x73, 0x22, 0x0a, 0x29, 0x0a,
 					0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x53, 0x74, 0x72, 0x69, 0x6e, 0x67,
@@ -3894,12 +4632,173 @@ func init() {
 					0x72, 0x65, 0x74, 0x20, 0x3d, 0x20, 0x61, 0x70, 0x70, 0x65, 0x6e, 0x64,
 					0x28, 0x72, 0x65, 0x74, 0x2c, 0x20, 0x69, 0x6e, 0x74, 0x36, 0x34, 0x28,
 					0x76, 0x29, 0x29, 0x0a, 0x09, 0x7d, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x75,
-					0x72, 0x6e, 0x0a, 0x7d,
+					0x72, 0x6e, 0x0a, 0x7d, 0x0a, 0x0a, 0x2f, 0x2f, 0x20, 0x43, 0x6f, 0x6f,
+					0x6b, 0x69, 0x65, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20,
+					0x74, 0x68, 0x65, 0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x20, 0x6f, 0x66,
+					0x20, 0x74, 0x68, 0x65, 0x20, 0x63, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x20,
+					0x6f, 0x72, 0x20, 0x61, 0x6e, 0x20, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x20,
+					0x73, 0x74, 0x72, 0x69, 0x6e, 0x67, 0x20, 0x69, 0x66, 0x20, 0x74, 0x68,
+					0x65, 0x20, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x64, 0x6f,
+					0x65, 0x73, 0x20, 0x6e, 0x6f, 0x74, 0x20, 0x68, 0x61, 0x76, 0x65, 0x20,
+					0x74, 0x68, 0x65, 0x20, 0x63, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x2e, 0x0a,
+					0x66, 0x75, 0x6e, 0x63, 0x20, 0x43, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x28,
+					0x72, 0x20, 0x2a, 0x68, 0x74, 0x74, 0x70, 0x2e, 0x52, 0x65, 0x71, 0x75,
+					0x65, 0x73, 0x74, 0x2c, 0x20, 0x6e, 0x61, 0x6d, 0x65, 0x20, 0x73, 0x74,
+					0x72, 0x69, 0x6e, 0x67, 0x29, 0x20, 0x73, 0x74, 0x72, 0x69, 0x6e, 0x67,
+					0x20, 0x7b, 0x0a, 0x09, 0x63, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x2c, 0x20,
+					0x65, 0x72, 0x72, 0x20, 0x3a, 0x3d, 0x20, 0x72, 0x2e, 0x43, 0x6f, 0x6f,
+					0x6b, 0x69, 0x65, 0x28, 0x6e, 0x61, 0x6d, 0x65, 0x29, 0x0a, 0x09, 0x69,
+					0x66, 0x20, 0x65, 0x72, 0x72, 0x20, 0x21, 0x3d, 0x20, 0x6e, 0x69, 0x6c,
+					0x20, 0x7b, 0x0a, 0x09, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x20,
+					0x22, 0x22, 0x0a, 0x09, 0x7d, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72,
+					0x6e, 0x20, 0x63, 0x6f, 0x6f, 0x6b, 0x69, 0x65, 0x2e, 0x56, 0x61, 0x6c,
+					0x75, 0x65, 0x0a, 0x7d, 0x0a,
 				},
 				fi: FileInfo{
 					name:    "utils.jet",
-					size:    2584,
-					modTime: time.Unix(0, 1587417128408993369),
+					size:    2837,
+					modTime: time.Unix(0, 1792309887837843030),
+					isDir:   false,
+				},
+			}, "/assets/service/gen/validation": {
+				data: []byte{},
+				fi: FileInfo{
+					name:    "validation",
+					size:    96,
+					modTime: time.Unix(0, 1792310169644816134),
+					isDir:   true,
+				},
+			}, "/assets/service/gen/validation/method.jet": {
+				data: []byte{
+					0x2f, 0x2f, 0x20, 0x43, 0x6f, 0x64, 0x65, 0x20, 0x67, 0x65, 0x6e, 0x65,
+					0x72, 0x61, 0x74, 0x65, 0x64, 0x20, 0x62, 0x79, 0x20, 0x67, 0x73, 0x2e,
+					0x20, 0x44, 0x4f, 0x20, 0x4e, 0x4f, 0x54, 0x20, 0x45, 0x44, 0x49, 0x54,
+					0x0a, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x20, 0x76, 0x61, 0x6c,
+					0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x0a, 0x0a, 0x69, 0x6d, 0x70,
+					0x6f, 0x72, 0x74, 0x20, 0x28, 0x0a, 0x09, 0x73, 0x65, 0x72, 0x76, 0x69,
+					0x63, 0x65, 0x20, 0x22, 0x7b, 0x7b, 0x20, 0x2e, 0x53, 0x65, 0x72, 0x76,
+					0x69, 0x63, 0x65, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x20, 0x7d,
+					0x7d, 0x22, 0x0a, 0x09, 0x22, 0x7b, 0x7b, 0x20, 0x2e, 0x53, 0x65, 0x72,
+					0x76, 0x69, 0x63, 0x65, 0x2e, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x20,
+					0x7d, 0x7d, 0x2f, 0x67, 0x65, 0x6e, 0x2f, 0x65, 0x72, 0x72, 0x6f, 0x72,
+					0x73, 0x22, 0x0a, 0x09, 0x22, 0x75, 0x6e, 0x69, 0x63, 0x6f, 0x64, 0x65,
+					0x2f, 0x75, 0x74, 0x66, 0x38, 0x22, 0x0a, 0x0a, 0x09, 0x22, 0x67, 0x69,
+					0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x61, 0x73, 0x61,
+					0x73, 0x6b, 0x65, 0x76, 0x69, 0x63, 0x68, 0x2f, 0x67, 0x6f, 0x76, 0x61,
+					0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x22, 0x0a, 0x7b, 0x7b, 0x69,
+					0x66, 0x20, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e,
+					0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x6d, 0x70, 0x6f, 0x72,
+					0x74, 0x20, 0x26, 0x26, 0x20, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69,
+					0x6e, 0x74, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x49, 0x6d,
+					0x70, 0x6f, 0x72, 0x74, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x20, 0x21, 0x3d,
+					0x20, 0x2e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x2e, 0x49, 0x6d,
+					0x70, 0x6f, 0x72, 0x74, 0x7d, 0x7d, 0x7b, 0x7b, 0x2e, 0x45, 0x6e, 0x64,
+					0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+					0x74, 0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x41, 0x6c, 0x69, 0x61,
+					0x73, 0x7d, 0x7d, 0x20, 0x22, 0x7b, 0x7b, 0x2e, 0x45, 0x6e, 0x64, 0x70,
+					0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+					0x49, 0x6d, 0x70, 0x6f, 0x72, 0x74, 0x2e, 0x50, 0x61, 0x74, 0x68, 0x7d,
+					0x7d, 0x22, 0x20, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x0a, 0x29,
+					0x0a, 0x0a, 0x2f, 0x2f, 0x20, 0x7b, 0x7b, 0x20, 0x2e, 0x45, 0x6e, 0x64,
+					0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x20, 0x7d,
+					0x7d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x63, 0x68, 0x65,
+					0x63, 0x6b, 0x73, 0x20, 0x74, 0x68, 0x65, 0x20, 0x76, 0x61, 0x6c, 0x69,
+					0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x72, 0x75, 0x6c, 0x65, 0x73,
+					0x20, 0x6f, 0x66, 0x20, 0x74, 0x68, 0x65, 0x20, 0x72, 0x65, 0x71, 0x75,
+					0x65, 0x73, 0x74, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x20, 0x61,
+					0x6e, 0x64, 0x20, 0x72, 0x65, 0x74, 0x75, 0x72, 0x6e, 0x73, 0x20, 0x74,
+					0x68, 0x65, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x20, 0x6f, 0x66,
+					0x20, 0x61, 0x6c, 0x6c, 0x20, 0x74, 0x68, 0x65, 0x20, 0x69, 0x6e, 0x76,
+					0x61, 0x6c, 0x69, 0x64, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x73, 0x2c,
+					0x0a, 0x2f, 0x2f, 0x20, 0x6f, 0x6e, 0x6c, 0x79, 0x20, 0x74, 0x68, 0x65,
+					0x20, 0x66, 0x69, 0x72, 0x73, 0x74, 0x20, 0x72, 0x75, 0x6c, 0x65, 0x20,
+					0x74, 0x68, 0x61, 0x74, 0x20, 0x66, 0x61, 0x69, 0x6c, 0x73, 0x20, 0x69,
+					0x73, 0x20, 0x72, 0x65, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x20, 0x66,
+					0x6f, 0x72, 0x20, 0x65, 0x61, 0x63, 0x68, 0x20, 0x66, 0x69, 0x65, 0x6c,
+					0x64, 0x2e, 0x0a, 0x66, 0x75, 0x6e, 0x63, 0x20, 0x7b, 0x7b, 0x20, 0x2e,
+					0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x4e, 0x61, 0x6d,
+					0x65, 0x20, 0x7d, 0x7d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x28,
+					0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x20, 0x2a, 0x7b, 0x7b, 0x20,
+					0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e, 0x50, 0x61,
+					0x72, 0x61, 0x6d, 0x73, 0x5b, 0x31, 0x5d, 0x2e, 0x54, 0x79, 0x70, 0x65,
+					0x20, 0x7d, 0x7d, 0x29, 0x20, 0x28, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x45,
+					0x72, 0x72, 0x6f, 0x72, 0x73, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73,
+					0x2e, 0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x73,
+					0x29, 0x20, 0x7b, 0x0a, 0x7b, 0x7b, 0x72, 0x61, 0x6e, 0x67, 0x65, 0x20,
+					0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x20, 0x3a,
+					0x3d, 0x20, 0x2e, 0x45, 0x6e, 0x64, 0x70, 0x6f, 0x69, 0x6e, 0x74, 0x2e,
+					0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x20,
+					0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x7b, 0x69, 0x66, 0x20,
+					0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x47,
+					0x75, 0x61, 0x72, 0x64, 0x7d, 0x7d, 0x69, 0x66, 0x20, 0x7b, 0x7b, 0x76,
+					0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x47, 0x75,
+					0x61, 0x72, 0x64, 0x7d, 0x7d, 0x20, 0x7b, 0x20, 0x7b, 0x7b, 0x65, 0x6e,
+					0x64, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7b, 0x7b, 0x72, 0x61,
+					0x6e, 0x67, 0x65, 0x20, 0x69, 0x6e, 0x78, 0x2c, 0x20, 0x72, 0x75, 0x6c,
+					0x65, 0x20, 0x3a, 0x3d, 0x20, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+					0x69, 0x6f, 0x6e, 0x2e, 0x52, 0x75, 0x6c, 0x65, 0x73, 0x20, 0x7d, 0x7d,
+					0x7b, 0x7b, 0x69, 0x66, 0x20, 0x69, 0x6e, 0x78, 0x20, 0x3e, 0x20, 0x30,
+					0x7d, 0x7d, 0x20, 0x65, 0x6c, 0x73, 0x65, 0x20, 0x7b, 0x7b, 0x65, 0x6e,
+					0x64, 0x7d, 0x7d, 0x69, 0x66, 0x20, 0x7b, 0x7b, 0x72, 0x75, 0x6c, 0x65,
+					0x2e, 0x43, 0x68, 0x65, 0x63, 0x6b, 0x7d, 0x7d, 0x20, 0x7b, 0x0a, 0x20,
+					0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64,
+					0x45, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x20, 0x3d, 0x20, 0x61, 0x70, 0x70,
+					0x65, 0x6e, 0x64, 0x28, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x72, 0x72,
+					0x6f, 0x72, 0x73, 0x2c, 0x20, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x73, 0x2e,
+					0x46, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72, 0x7b, 0x4e,
+					0x61, 0x6d, 0x65, 0x3a, 0x20, 0x22, 0x7b, 0x7b, 0x76, 0x61, 0x6c, 0x69,
+					0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x2e, 0x4e, 0x61, 0x6d, 0x65, 0x7d,
+					0x7d, 0x22, 0x2c, 0x20, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+					0x3a, 0x20, 0x22, 0x7b, 0x7b, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+					0x69, 0x6f, 0x6e, 0x2e, 0x4c, 0x6f, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+					0x7d, 0x7d, 0x22, 0x2c, 0x20, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x3a,
+					0x20, 0x7b, 0x7b, 0x72, 0x75, 0x6c, 0x65, 0x2e, 0x52, 0x65, 0x61, 0x73,
+					0x6f, 0x6e, 0x7d, 0x7d, 0x7d, 0x29, 0x0a, 0x20, 0x20, 0x20, 0x20, 0x7d,
+					0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x0a, 0x20, 0x20, 0x20, 0x20,
+					0x7b, 0x7b, 0x69, 0x66, 0x20, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74,
+					0x69, 0x6f, 0x6e, 0x2e, 0x47, 0x75, 0x61, 0x72, 0x64, 0x7d, 0x7d, 0x20,
+					0x7d, 0x20, 0x7b, 0x7b, 0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x0a, 0x7b, 0x7b,
+					0x65, 0x6e, 0x64, 0x7d, 0x7d, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x75, 0x72,
+					0x6e, 0x20, 0x66, 0x69, 0x65, 0x6c, 0x64, 0x45, 0x72, 0x72, 0x6f, 0x72,
+					0x73, 0x0a, 0x7d, 0x0a,
+				},
+				fi: FileInfo{
+					name:    "method.jet",
+					size:    1084,
+					modTime: time.Unix(0, 1792310188847085734),
+					isDir:   false,
+				},
+			}, "/assets/service/gen/version": {
+				data: []byte{},
+				fi: FileInfo{
+					name:    "version",
+					size:    96,
+					modTime: time.Unix(0, 1792307751782979748),
+					isDir:   true,
+				},
+			}, "/assets/service/gen/version/version.jet": {
+				data: []byte{
+					0x2f, 0x2f, 0x20, 0x43, 0x6f, 0x64, 0x65, 0x20, 0x67, 0x65, 0x6e, 0x65,
+					0x72, 0x61, 0x74, 0x65, 0x64, 0x20, 0x62, 0x79, 0x20, 0x67, 0x73, 0x2e,
+					0x20, 0x44, 0x4f, 0x20, 0x4e, 0x4f, 0x54, 0x20, 0x45, 0x44, 0x49, 0x54,
+					0x0a, 0x70, 0x61, 0x63, 0x6b, 0x61, 0x67, 0x65, 0x20, 0x76, 0x65, 0x72,
+					0x73, 0x69, 0x6f, 0x6e, 0x0a, 0x0a, 0x2f, 0x2f, 0x20, 0x74, 0x68, 0x65,
+					0x20, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x73, 0x20, 0x61, 0x72, 0x65, 0x20,
+					0x73, 0x65, 0x74, 0x20, 0x77, 0x69, 0x74, 0x68, 0x20, 0x2d, 0x6c, 0x64,
+					0x66, 0x6c, 0x61, 0x67, 0x73, 0x20, 0x77, 0x68, 0x65, 0x6e, 0x20, 0x74,
+					0x68, 0x65, 0x20, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x20, 0x69,
+					0x73, 0x20, 0x62, 0x75, 0x69, 0x6c, 0x74, 0x20, 0x77, 0x69, 0x74, 0x68,
+					0x20, 0x60, 0x67, 0x73, 0x20, 0x62, 0x75, 0x69, 0x6c, 0x64, 0x60, 0x0a,
+					0x76, 0x61, 0x72, 0x20, 0x28, 0x0a, 0x09, 0x56, 0x65, 0x72, 0x73, 0x69,
+					0x6f, 0x6e, 0x20, 0x20, 0x20, 0x3d, 0x20, 0x22, 0x64, 0x65, 0x76, 0x22,
+					0x0a, 0x09, 0x43, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x20, 0x20, 0x20, 0x20,
+					0x3d, 0x20, 0x22, 0x22, 0x0a, 0x09, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x54,
+					0x69, 0x6d, 0x65, 0x20, 0x3d, 0x20, 0x22, 0x22, 0x0a, 0x29, 0x0a,
+				},
+				fi: FileInfo{
+					name:    "version.jet",
+					size:    191,
+					modTime: time.Unix(0, 1792307751604463539),
 					isDir:   false,
 				},
 			}, "/assets/service/service.jet": {
@@ -0,0 +1,80 @@
+package template
+
+import (
+	"errors"
+	"fmt"
+	"go/scanner"
+	"io/ioutil"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// the number of lines shown around the line that broke
+const errorContext = 2
+
+var errorLineRegex = regexp.MustCompile(`:(\d+):(\d+:)?\s`)
+
+// SourceError is returned when a template renders go code that can not be formatted.
+type SourceError struct {
+	// Template is the path of the template that rendered the source.
+	Template string
+	// Line is the line of the rendered source that broke, 0 if it is not known.
+	Line int
+	// Source is the raw unformatted source.
+	Source string
+	// Dump is the file the raw source was written to.
+	Dump string
+	Err  error
+}
+
+func newSourceError(tplPath, src string, err error) *SourceError {
+	e := &SourceError{
+		Template: tplPath,
+		Source:   src,
+		Err:      err,
+	}
+	var list scanner.ErrorList
+	if errors.As(err, &list) && len(list) > 0 {
+		e.Line = list[0].Pos.Line
+	} else if match := errorLineRegex.FindStringSubmatch(err.Error()); match != nil {
+		e.Line, _ = strconv.Atoi(match[1])
+	}
+	if f, err := ioutil.TempFile("", "gs-*.go.txt"); err == nil {
+		if _, err := f.WriteString(src); err == nil {
+			e.Dump = f.Name()
+		}
+		_ = f.Close()
+	}
+	return e
+}
+
+func (e *SourceError) Error() string {
+	s := fmt.Sprintf("template `%s` rendered invalid go code", e.Template)
+	if e.Line > 0 {
+		s += fmt.Sprintf(" at line %d", e.Line)
+	}
+	s += fmt.Sprintf(": %s", e.Err)
+	lines := strings.Split(e.Source, "\n")
+	if e.Line > 0 && e.Line <= len(lines) {
+		s += "\n"
+		for i := e.Line - errorContext; i <= e.Line+errorContext; i++ {
+			if i < 1 || i > len(lines) {
+				continue
+			}
+			marker := " "
+			if i == e.Line {
+				marker = ">"
+			}
+			s += fmt.Sprintf("%s %4d | %s\n", marker, i, lines[i-1])
+		}
+	}
+	if e.Dump != "" {
+		s += fmt.Sprintf("the raw output was written to %s", e.Dump)
+	}
+	return strings.TrimSuffix(s, "\n")
+}
+
+func (e *SourceError) Unwrap() error {
+	return e.Err
+}
@@ -0,0 +1,41 @@
+package template
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSourceError(t *testing.T) {
+	src := "package gen\n\nfunc a() {}\n\nfunc b( {\n}\n\nfunc c() {}\n"
+	_, err := FormatGo("gen.go", src)
+	if err == nil {
+		t.Fatal("the source should not be valid")
+	}
+	e := newSourceError("service/gen/gen.jet", src, err)
+	defer os.Remove(e.Dump)
+
+	assert.Equal(t, 5, e.Line)
+	assert.Contains(t, e.Error(), "template `service/gen/gen.jet` rendered invalid go code at line 5: ")
+	assert.Contains(t, e.Error(), "\n     3 | func a() {}\n     4 | \n>    5 | func b( {\n     6 | }\n     7 | \n")
+	assert.Contains(t, e.Error(), "the raw output was written to "+e.Dump)
+
+	dump, err := ioutil.ReadFile(e.Dump)
+	assert.NoError(t, err)
+	assert.Equal(t, src, string(dump))
+}
+
+func TestSourceErrorLineFromMessage(t *testing.T) {
+	e := newSourceError("a.jet", "package a\n", errors.New("a.go:1:9: expected ';'"))
+	defer os.Remove(e.Dump)
+	assert.Equal(t, 1, e.Line)
+	assert.Contains(t, e.Error(), ">    1 | package a")
+
+	e = newSourceError("a.jet", "package a\n", errors.New("invalid"))
+	defer os.Remove(e.Dump)
+	assert.Equal(t, 0, e.Line)
+	assert.NotContains(t, e.Error(), "|")
+}
@@ -45,6 +45,21 @@ func ToLowerFirst(text string) string {
 	return text
 }
 
+// ToUpperFirst returns the text with the first letter in upper case, unlike the
+// deprecated strings.Title it does not change the rest of the text.
+func ToUpperFirst(text string) string {
+	if len(text) > 0 {
+		r, size := utf8.DecodeRuneInString(text)
+		if r != utf8.RuneError || size > 1 {
+			up := unicode.ToUpper(r)
+			if up != r {
+				text = string(up) + text[size:]
+			}
+		}
+	}
+	return text
+}
+
 func toTitle(text string) string {
 	return strings.Title(strutil.ToCamelCase(text))
 }
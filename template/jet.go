@@ -1,7 +1,10 @@
 package template
 
 import (
+	"gs/fs"
 	"io"
+	"io/ioutil"
+	"strings"
 
 	"github.com/CloudyKit/jet"
 )
@@ -12,10 +15,21 @@ type Loader struct {
 }
 
 func (l Loader) Open(name string) (io.ReadCloser, error) {
+	if pth, ok := overridePath(name); ok {
+		log.Debugf("Using project template %s", pth)
+		data, err := fs.ReadFile(pth)
+		if err != nil {
+			return nil, err
+		}
+		return ioutil.NopCloser(strings.NewReader(data)), nil
+	}
 	return FS.Open("/assets/" + name)
 }
 
 func (l Loader) Exists(name string) (string, bool) {
+	if _, ok := overridePath(name); ok {
+		return name, true
+	}
 	_, err := FS.Open("/assets/" + name)
 	if err != nil {
 		return "", false
@@ -32,6 +46,8 @@ func getSet() *jet.Set {
 		return set
 	}
 	set = jet.NewSetLoader(nopEscape, &Loader{})
+	// project templates can change while watching so they should not be cached
+	set.SetDevelopmentMode(overrides != "")
 	for name, fn := range CustomFunctions {
 		set.AddGlobal(name, fn)
 	}
@@ -0,0 +1,112 @@
+package template
+
+import (
+	"fmt"
+	"gs/fs"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/pmezard/go-difflib/difflib"
+)
+
+// overrides is the project folder that is searched for templates before the embedded assets.
+var overrides string
+
+// SetOverrides sets the project folder with the template overrides,
+// an empty folder means only the embedded templates are used.
+func SetOverrides(dir string) {
+	if dir == overrides {
+		return
+	}
+	overrides = dir
+	// the set caches the templates so it needs to be created again
+	set = nil
+}
+
+// Overrides returns the project folder with the template overrides.
+func Overrides() string {
+	return overrides
+}
+
+// overridePath returns the path of the project template if it exists.
+func overridePath(name string) (string, bool) {
+	if overrides == "" {
+		return "", false
+	}
+	pth := path.Join(overrides, name)
+	if b, _ := fs.Exists(pth); !b {
+		return "", false
+	}
+	return pth, true
+}
+
+// Assets returns the names of all the embedded templates.
+func Assets() (names []string) {
+	for name, file := range FS.files {
+		if file.fi.isDir || !strings.HasPrefix(name, "/assets/") {
+			continue
+		}
+		names = append(names, strings.TrimPrefix(name, "/assets/"))
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Eject copies the embedded templates that match the name to the project folder so they can be customized,
+// the name can be a template or a folder of templates.
+func Eject(dir, name string) (ejected []string, err error) {
+	name = strings.Trim(path.Clean(name), "/")
+	for _, asset := range Assets() {
+		if asset != name && !strings.HasPrefix(asset, name+"/") && name != "." {
+			continue
+		}
+		pth := path.Join(dir, asset)
+		if b, _ := fs.Exists(pth); b {
+			return ejected, fmt.Errorf("template `%s` is already ejected", pth)
+		}
+		data, _ := FS.String("/assets/" + asset)
+		if err := fs.WriteFile(pth, data); err != nil {
+			return ejected, err
+		}
+		ejected = append(ejected, pth)
+	}
+	if len(ejected) == 0 {
+		return nil, fmt.Errorf("template `%s` does not exist", name)
+	}
+	return ejected, nil
+}
+
+// Diff returns a unified diff of the project templates against the embedded templates,
+// templates that do not exist upstream are diffed against an empty file.
+func Diff(dir string) (string, error) {
+	files, err := fs.ListFiles(dir)
+	if err != nil {
+		return "", err
+	}
+	sort.Strings(files)
+	diff := ""
+	for _, pth := range files {
+		name := strings.TrimPrefix(path.Clean(pth), path.Clean(dir)+"/")
+		upstream, _ := FS.String("/assets/" + name)
+		current, err := fs.ReadFile(pth)
+		if err != nil {
+			return "", err
+		}
+		if current == upstream {
+			continue
+		}
+		text, err := difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+			A:        difflib.SplitLines(upstream),
+			B:        difflib.SplitLines(current),
+			FromFile: "a/" + name,
+			ToFile:   "b/" + name,
+			Context:  3,
+		})
+		if err != nil {
+			return "", err
+		}
+		diff += text
+	}
+	return diff, nil
+}
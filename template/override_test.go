@@ -0,0 +1,72 @@
+package template
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOverrides(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gs-templates")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	if err := os.MkdirAll(filepath.Join(dir, "project"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "project", "gitignore"), []byte("{{ .Rule }}\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	defer SetOverrides("")
+
+	SetOverrides(dir)
+	data, err := CompileFromPath("project/gitignore", map[string]string{"Rule": "bin/"})
+	assert.NoError(t, err)
+	assert.Equal(t, "bin/\n", data, "the project template should be used")
+
+	data, err = CompileFromPath("project/go.mod.jet", map[string]string{"Module": "shop"})
+	assert.NoError(t, err)
+	assert.Contains(t, data, "module shop\n", "the embedded template should be used if there is no override")
+
+	SetOverrides("")
+	data, err = CompileFromPath("project/gitignore", nil)
+	assert.NoError(t, err)
+	assert.Contains(t, data, "gen/\n", "the embedded template should be used without overrides")
+}
+
+func TestEjectAndDiff(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gs-templates")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	ejected, err := Eject(dir, "project/")
+	assert.NoError(t, err)
+	assert.Contains(t, ejected, filepath.ToSlash(filepath.Join(dir, "project", "gitignore")))
+	_, err = Eject(dir, "project/gitignore")
+	assert.Error(t, err, "an ejected template should not be overwritten")
+	_, err = Eject(dir, "project/missing.jet")
+	assert.EqualError(t, err, "template `project/missing.jet` does not exist")
+
+	diff, err := Diff(dir)
+	assert.NoError(t, err)
+	assert.Empty(t, diff, "the ejected templates are the same as the embedded ones")
+
+	if err := ioutil.WriteFile(filepath.Join(dir, "project", "gitignore"), []byte("gen/\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, "project", "README.md"), []byte("# shop\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	diff, err = Diff(dir)
+	assert.NoError(t, err)
+	assert.Contains(t, diff, "--- a/project/gitignore\n+++ b/project/gitignore\n")
+	assert.Contains(t, diff, "-dist/\n")
+	assert.Contains(t, diff, "--- a/project/README.md\n+++ b/project/README.md\n")
+	assert.Contains(t, diff, "+# shop\n")
+}
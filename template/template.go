@@ -2,6 +2,7 @@ package template
 
 import (
 	"bytes"
+	"fmt"
 	"strings"
 
 	"github.com/sirupsen/logrus"
@@ -24,7 +25,7 @@ func CompileFromPath(tplPath string, data interface{}) (string, error) {
 	log.Debugf("Executing template %s", tplPath)
 	err = t.Execute(&templateBuffer, make(jet.VarMap), data)
 	if err != nil {
-		return "", err
+		return "", fmt.Errorf("template `%s`: %s", tplPath, err)
 	}
 	return templateBuffer.String(), err
 }
@@ -35,6 +36,15 @@ func CompileGoFromPath(tplPath string, data interface{}) (string, error) {
 		return "", err
 	}
 
-	prettyCode, err := imports.Process(strings.Replace(tplPath, "jet", "go", -1), []byte(src), nil)
+	formatted, err := FormatGo(strings.Replace(tplPath, "jet", "go", -1), src)
+	if err != nil {
+		return "", newSourceError(tplPath, src, err)
+	}
+	return formatted, nil
+}
+
+// FormatGo formats the go source and fixes the imports.
+func FormatGo(name, src string) (string, error) {
+	prettyCode, err := imports.Process(name, []byte(src), nil)
 	return string(prettyCode), err
 }
@@ -38,13 +38,22 @@ func (b *Builder) Build() {
 	}()
 
 	for serviceName := range b.watcher.Wait() {
-		err := service.Generate(serviceName, b.watcher.gsConfig.Services[serviceName], b.watcher.gsConfig.Module)
+		if !b.watcher.gsConfig.Services[serviceName].IsEnabled() {
+			continue
+		}
+		svcCfg := b.watcher.gsConfig.Services[serviceName]
+		err := service.GenerateServices(b.watcher.gsConfig, []string{serviceName})
+		if err != nil {
+			log.Println(err)
+			continue
+		}
+		_, serviceImport, err := svcCfg.ResolveModule(serviceName, b.watcher.gsConfig.Module)
 		if err != nil {
 			log.Println(err)
 			continue
 		}
-		pkg := path.Join(b.watcher.gsConfig.Module, serviceName, "cmd")
-		fileName := generateBinaryName(path.Join(serviceName, "cmd"))
+		pkg := path.Join(serviceImport, "cmd")
+		fileName := generateBinaryName(path.Join(svcCfg.Folder(serviceName), "cmd"))
 
 		log.WithField("service", serviceName).Info("Building service")
 
@@ -70,7 +79,7 @@ func (b *Builder) Build() {
 }
 
 func (b *Builder) registerSignalHandler() {
-	signals := make(chan os.Signal)
+	signals := make(chan os.Signal, 1)
 	signal.Notify(signals, syscall.SIGINT, syscall.SIGTERM, syscall.SIGQUIT)
 	<-signals
 	b.watcher.Close()
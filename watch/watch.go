@@ -2,6 +2,7 @@ package watch
 
 import (
 	"gs/config"
+	"gs/template"
 	"os"
 	"path"
 	"path/filepath"
@@ -19,7 +20,9 @@ var log = logrus.WithFields(logrus.Fields{
 
 type Watcher struct {
 	gsConfig *config.GSConfig
-	watcher  *watcher.Watcher
+	// the profile applied every time the configuration is read
+	profile string
+	watcher *watcher.Watcher
 	// when a file gets changed a message is sent to the update channel
 	update chan string
 }
@@ -43,26 +46,42 @@ func (w *Watcher) handleUpdate(event watcher.Event) {
 	if pth == "gs.toml" {
 		mustWatch = true
 	}
+	// a project template changed, this is handled below as a change outside of the services
+	if tpl := w.gsConfig.Templates; tpl != "" && strings.HasPrefix(pth, filepath.Clean(tpl)+string(filepath.Separator)) {
+		mustWatch = true
+	}
 	if !mustWatch {
 		return
 	}
-	for name := range w.gsConfig.Services {
-		if strings.HasPrefix(pth, name) {
-			w.update <- name
-			return
-		}
+	if name := w.findService(filepath.ToSlash(pth)); name != "" {
+		w.update <- name
+		return
 	}
 
 	// something outside of any service changed reload all of them
-	w.gsConfig, err = config.Read()
+	w.gsConfig, err = config.ReadProfile(w.profile)
 	if err != nil {
 		return
 	}
+	template.SetOverrides(w.gsConfig.Templates)
 	for name := range w.gsConfig.Services {
 		w.update <- name
 	}
 }
 
+// findService returns the service the file belongs to, if the service folders
+// are nested the service with the deepest folder is returned.
+func (w *Watcher) findService(pth string) (service string) {
+	folder := ""
+	for name, svc := range w.gsConfig.Services {
+		f := svc.Folder(name)
+		if strings.HasPrefix(pth, f+"/") && len(f) > len(folder) {
+			service, folder = name, f
+		}
+	}
+	return service
+}
+
 func (w *Watcher) watchLoop() {
 	for {
 		select {
@@ -96,8 +115,8 @@ func (w *Watcher) Watch() {
 		log.Fatalln(err)
 	}
 
-	for name := range w.gsConfig.Services {
-		if err := w.watcher.Ignore(path.Join(name, "gen")); err != nil {
+	for name, svc := range w.gsConfig.Services {
+		if err := w.watcher.Ignore(path.Join(svc.Folder(name), "gen")); err != nil {
 			log.Fatalln(err)
 		}
 	}
@@ -121,20 +140,21 @@ func (w *Watcher) Close() {
 	close(w.update)
 }
 
-func NewWatcher() *Watcher {
-	cfg, err := config.Read()
+func NewWatcher(profile string) *Watcher {
+	cfg, err := config.ReadProfile(profile)
 	if err != nil {
 		panic(err)
 	}
 	return &Watcher{
 		update:   make(chan string),
 		gsConfig: cfg,
+		profile:  profile,
 		watcher:  watcher.New(),
 	}
 }
-func Run(port int) {
+func Run(port int, profile string) {
 	r := NewRunner()
-	w := NewWatcher()
+	w := NewWatcher(profile)
 	// wait for build and run the binary with given params
 	go r.Run()
 